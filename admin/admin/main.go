@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"context"
 	"github.com/memocash/index/node"
 	"log"
 	"net/http"
@@ -23,9 +24,14 @@ type Response struct {
 	Writer    http.ResponseWriter
 	Request   *http.Request
 	NodeGroup *node.Group
+	Backfill  BackfillFunc
 	Route     Route
 }
 
+// BackfillFunc processes blocks in a height range again from the lead processor's block source, nil if the lead
+// processor is not running in this server.
+type BackfillFunc func(ctx context.Context, startHeight, endHeight int64) error
+
 func (r Response) Error(err error) {
 	log.Printf("error with request: %s; %v", r.Route.Pattern, err)
 }
@@ -44,8 +50,18 @@ const (
 	UrlNodeFoundPeers      = "/node/found_peers"
 	UrlNodePeers           = "/node/peers"
 	UrlNodePeerReport      = "/node/peer_report"
+	UrlNodeSyncProgress    = "/node/sync_progress"
+	UrlNodeBackfill        = "/node/backfill"
+	UrlNodeCrawlerStart    = "/node/crawler_start"
+	UrlNodeCrawlerStop     = "/node/crawler_stop"
+	UrlNodeNetworkMap      = "/node/network_map"
+	UrlNodeNetworkPeers    = "/node/network_peers"
 	UrlNetworkTx           = "/network/tx"
 	UrlTopicList           = "/topic/list"
 	UrlTopicView           = "/topic/view"
 	UrlTopicItem           = "/topic/item"
+	UrlTopicItemDelete     = "/topic/item_delete"
+	UrlTopicItemSave       = "/topic/item_save"
+	UrlQueryList           = "/query/list"
+	UrlQuerySave           = "/query/save"
 )
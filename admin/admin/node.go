@@ -65,39 +65,59 @@ type NodePeerReportResponse struct {
 	PeersFailed    uint64
 }
 
-type Topic struct {
-	Name string
+type NodeSyncProgressResponse struct {
+	Complete    bool
+	StartHeight int64
+	Height      int64
+	Target      int64
+	InFlight    int
+	Rate        float64
+	Eta         string
+	Started     time.Time
+	Updated     time.Time
 }
 
-type TopicListResponse struct {
-	Topics []Topic
+type NodeBackfillRequest struct {
+	StartHeight int64
+	EndHeight   int64
 }
 
-type TopicViewRequest struct {
-	Topic string
-	Start string
-	Shard int
-}
-
-type TopicItem struct {
-	Topic   string
-	Uid     string
-	Message string
-	Shard   uint
-	Props   map[string]interface{}
-}
-
-type TopicViewResponse struct {
+type NetworkCount struct {
 	Name  string
-	Items []TopicItem
-}
-
-type TopicItemRequest struct {
-	Topic string
-	Shard uint
-	Uid   string
-}
-
-type TopicItemResponse struct {
-	Item TopicItem
+	Count int
+}
+
+type NodeNetworkMapResponse struct {
+	CrawlerRunning bool
+	Crawled        int
+	Failed         int
+	TotalPeers     int
+	Reachable      int
+	UserAgents     []NetworkCount
+	Versions       []NetworkCount
+	Heights        []NetworkCount
+}
+
+type NetworkPeer struct {
+	Ip              string
+	Port            uint16
+	UserAgent       string
+	ProtocolVersion int32
+	Services        uint64
+	Height          int32
+	Seen            time.Time
+	Attempts        int
+	Successes       int
+	Uptime          float64
+	Reliability     float64
+	LastSuccess     time.Time
+	Reachable       bool
+}
+
+type NodeNetworkPeersRequest struct {
+	ReachableOnly bool
+}
+
+type NodeNetworkPeersResponse struct {
+	Peers []NetworkPeer
 }
@@ -0,0 +1,20 @@
+package admin
+
+type Query struct {
+	Name  string
+	Hash  string
+	Query string
+}
+
+type QueryListResponse struct {
+	Queries []Query
+}
+
+type QuerySaveRequest struct {
+	Name  string
+	Query string
+}
+
+type QuerySaveResponse struct {
+	Query Query
+}
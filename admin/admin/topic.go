@@ -0,0 +1,78 @@
+package admin
+
+type Topic struct {
+	Name string
+}
+
+type TopicListResponse struct {
+	Topics []Topic
+}
+
+// TopicField is a field of a topic's object, key fields are part of the item UID.
+type TopicField struct {
+	Name string
+	Type string
+	Key  bool
+}
+
+// TopicViewRequest lists items from the start UID. Prefix limits items to those matching leading key field values,
+// From and To limit items to a range of key field values.
+type TopicViewRequest struct {
+	Topic  string
+	Start  string
+	Shard  int
+	Prefix map[string]string
+	From   map[string]string
+	To     map[string]string
+}
+
+// TopicLink is a search on another topic for items related to an item.
+type TopicLink struct {
+	Name   string
+	Topic  string
+	Prefix map[string]string
+}
+
+type TopicItem struct {
+	Topic   string
+	Uid     string
+	Message string
+	Shard   uint
+	Props   map[string]interface{}
+	Links   []TopicLink
+}
+
+type TopicViewResponse struct {
+	Name   string
+	Fields []TopicField
+	Items  []TopicItem
+}
+
+type TopicItemRequest struct {
+	Topic string
+	Shard uint
+	Uid   string
+}
+
+type TopicItemResponse struct {
+	Item   TopicItem
+	Fields []TopicField
+}
+
+type TopicItemDeleteRequest struct {
+	Topic string
+	Shard uint
+	Uid   string
+}
+
+// TopicItemSaveRequest re-saves an item with edited field values, key fields cannot be changed.
+type TopicItemSaveRequest struct {
+	Topic string
+	Shard uint
+	Uid   string
+	Props map[string]string
+}
+
+type TopicItemSaveResponse struct {
+	Item TopicItem
+}
@@ -0,0 +1,45 @@
+package peer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/memocash/index/admin/admin"
+	"github.com/memocash/index/ref/config"
+	"io/ioutil"
+	"net/http"
+)
+
+type Backfill struct {
+	Message string
+}
+
+// Backfill asks the lead processor to process blocks in the height range again, waiting until it is done.
+func (b *Backfill) Backfill(startHeight, endHeight int64) error {
+	jsonData, err := json.Marshal(admin.NodeBackfillRequest{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	})
+	if err != nil {
+		return fmt.Errorf("error marshalling backfill request data; %w", err)
+	}
+	url := "http://" + config.GetHost(config.GetAdminPort()) + admin.UrlNodeBackfill
+	resp, err := http.Post(url, "application/json", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("error getting node backfill; %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading node backfill body; %w", err)
+	}
+	b.Message = string(body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error node backfill response status: %d; %s", resp.StatusCode, b.Message)
+	}
+	return nil
+}
+
+func NewBackfill() *Backfill {
+	return &Backfill{}
+}
@@ -0,0 +1,44 @@
+package peer
+
+import (
+	"fmt"
+	"github.com/memocash/index/admin/admin"
+	"github.com/memocash/index/ref/config"
+	"io/ioutil"
+	"net/http"
+)
+
+type CrawlerToggle struct {
+	Message string
+}
+
+func (i *CrawlerToggle) Start() error {
+	if err := i.set(admin.UrlNodeCrawlerStart); err != nil {
+		return fmt.Errorf("error starting crawler; %w", err)
+	}
+	return nil
+}
+
+func (i *CrawlerToggle) Stop() error {
+	if err := i.set(admin.UrlNodeCrawlerStop); err != nil {
+		return fmt.Errorf("error stopping crawler; %w", err)
+	}
+	return nil
+}
+
+func (i *CrawlerToggle) set(url string) error {
+	resp, err := http.Get("http://" + config.GetHost(config.GetAdminPort()) + url)
+	if err != nil {
+		return fmt.Errorf("error getting node crawler toggle; %w", err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading node crawler toggle body; %w", err)
+	}
+	i.Message = string(body)
+	return nil
+}
+
+func NewCrawlerToggle() *CrawlerToggle {
+	return &CrawlerToggle{}
+}
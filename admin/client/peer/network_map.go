@@ -0,0 +1,33 @@
+package peer
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/memocash/index/admin/admin"
+	"github.com/memocash/index/ref/config"
+	"io/ioutil"
+	"net/http"
+)
+
+type NetworkMap struct {
+	Response admin.NodeNetworkMapResponse
+}
+
+func (m *NetworkMap) Get() error {
+	resp, err := http.Get("http://" + config.GetHost(config.GetAdminPort()) + admin.UrlNodeNetworkMap)
+	if err != nil {
+		return fmt.Errorf("error getting node network map; %w", err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading node network map body; %w", err)
+	}
+	if err := json.Unmarshal(body, &m.Response); err != nil {
+		return fmt.Errorf("error unmarshalling node network map response: %s; %w", body, err)
+	}
+	return nil
+}
+
+func NewNetworkMap() *NetworkMap {
+	return &NetworkMap{}
+}
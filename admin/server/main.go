@@ -5,6 +5,7 @@ import (
 	"github.com/memocash/index/admin/admin"
 	"github.com/memocash/index/admin/server/network"
 	node2 "github.com/memocash/index/admin/server/node"
+	"github.com/memocash/index/admin/server/query"
 	"github.com/memocash/index/admin/server/topic"
 	"github.com/memocash/index/node"
 	"github.com/memocash/index/ref/config"
@@ -15,6 +16,7 @@ import (
 
 type Server struct {
 	Nodes    *node.Group
+	Backfill admin.BackfillFunc
 	Port     uint
 	server   http.Server
 	listener net.Listener
@@ -25,6 +27,7 @@ var routes = admin.Routes([]admin.Route{
 },
 	network.GetRoutes(),
 	node2.GetRoutes(),
+	query.GetRoutes(),
 	topic.GetRoutes(),
 )
 
@@ -37,6 +40,15 @@ func (s *Server) Run() error {
 }
 
 func (s *Server) Start() error {
+	s.server = http.Server{Handler: s.GetHandler()}
+	var err error
+	if s.listener, err = net.Listen("tcp", config.GetHost(s.Port)); err != nil {
+		return fmt.Errorf("failed to listen admin server; %w", err)
+	}
+	return nil
+}
+
+func (s *Server) GetHandler() http.Handler {
 	mux := http.NewServeMux()
 	for _, tempRoute := range routes {
 		route := tempRoute
@@ -45,17 +57,13 @@ func (s *Server) Start() error {
 				Writer:    w,
 				Request:   r,
 				NodeGroup: s.Nodes,
+				Backfill:  s.Backfill,
 				Route:     route,
 			})
 			log.Printf("Processed admin request: %s\n", r.URL)
 		})
 	}
-	s.server = http.Server{Handler: mux}
-	var err error
-	if s.listener, err = net.Listen("tcp", config.GetHost(s.Port)); err != nil {
-		return fmt.Errorf("failed to listen admin server; %w", err)
-	}
-	return nil
+	return mux
 }
 
 func (s *Server) Serve() error {
@@ -0,0 +1,80 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/memocash/index/admin/admin"
+	"github.com/memocash/index/admin/server"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type backfillTest struct {
+	Name        string
+	NoProcessor bool
+	Request     admin.NodeBackfillRequest
+	Err         error
+	Status      int
+	Called      bool
+}
+
+var backfillTests = []backfillTest{{
+	Name:    "range",
+	Request: admin.NodeBackfillRequest{StartHeight: 5, EndHeight: 10},
+	Status:  http.StatusOK,
+	Called:  true,
+}, {
+	Name:    "single block",
+	Request: admin.NodeBackfillRequest{StartHeight: 7, EndHeight: 7},
+	Status:  http.StatusOK,
+	Called:  true,
+}, {
+	Name:    "invalid range",
+	Request: admin.NodeBackfillRequest{StartHeight: 10, EndHeight: 5},
+	Status:  http.StatusBadRequest,
+}, {
+	Name:        "no processor",
+	NoProcessor: true,
+	Request:     admin.NodeBackfillRequest{StartHeight: 5, EndHeight: 10},
+	Status:      http.StatusServiceUnavailable,
+}, {
+	Name:    "backfill error",
+	Request: admin.NodeBackfillRequest{StartHeight: 5, EndHeight: 10},
+	Err:     fmt.Errorf("error no connected block peer"),
+	Status:  http.StatusInternalServerError,
+	Called:  true,
+}}
+
+func TestBackfillRoute(t *testing.T) {
+	for _, tst := range backfillTests {
+		t.Run(tst.Name, func(t *testing.T) {
+			adminServer := server.NewServer(nil)
+			var called bool
+			if !tst.NoProcessor {
+				adminServer.Backfill = func(ctx context.Context, startHeight, endHeight int64) error {
+					called = true
+					if startHeight != tst.Request.StartHeight || endHeight != tst.Request.EndHeight {
+						t.Errorf("unexpected backfill range: %d to %d", startHeight, endHeight)
+					}
+					return tst.Err
+				}
+			}
+			body, err := json.Marshal(tst.Request)
+			if err != nil {
+				t.Fatalf("error marshalling backfill request; %v", err)
+			}
+			recorder := httptest.NewRecorder()
+			adminServer.GetHandler().ServeHTTP(recorder,
+				httptest.NewRequest(http.MethodPost, admin.UrlNodeBackfill, bytes.NewReader(body)))
+			if recorder.Code != tst.Status {
+				t.Errorf("unexpected status: %d, expected: %d (%s)", recorder.Code, tst.Status, recorder.Body)
+			}
+			if called != tst.Called {
+				t.Errorf("unexpected backfill called: %t, expected: %t", called, tst.Called)
+			}
+		})
+	}
+}
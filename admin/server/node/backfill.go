@@ -0,0 +1,37 @@
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/memocash/index/admin/admin"
+	"net/http"
+)
+
+var backfillRoute = admin.Route{
+	Pattern: admin.UrlNodeBackfill,
+	Handler: func(r admin.Response) {
+		var backfillRequest = new(admin.NodeBackfillRequest)
+		if err := json.NewDecoder(r.Request.Body).Decode(backfillRequest); err != nil {
+			r.Error(fmt.Errorf("error unmarshalling node backfill request; %w", err))
+			http.Error(r.Writer, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if r.Backfill == nil {
+			r.Error(fmt.Errorf("error lead processor not running for backfill"))
+			http.Error(r.Writer, "lead processor not running in this server", http.StatusServiceUnavailable)
+			return
+		}
+		if backfillRequest.StartHeight < 0 || backfillRequest.EndHeight < backfillRequest.StartHeight {
+			r.Error(fmt.Errorf("error invalid backfill range: %d to %d",
+				backfillRequest.StartHeight, backfillRequest.EndHeight))
+			http.Error(r.Writer, "invalid height range", http.StatusBadRequest)
+			return
+		}
+		if err := r.Backfill(r.Request.Context(), backfillRequest.StartHeight, backfillRequest.EndHeight); err != nil {
+			r.Error(fmt.Errorf("error running backfill; %w", err))
+			http.Error(r.Writer, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintf(r.Writer, "Backfill complete: %d to %d", backfillRequest.StartHeight, backfillRequest.EndHeight)
+	},
+}
@@ -0,0 +1,19 @@
+package node
+
+import (
+	"github.com/memocash/index/admin/admin"
+)
+
+var crawlerStartRoute = admin.Route{
+	Pattern: admin.UrlNodeCrawlerStart,
+	Handler: func(r admin.Response) {
+		r.NodeGroup.Crawler.Start()
+	},
+}
+
+var crawlerStopRoute = admin.Route{
+	Pattern: admin.UrlNodeCrawlerStop,
+	Handler: func(r admin.Response) {
+		r.NodeGroup.Crawler.Stop()
+	},
+}
@@ -16,5 +16,11 @@ func GetRoutes() []admin.Route {
 		getAddrsRoute,
 		peersRoute,
 		peerReportRoute,
+		syncProgressRoute,
+		backfillRoute,
+		crawlerStartRoute,
+		crawlerStopRoute,
+		networkMapRoute,
+		networkPeersRoute,
 	}
 }
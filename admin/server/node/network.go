@@ -0,0 +1,97 @@
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/memocash/index/admin/admin"
+	"github.com/memocash/index/db/client/peer"
+	"github.com/memocash/index/db/item"
+	"net"
+	"strconv"
+)
+
+var networkMapRoute = admin.Route{
+	Pattern: admin.UrlNodeNetworkMap,
+	Handler: func(r admin.Response) {
+		network := peer.NewNetwork()
+		if err := network.Load(); err != nil {
+			r.Error(fmt.Errorf("error loading network for network map; %w", err))
+			return
+		}
+		var response = &admin.NodeNetworkMapResponse{
+			CrawlerRunning: r.NodeGroup.Crawler.IsRunning(),
+			TotalPeers:     len(network.Peers),
+			Reachable:      len(network.GetReachable()),
+			UserAgents: getNetworkCounts(network, func(info *item.PeerInfo) string {
+				return info.UserAgent
+			}),
+			Versions: getNetworkCounts(network, func(info *item.PeerInfo) string {
+				return strconv.Itoa(int(info.ProtocolVersion))
+			}),
+			Heights: getNetworkCounts(network, func(info *item.PeerInfo) string {
+				return strconv.Itoa(int(info.Height))
+			}),
+		}
+		response.Crawled, response.Failed = r.NodeGroup.Crawler.GetCounts()
+		if err := json.NewEncoder(r.Writer).Encode(response); err != nil {
+			r.Error(fmt.Errorf("error marshalling and writing network map response data; %w", err))
+			return
+		}
+	},
+}
+
+func getNetworkCounts(network *peer.Network, field func(*item.PeerInfo) string) []admin.NetworkCount {
+	networkCounts := network.GetCounts(field)
+	var counts = make([]admin.NetworkCount, len(networkCounts))
+	for i := range networkCounts {
+		counts[i] = admin.NetworkCount{
+			Name:  networkCounts[i].Name,
+			Count: networkCounts[i].Count,
+		}
+	}
+	return counts
+}
+
+var networkPeersRoute = admin.Route{
+	Pattern: admin.UrlNodeNetworkPeers,
+	Handler: func(r admin.Response) {
+		var request = new(admin.NodeNetworkPeersRequest)
+		if err := json.NewDecoder(r.Request.Body).Decode(request); err != nil {
+			r.Error(fmt.Errorf("error unmarshalling network peers request; %w", err))
+			return
+		}
+		network := peer.NewNetwork()
+		if err := network.Load(); err != nil {
+			r.Error(fmt.Errorf("error loading network for network peers; %w", err))
+			return
+		}
+		networkPeers := network.Peers
+		if request.ReachableOnly {
+			networkPeers = network.GetReachable()
+		}
+		var response = &admin.NodeNetworkPeersResponse{
+			Peers: make([]admin.NetworkPeer, len(networkPeers)),
+		}
+		for i, networkPeer := range networkPeers {
+			response.Peers[i] = admin.NetworkPeer{
+				Ip:              net.IP(networkPeer.Info.Ip).String(),
+				Port:            networkPeer.Info.Port,
+				UserAgent:       networkPeer.Info.UserAgent,
+				ProtocolVersion: networkPeer.Info.ProtocolVersion,
+				Services:        networkPeer.Info.Services,
+				Height:          networkPeer.Info.Height,
+				Seen:            networkPeer.Info.Time,
+				Attempts:        networkPeer.Attempts,
+				Successes:       networkPeer.Successes,
+				Uptime:          networkPeer.Uptime,
+				Reliability:     networkPeer.Reliability,
+				LastSuccess:     networkPeer.LastSuccess,
+				Reachable:       networkPeer.Reachable,
+			}
+		}
+		if err := json.NewEncoder(r.Writer).Encode(response); err != nil {
+			r.Error(fmt.Errorf("error marshalling and writing network peers response data; %w", err))
+			return
+		}
+	},
+}
@@ -0,0 +1,42 @@
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/memocash/index/admin/admin"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item"
+	"time"
+)
+
+var syncProgressRoute = admin.Route{
+	Pattern: admin.UrlNodeSyncProgress,
+	Handler: func(r admin.Response) {
+		var response = new(admin.NodeSyncProgressResponse)
+		syncStatusComplete, err := item.GetSyncStatus(item.SyncStatusComplete)
+		if err != nil && !client.IsEntryNotFoundError(err) {
+			r.Error(fmt.Errorf("error getting sync status complete; %w", err))
+			return
+		}
+		response.Complete = syncStatusComplete != nil
+		syncProgress, err := item.GetSyncProgress(item.SyncProgressBlock)
+		if err != nil && !client.IsEntryNotFoundError(err) {
+			r.Error(fmt.Errorf("error getting sync progress; %w", err))
+			return
+		}
+		if syncProgress != nil {
+			response.StartHeight = syncProgress.StartHeight
+			response.Height = syncProgress.Height
+			response.Target = syncProgress.Target
+			response.InFlight = syncProgress.InFlight
+			response.Rate = syncProgress.GetRate()
+			response.Eta = syncProgress.GetEta().Round(time.Second).String()
+			response.Started = syncProgress.Started
+			response.Updated = syncProgress.Updated
+		}
+		if err := json.NewEncoder(r.Writer).Encode(response); err != nil {
+			r.Error(fmt.Errorf("error marshalling and writing sync progress response data; %w", err))
+			return
+		}
+	},
+}
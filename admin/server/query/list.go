@@ -0,0 +1,37 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/memocash/index/admin/admin"
+	"github.com/memocash/index/db/item"
+	"log"
+	"sort"
+)
+
+var listRoute = admin.Route{
+	Pattern: admin.UrlQueryList,
+	Handler: func(r admin.Response) {
+		persistedQueries, err := item.GetPersistedQueries(r.Request.Context())
+		if err != nil {
+			r.Error(fmt.Errorf("error getting persisted queries; %w", err))
+			return
+		}
+		sort.Slice(persistedQueries, func(i, j int) bool {
+			return persistedQueries[i].Name < persistedQueries[j].Name
+		})
+		var queryListResponse = new(admin.QueryListResponse)
+		queryListResponse.Queries = make([]admin.Query, len(persistedQueries))
+		for i := range persistedQueries {
+			queryListResponse.Queries[i] = admin.Query{
+				Name:  persistedQueries[i].Name,
+				Hash:  persistedQueries[i].HashString(),
+				Query: persistedQueries[i].Query,
+			}
+		}
+		if err := json.NewEncoder(r.Writer).Encode(queryListResponse); err != nil {
+			log.Printf("error writing json query list response data; %v", err)
+			return
+		}
+	},
+}
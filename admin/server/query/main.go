@@ -0,0 +1,10 @@
+package query
+
+import "github.com/memocash/index/admin/admin"
+
+func GetRoutes() []admin.Route {
+	return []admin.Route{
+		listRoute,
+		saveRoute,
+	}
+}
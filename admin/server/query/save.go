@@ -0,0 +1,67 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/memocash/index/admin/admin"
+	"github.com/memocash/index/db/item"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/graph/generated"
+	"github.com/memocash/index/graph/resolver"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+	"github.com/vektah/gqlparser/v2/validator"
+	"log"
+	"net/http"
+	"regexp"
+)
+
+var validName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+var saveRoute = admin.Route{
+	Pattern: admin.UrlQuerySave,
+	Handler: func(r admin.Response) {
+		var querySaveRequest = new(admin.QuerySaveRequest)
+		if err := json.NewDecoder(r.Request.Body).Decode(querySaveRequest); err != nil {
+			r.Error(fmt.Errorf("error unmarshalling query save request; %w", err))
+			return
+		}
+		if !validName.MatchString(querySaveRequest.Name) {
+			r.Error(fmt.Errorf("error invalid persisted query name: %s", querySaveRequest.Name))
+			http.Error(r.Writer, "invalid query name", http.StatusBadRequest)
+			return
+		}
+		if err := validateQuery(querySaveRequest.Query); err != nil {
+			r.Error(fmt.Errorf("error validating persisted query: %s; %w", querySaveRequest.Name, err))
+			http.Error(r.Writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+		persistedQuery := item.NewPersistedQuery(querySaveRequest.Name, querySaveRequest.Query)
+		if err := db.Save([]db.Object{persistedQuery}); err != nil {
+			r.Error(fmt.Errorf("error saving persisted query; %w", err))
+			return
+		}
+		if err := json.NewEncoder(r.Writer).Encode(admin.QuerySaveResponse{
+			Query: admin.Query{
+				Name:  persistedQuery.Name,
+				Hash:  persistedQuery.HashString(),
+				Query: persistedQuery.Query,
+			},
+		}); err != nil {
+			log.Printf("error writing json query save response data; %v", err)
+			return
+		}
+	},
+}
+
+func validateQuery(query string) error {
+	schema := generated.NewExecutableSchema(generated.Config{Resolvers: &resolver.Resolver{}}).Schema()
+	queryDocument, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return fmt.Errorf("error parsing query; %w", err)
+	}
+	if errs := validator.Validate(schema, queryDocument); len(errs) > 0 {
+		return fmt.Errorf("error query does not match schema; %w", errs)
+	}
+	return nil
+}
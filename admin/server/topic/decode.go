@@ -0,0 +1,303 @@
+package topic
+
+import (
+	"encoding/hex"
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/admin/admin"
+	"github.com/memocash/index/db/item"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var typeOfBytes = reflect.TypeOf([]byte(nil))
+var typeOfBytes25 = reflect.TypeOf([25]byte{})
+var typeOfBytes32 = reflect.TypeOf([32]byte{})
+var typeOfTime = reflect.TypeOf(time.Time{})
+var typeOfBigInt = reflect.TypeOf((*big.Int)(nil))
+
+// getTopicObject returns a new empty object for a topic, or nil if the topic is not known.
+func getTopicObject(topic string) db.Object {
+	for _, obj := range item.GetTopics() {
+		if obj.GetTopic() == topic {
+			return reflect.New(reflect.ValueOf(obj).Elem().Type()).Interface().(db.Object)
+		}
+	}
+	return nil
+}
+
+// isDisplayOrderHash checks if a byte slice field is a tx or block hash shown in reverse byte order.
+func isDisplayOrderHash(fieldName string) bool {
+	lowerName := strings.ToLower(fieldName)
+	return strings.Contains(lowerName, "txhash") || strings.Contains(lowerName, "blockhash")
+}
+
+// getUid gets an object's UID, objects with unset fields may panic when building a UID.
+func getUid(obj db.Object) (uid []byte, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	return obj.GetUid(), true
+}
+
+// getFieldProp formats a field for display, addresses as cashaddr, tx and block hashes in display order, times as
+// RFC3339 and other bytes as hex.
+func getFieldProp(fieldName string, fieldValue reflect.Value) interface{} {
+	switch fieldValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(fieldValue.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(fieldValue.Uint(), 10)
+	case reflect.Slice:
+		if fieldValue.Type() != typeOfBytes {
+			return fieldValue.String()
+		} else if isDisplayOrderHash(fieldName) {
+			return hex.EncodeToString(jutil.ByteReverse(fieldValue.Bytes()))
+		}
+		return hex.EncodeToString(fieldValue.Bytes())
+	case reflect.Array:
+		if fieldValue.Type() == typeOfBytes25 {
+			var addr = wallet.GetAddrFromBytes(getArrayBytes(fieldValue))
+			if cashAddr := addr.OldAddress().GetCashAddrString(); cashAddr != "" {
+				return cashAddr
+			}
+			return hex.EncodeToString(addr[:])
+		} else if fieldValue.Type() == typeOfBytes32 {
+			return hex.EncodeToString(jutil.ByteReverse(getArrayBytes(fieldValue)))
+		}
+		return fieldValue.String()
+	case reflect.String:
+		return fieldValue.String()
+	case reflect.Bool:
+		return fieldValue.Bool()
+	default:
+		switch v := fieldValue.Interface().(type) {
+		case time.Time:
+			return v.Format(time.RFC3339Nano)
+		case *big.Int:
+			if v == nil {
+				return ""
+			}
+			return v.String()
+		default:
+			return fieldValue.String()
+		}
+	}
+}
+
+func getArrayBytes(fieldValue reflect.Value) []byte {
+	var b = make([]byte, fieldValue.Len())
+	reflect.Copy(reflect.ValueOf(b), fieldValue)
+	return b
+}
+
+// setFieldProp parses a value in the format from getFieldProp and sets the field.
+func setFieldProp(fieldName string, fieldValue reflect.Value, prop string) error {
+	switch fieldValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(prop, 10, fieldValue.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("error parsing int; %w", err)
+		}
+		fieldValue.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(prop, 10, fieldValue.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("error parsing uint; %w", err)
+		}
+		fieldValue.SetUint(u)
+	case reflect.Slice:
+		if fieldValue.Type() != typeOfBytes {
+			return fmt.Errorf("error unsupported slice type: %s", fieldValue.Type())
+		}
+		b, err := hex.DecodeString(prop)
+		if err != nil {
+			return fmt.Errorf("error decoding hex; %w", err)
+		}
+		if isDisplayOrderHash(fieldName) {
+			b = jutil.ByteReverse(b)
+		}
+		fieldValue.SetBytes(b)
+	case reflect.Array:
+		var b []byte
+		if fieldValue.Type() == typeOfBytes25 && len(prop) != hex.EncodedLen(fieldValue.Len()) {
+			address, err := wallet.GetAddressFromStringErr(prop)
+			if err != nil {
+				return fmt.Errorf("error parsing address; %w", err)
+			}
+			addr := address.GetAddr()
+			b = addr[:]
+		} else if fieldValue.Type().Elem().Kind() == reflect.Uint8 {
+			var err error
+			if b, err = hex.DecodeString(prop); err != nil {
+				return fmt.Errorf("error decoding hex; %w", err)
+			}
+			if fieldValue.Type() == typeOfBytes32 {
+				b = jutil.ByteReverse(b)
+			}
+		} else {
+			return fmt.Errorf("error unsupported array type: %s", fieldValue.Type())
+		}
+		if len(b) != fieldValue.Len() {
+			return fmt.Errorf("error unexpected length: %d (expected %d)", len(b), fieldValue.Len())
+		}
+		reflect.Copy(fieldValue, reflect.ValueOf(b))
+	case reflect.String:
+		fieldValue.SetString(prop)
+	case reflect.Bool:
+		v, err := strconv.ParseBool(prop)
+		if err != nil {
+			return fmt.Errorf("error parsing bool; %w", err)
+		}
+		fieldValue.SetBool(v)
+	default:
+		switch fieldValue.Type() {
+		case typeOfTime:
+			t, err := time.Parse(time.RFC3339Nano, prop)
+			if err != nil {
+				return fmt.Errorf("error parsing time; %w", err)
+			}
+			fieldValue.Set(reflect.ValueOf(t))
+		case typeOfBigInt:
+			i, ok := new(big.Int).SetString(prop, 10)
+			if !ok {
+				return fmt.Errorf("error parsing big int: %s", prop)
+			}
+			fieldValue.Set(reflect.ValueOf(i))
+		default:
+			return fmt.Errorf("error unsupported field type: %s", fieldValue.Type())
+		}
+	}
+	return nil
+}
+
+// setFieldAlt sets a field to a non-zero value, used to find which fields and bytes make up the UID.
+func setFieldAlt(fieldValue reflect.Value) {
+	switch fieldValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		fieldValue.SetInt(1<<(fieldValue.Type().Bits()-1) - 1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		fieldValue.SetUint(1<<fieldValue.Type().Bits() - 1)
+	case reflect.Slice:
+		if fieldValue.Type() == typeOfBytes {
+			fieldValue.SetBytes([]byte{0xff})
+		}
+	case reflect.Array:
+		if fieldValue.Type().Elem().Kind() == reflect.Uint8 {
+			for i := 0; i < fieldValue.Len(); i++ {
+				fieldValue.Index(i).SetUint(0xff)
+			}
+		}
+	case reflect.String:
+		fieldValue.SetString("\xff")
+	case reflect.Bool:
+		fieldValue.SetBool(true)
+	default:
+		if fieldValue.Type() == typeOfTime {
+			fieldValue.Set(reflect.ValueOf(time.Unix(1<<32, 0)))
+		}
+	}
+}
+
+// getFields returns the fields of a topic's object, a field is a key field if setting it changes the UID.
+func getFields(topic string) []admin.TopicField {
+	obj := getTopicObject(topic)
+	if obj == nil {
+		return nil
+	}
+	emptyUid, _ := getUid(obj)
+	elem := reflect.ValueOf(obj).Elem()
+	var fields = make([]admin.TopicField, elem.NumField())
+	for i := range fields {
+		fields[i] = admin.TopicField{
+			Name: elem.Type().Field(i).Name,
+			Type: elem.Field(i).Type().String(),
+		}
+		altObj := getTopicObject(topic)
+		setFieldAlt(reflect.ValueOf(altObj).Elem().Field(i))
+		if altUid, ok := getUid(altObj); ok && string(altUid) != string(emptyUid) {
+			fields[i].Key = true
+		}
+	}
+	return fields
+}
+
+// getProps decodes each field of an object by name.
+func getProps(obj db.Object) map[string]interface{} {
+	var props = make(map[string]interface{})
+	elem := reflect.ValueOf(obj).Elem()
+	for i := 0; i < elem.NumField(); i++ {
+		props[elem.Type().Field(i).Name] = getFieldProp(elem.Type().Field(i).Name, elem.Field(i))
+	}
+	return props
+}
+
+// setProps parses and sets fields of an object by name.
+func setProps(obj db.Object, props map[string]string) error {
+	elem := reflect.ValueOf(obj).Elem()
+	for name, prop := range props {
+		field, ok := elem.Type().FieldByName(name)
+		if !ok {
+			return fmt.Errorf("error field not found: %s", name)
+		}
+		if err := setFieldProp(name, elem.FieldByIndex(field.Index), prop); err != nil {
+			return fmt.Errorf("error setting field: %s; %w", name, err)
+		}
+	}
+	return nil
+}
+
+// getKeyPrefix returns the UID prefix for items with the key field values. Only leading key fields are part of the
+// prefix, a value for a later key field without the ones before it does not narrow the prefix.
+func getKeyPrefix(topic string, props map[string]string) ([]byte, error) {
+	lowObj, highObj := getTopicObject(topic), getTopicObject(topic)
+	if lowObj == nil {
+		return nil, fmt.Errorf("error unknown topic: %s", topic)
+	}
+	highElem := reflect.ValueOf(highObj).Elem()
+	for i := 0; i < highElem.NumField(); i++ {
+		if _, ok := props[highElem.Type().Field(i).Name]; !ok {
+			setFieldAlt(highElem.Field(i))
+		}
+	}
+	if err := setProps(lowObj, props); err != nil {
+		return nil, fmt.Errorf("error setting low key props; %w", err)
+	}
+	if err := setProps(highObj, props); err != nil {
+		return nil, fmt.Errorf("error setting high key props; %w", err)
+	}
+	lowUid, lowOk := getUid(lowObj)
+	highUid, highOk := getUid(highObj)
+	if !lowOk || !highOk {
+		return nil, fmt.Errorf("error getting uid for key props")
+	}
+	var prefixLen int
+	for prefixLen < len(lowUid) && prefixLen < len(highUid) && lowUid[prefixLen] == highUid[prefixLen] {
+		prefixLen++
+	}
+	return lowUid[:prefixLen], nil
+}
+
+// getTopicItem decodes a message into a topic item with props and links.
+func getTopicItem(topic string, shard uint, uid, message []byte) admin.TopicItem {
+	var topicItem = admin.TopicItem{
+		Topic:   topic,
+		Shard:   shard,
+		Uid:     hex.EncodeToString(uid),
+		Message: hex.EncodeToString(message),
+	}
+	if obj := getTopicObject(topic); obj != nil {
+		obj.SetUid(uid)
+		obj.Deserialize(message)
+		topicItem.Props = getProps(obj)
+		topicItem.Links = getLinks(topic, topicItem.Props)
+	}
+	return topicItem
+}
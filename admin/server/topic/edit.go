@@ -0,0 +1,154 @@
+package topic
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/memocash/index/admin/admin"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/ref/config"
+	"log"
+	"net/http"
+	"time"
+)
+
+// getEditItem gets the current message for an item being edited. Items in immutable topics cannot be edited since
+// servers cache them without invalidation.
+func getEditItem(r admin.Response, topic string, shard uint, uidHex string) (*client.Client, *client.Message, bool) {
+	uid, err := hex.DecodeString(uidHex)
+	if err != nil {
+		r.Error(fmt.Errorf("error parsing uid for topic item edit; %w", err))
+		http.Error(r.Writer, "invalid uid", http.StatusBadRequest)
+		return nil, nil, false
+	}
+	if getTopicObject(topic) == nil {
+		r.Error(fmt.Errorf("error unknown topic for topic item edit: %s", topic))
+		http.Error(r.Writer, "unknown topic", http.StatusBadRequest)
+		return nil, nil, false
+	}
+	if db.IsImmutableTopic(topic) {
+		r.Error(fmt.Errorf("error immutable topic for topic item edit: %s", topic))
+		http.Error(r.Writer, "immutable topic items are cached by servers and cannot be edited", http.StatusBadRequest)
+		return nil, nil, false
+	}
+	shardConfig := config.GetShardConfig(uint32(shard), config.GetQueueShards())
+	dbClient := client.NewClient(shardConfig.GetHost())
+	if err := dbClient.GetSingle(topic, uid); err != nil {
+		r.Error(fmt.Errorf("error getting topic item for edit; %w", err))
+		http.Error(r.Writer, "item not found", http.StatusNotFound)
+		return nil, nil, false
+	}
+	if len(dbClient.Messages) != 1 {
+		r.Error(fmt.Errorf("error unexpected message count for topic item edit: %d", len(dbClient.Messages)))
+		http.Error(r.Writer, "item not found", http.StatusNotFound)
+		return nil, nil, false
+	}
+	return dbClient, &dbClient.Messages[0], true
+}
+
+// saveAdminEdit records an audit entry for an edit before it is applied, edits are not applied without an audit entry.
+func saveAdminEdit(r admin.Response, adminEdit *item.AdminEdit) bool {
+	adminEdit.Time = time.Now()
+	adminEdit.Remote = r.Request.RemoteAddr
+	log.Printf("Admin topic item %s (%s): %s %d %x\n", adminEdit.Action, adminEdit.Remote, adminEdit.Topic,
+		adminEdit.Shard, adminEdit.Uid)
+	if err := item.SaveAdminEdit(adminEdit); err != nil {
+		r.Error(fmt.Errorf("error saving admin edit audit for topic item; %w", err))
+		http.Error(r.Writer, "error saving audit record, item not changed", http.StatusInternalServerError)
+		return false
+	}
+	return true
+}
+
+var itemDeleteRoute = admin.Route{
+	Pattern: admin.UrlTopicItemDelete,
+	Handler: func(r admin.Response) {
+		var deleteRequest = new(admin.TopicItemDeleteRequest)
+		if err := json.NewDecoder(r.Request.Body).Decode(deleteRequest); err != nil {
+			r.Error(fmt.Errorf("error unmarshalling topic item delete request; %w", err))
+			return
+		}
+		dbClient, msg, ok := getEditItem(r, deleteRequest.Topic, deleteRequest.Shard, deleteRequest.Uid)
+		if !ok {
+			return
+		}
+		if !saveAdminEdit(r, &item.AdminEdit{
+			Uid:        msg.Uid,
+			Topic:      deleteRequest.Topic,
+			Shard:      uint32(deleteRequest.Shard),
+			Action:     item.AdminEditActionDelete,
+			OldMessage: msg.Message,
+		}) {
+			return
+		}
+		if err := dbClient.DeleteMessages(deleteRequest.Topic, [][]byte{msg.Uid}); err != nil {
+			r.Error(fmt.Errorf("error deleting topic item; %w", err))
+			http.Error(r.Writer, "error deleting item", http.StatusInternalServerError)
+			return
+		}
+		if err := json.NewEncoder(r.Writer).Encode(admin.TopicItemResponse{
+			Item: getTopicItem(deleteRequest.Topic, deleteRequest.Shard, msg.Uid, msg.Message),
+		}); err != nil {
+			log.Printf("error writing json topic item delete response data; %v", err)
+			return
+		}
+	},
+}
+
+var itemSaveRoute = admin.Route{
+	Pattern: admin.UrlTopicItemSave,
+	Handler: func(r admin.Response) {
+		var saveRequest = new(admin.TopicItemSaveRequest)
+		if err := json.NewDecoder(r.Request.Body).Decode(saveRequest); err != nil {
+			r.Error(fmt.Errorf("error unmarshalling topic item save request; %w", err))
+			return
+		}
+		dbClient, msg, ok := getEditItem(r, saveRequest.Topic, saveRequest.Shard, saveRequest.Uid)
+		if !ok {
+			return
+		}
+		obj := getTopicObject(saveRequest.Topic)
+		obj.SetUid(msg.Uid)
+		obj.Deserialize(msg.Message)
+		if err := setProps(obj, saveRequest.Props); err != nil {
+			r.Error(fmt.Errorf("error setting props for topic item save; %w", err))
+			http.Error(r.Writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if uid, ok := getUid(obj); !ok || !bytes.Equal(uid, msg.Uid) {
+			r.Error(fmt.Errorf("error topic item save changes uid"))
+			http.Error(r.Writer, "key fields cannot be changed, delete and save a new item instead",
+				http.StatusBadRequest)
+			return
+		}
+		var newMessage = obj.Serialize()
+		if !saveAdminEdit(r, &item.AdminEdit{
+			Uid:        msg.Uid,
+			Topic:      saveRequest.Topic,
+			Shard:      uint32(saveRequest.Shard),
+			Action:     item.AdminEditActionSave,
+			OldMessage: msg.Message,
+			NewMessage: newMessage,
+		}) {
+			return
+		}
+		if err := dbClient.SaveSingle(&client.Message{
+			Topic:   saveRequest.Topic,
+			Uid:     msg.Uid,
+			Message: newMessage,
+		}, time.Now()); err != nil {
+			r.Error(fmt.Errorf("error saving topic item; %w", err))
+			http.Error(r.Writer, "error saving item", http.StatusInternalServerError)
+			return
+		}
+		if err := json.NewEncoder(r.Writer).Encode(admin.TopicItemSaveResponse{
+			Item: getTopicItem(saveRequest.Topic, saveRequest.Shard, msg.Uid, newMessage),
+		}); err != nil {
+			log.Printf("error writing json topic item save response data; %v", err)
+			return
+		}
+	},
+}
@@ -3,24 +3,14 @@ package topic
 import (
 	"encoding/hex"
 	"encoding/json"
-	"github.com/jchavannes/jgo/jutil"
+	"fmt"
 	"github.com/memocash/index/admin/admin"
 	"github.com/memocash/index/db/client"
-	"github.com/memocash/index/db/item"
-	"github.com/memocash/index/db/item/db"
-	"github.com/memocash/index/ref/bitcoin/wallet"
 	"github.com/memocash/index/ref/config"
 	"log"
-	"reflect"
-	"strconv"
-	"strings"
-	"time"
+	"net/http"
 )
 
-var typeOfBytes = reflect.TypeOf([]byte(nil))
-var typeOfBytes25 = reflect.TypeOf([25]byte{})
-var typeOfBytes32 = reflect.TypeOf([32]byte{})
-
 var itemRoute = admin.Route{
 	Pattern: admin.UrlTopicItem,
 	Handler: func(r admin.Response) {
@@ -31,9 +21,10 @@ var itemRoute = admin.Route{
 		}
 		uid, err := hex.DecodeString(topicItemRequest.Uid)
 		if err != nil {
-			log.Printf("error parsing uid for topic item; %v", err)
+			r.Error(fmt.Errorf("error parsing uid for topic item; %w", err))
+			http.Error(r.Writer, "invalid uid", http.StatusBadRequest)
+			return
 		}
-		var topicItemResponse = new(admin.TopicItemResponse)
 		shardConfig := config.GetShardConfig(uint32(topicItemRequest.Shard), config.GetQueueShards())
 		dbClient := client.NewClient(shardConfig.GetHost())
 		if err := dbClient.GetSingle(topicItemRequest.Topic, uid); err != nil {
@@ -44,63 +35,10 @@ var itemRoute = admin.Route{
 			log.Printf("error unexpected message count: %d", len(dbClient.Messages))
 			return
 		}
-		var props = make(map[string]interface{})
-		for _, topic := range item.GetTopicsSorted() {
-			if topic.GetTopic() != topicItemRequest.Topic {
-				continue
-			}
-			objType := reflect.ValueOf(topic).Elem().Type()
-			obj := reflect.New(objType).Interface().(db.Object)
-			obj.SetUid(dbClient.Messages[0].Uid)
-			obj.Deserialize(dbClient.Messages[0].Message)
-			elem := reflect.ValueOf(obj).Elem()
-			for i := 0; i < objType.NumField(); i++ {
-				fieldValue := elem.Field(i)
-				fieldName := elem.Type().Field(i).Name
-				switch fieldValue.Kind() {
-				case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-					props[fieldName] = strconv.FormatInt(fieldValue.Int(), 10)
-				case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-					props[fieldName] = strconv.FormatUint(fieldValue.Uint(), 10)
-				case reflect.Slice:
-					if fieldValue.Type() == typeOfBytes {
-						if strings.Contains(strings.ToLower(fieldName), "txhash") ||
-							strings.Contains(strings.ToLower(fieldName), "blockhash") {
-							props[fieldName] = hex.EncodeToString(jutil.ByteReverse(fieldValue.Bytes()))
-						} else {
-							props[fieldName] = hex.EncodeToString(fieldValue.Bytes())
-						}
-					} else {
-						props[fieldName] = fieldValue.String()
-					}
-				case reflect.Array:
-					if fieldValue.Type() == typeOfBytes25 {
-						props[fieldName] = wallet.GetAddrFromBytes(fieldValue.Bytes()).String()
-					} else if fieldValue.Type() == typeOfBytes32 {
-						props[fieldName] = hex.EncodeToString(jutil.ByteReverse(fieldValue.Bytes()))
-					} else {
-						props[fieldName] = fieldValue.String()
-					}
-				case reflect.String:
-					props[fieldName] = fieldValue.String()
-				case reflect.Bool:
-					props[fieldName] = fieldValue.Bool()
-				default:
-					switch v := fieldValue.Interface().(type) {
-					case time.Time:
-						props[fieldName] = v.Format(time.RFC3339Nano)
-					default:
-						props[fieldName] = fieldValue.String()
-					}
-				}
-			}
-		}
-		topicItemResponse.Item = admin.TopicItem{
-			Topic:   topicItemRequest.Topic,
-			Shard:   topicItemRequest.Shard,
-			Uid:     hex.EncodeToString(dbClient.Messages[0].Uid),
-			Message: hex.EncodeToString(dbClient.Messages[0].Message),
-			Props:   props,
+		var topicItemResponse = admin.TopicItemResponse{
+			Item: getTopicItem(topicItemRequest.Topic, topicItemRequest.Shard,
+				dbClient.Messages[0].Uid, dbClient.Messages[0].Message),
+			Fields: getFields(topicItemRequest.Topic),
 		}
 		if err := json.NewEncoder(r.Writer).Encode(topicItemResponse); err != nil {
 			log.Printf("error writing json topic item response data; %v", err)
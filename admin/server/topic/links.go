@@ -0,0 +1,109 @@
+package topic
+
+import (
+	"github.com/memocash/index/admin/admin"
+	"github.com/memocash/index/db/item/db"
+)
+
+// topicLink maps key fields of a linked topic to the fields of an item they are set from.
+type topicLink struct {
+	Name   string
+	Topic  string
+	Fields map[string]string
+}
+
+var txHashFields = map[string]string{"TxHash": "TxHash"}
+
+var topicLinks = map[string][]topicLink{
+	db.TopicChainTx: {
+		{Name: "Inputs", Topic: db.TopicChainTxInput, Fields: txHashFields},
+		{Name: "Outputs", Topic: db.TopicChainTxOutput, Fields: txHashFields},
+		{Name: "Blocks", Topic: db.TopicChainTxBlock, Fields: txHashFields},
+		{Name: "Seen", Topic: db.TopicChainTxSeen, Fields: txHashFields},
+	},
+	db.TopicChainTxInput: {
+		{Name: "Tx", Topic: db.TopicChainTx, Fields: txHashFields},
+		{Name: "Prev Output", Topic: db.TopicChainTxOutput, Fields: map[string]string{
+			"TxHash": "PrevHash",
+			"Index":  "PrevIndex",
+		}},
+	},
+	db.TopicChainTxOutput: {
+		{Name: "Tx", Topic: db.TopicChainTx, Fields: txHashFields},
+		{Name: "Spends", Topic: db.TopicChainOutputInput, Fields: map[string]string{
+			"PrevHash":  "TxHash",
+			"PrevIndex": "Index",
+		}},
+		{Name: "SLP Output", Topic: db.TopicSlpOutput, Fields: map[string]string{"TxHash": "TxHash", "Index": "Index"}},
+		{Name: "SLP Baton", Topic: db.TopicSlpBaton, Fields: map[string]string{"TxHash": "TxHash", "Index": "Index"}},
+	},
+	db.TopicChainOutputInput: {
+		{Name: "Output", Topic: db.TopicChainTxOutput, Fields: map[string]string{
+			"TxHash": "PrevHash",
+			"Index":  "PrevIndex",
+		}},
+		{Name: "Input", Topic: db.TopicChainTxInput, Fields: map[string]string{"TxHash": "Hash", "Index": "Index"}},
+		{Name: "Spending Tx", Topic: db.TopicChainTx, Fields: map[string]string{"TxHash": "Hash"}},
+	},
+	db.TopicChainTxBlock: {
+		{Name: "Tx", Topic: db.TopicChainTx, Fields: txHashFields},
+		{Name: "Block", Topic: db.TopicChainBlock, Fields: map[string]string{"Hash": "BlockHash"}},
+		{Name: "Block Height", Topic: db.TopicChainBlockHeight, Fields: map[string]string{"BlockHash": "BlockHash"}},
+	},
+	db.TopicChainTxSeen: {
+		{Name: "Tx", Topic: db.TopicChainTx, Fields: txHashFields},
+	},
+	db.TopicChainBlock: {
+		{Name: "Txs", Topic: db.TopicChainBlockTx, Fields: map[string]string{"BlockHash": "Hash"}},
+		{Name: "Height", Topic: db.TopicChainBlockHeight, Fields: map[string]string{"BlockHash": "Hash"}},
+		{Name: "Info", Topic: db.TopicChainBlockInfo, Fields: map[string]string{"BlockHash": "Hash"}},
+	},
+	db.TopicChainBlockTx: {
+		{Name: "Block", Topic: db.TopicChainBlock, Fields: map[string]string{"Hash": "BlockHash"}},
+		{Name: "Tx", Topic: db.TopicChainTx, Fields: txHashFields},
+	},
+	db.TopicChainBlockHeight: {
+		{Name: "Block", Topic: db.TopicChainBlock, Fields: map[string]string{"Hash": "BlockHash"}},
+		{Name: "Height Blocks", Topic: db.TopicChainHeightBlock, Fields: map[string]string{"Height": "Height"}},
+	},
+	db.TopicChainHeightBlock: {
+		{Name: "Block", Topic: db.TopicChainBlock, Fields: map[string]string{"Hash": "BlockHash"}},
+	},
+	db.TopicSlpOutput: {
+		{Name: "Output", Topic: db.TopicChainTxOutput, Fields: map[string]string{"TxHash": "TxHash", "Index": "Index"}},
+		{Name: "Genesis", Topic: db.TopicSlpGenesis, Fields: map[string]string{"TxHash": "TokenHash"}},
+	},
+	db.TopicSlpBaton: {
+		{Name: "Output", Topic: db.TopicChainTxOutput, Fields: map[string]string{"TxHash": "TxHash", "Index": "Index"}},
+		{Name: "Genesis", Topic: db.TopicSlpGenesis, Fields: map[string]string{"TxHash": "TokenHash"}},
+	},
+	db.TopicSlpGenesis: {
+		{Name: "Tx", Topic: db.TopicChainTx, Fields: txHashFields},
+	},
+	db.TopicAddrSeenTx: {
+		{Name: "Tx", Topic: db.TopicChainTx, Fields: txHashFields},
+	},
+}
+
+// getLinks returns searches for items related to an item from its decoded props, such as a tx to its outputs and an
+// output to its spends.
+func getLinks(topic string, props map[string]interface{}) []admin.TopicLink {
+	var links []admin.TopicLink
+LinkLoop:
+	for _, link := range topicLinks[topic] {
+		var prefix = make(map[string]string)
+		for linkField, field := range link.Fields {
+			prop, ok := props[field].(string)
+			if !ok {
+				continue LinkLoop
+			}
+			prefix[linkField] = prop
+		}
+		links = append(links, admin.TopicLink{
+			Name:   link.Name,
+			Topic:  link.Topic,
+			Prefix: prefix,
+		})
+	}
+	return links
+}
@@ -7,5 +7,7 @@ func GetRoutes() []admin.Route {
 		listRoute,
 		viewRoute,
 		itemRoute,
+		itemDeleteRoute,
+		itemSaveRoute,
 	}
 }
@@ -1,6 +1,7 @@
 package topic
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"github.com/memocash/index/db/client"
 	"github.com/memocash/index/ref/config"
 	"log"
+	"net/http"
 )
 
 var viewRoute = admin.Route{
@@ -22,27 +24,63 @@ var viewRoute = admin.Route{
 		if topicViewRequest.Start != "" {
 			var err error
 			if start, err = hex.DecodeString(topicViewRequest.Start); err != nil {
-				fmt.Errorf("error parsing start from topic view request; %w", err)
+				r.Error(fmt.Errorf("error parsing start from topic view request; %w", err))
+				http.Error(r.Writer, "invalid start", http.StatusBadRequest)
 				return
 			}
 		}
-		var topicViewResponse = new(admin.TopicViewResponse)
+		var prefixes [][]byte
+		var to []byte
+		if len(topicViewRequest.Prefix) > 0 || len(topicViewRequest.From) > 0 || len(topicViewRequest.To) > 0 {
+			prefix, err := getKeyPrefix(topicViewRequest.Topic, topicViewRequest.Prefix)
+			if err != nil {
+				r.Error(fmt.Errorf("error getting prefix for topic view request; %w", err))
+				http.Error(r.Writer, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if len(prefix) > 0 {
+				prefixes = [][]byte{prefix}
+			}
+			from, err := getKeyPrefix(topicViewRequest.Topic, topicViewRequest.From)
+			if err != nil {
+				r.Error(fmt.Errorf("error getting from for topic view request; %w", err))
+				http.Error(r.Writer, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if bytes.Compare(from, start) > 0 {
+				start = from
+			}
+			if len(topicViewRequest.To) > 0 {
+				if to, err = getKeyPrefix(topicViewRequest.Topic, topicViewRequest.To); err != nil {
+					r.Error(fmt.Errorf("error getting to for topic view request; %w", err))
+					http.Error(r.Writer, err.Error(), http.StatusBadRequest)
+					return
+				}
+			}
+		}
+		var topicViewResponse = admin.TopicViewResponse{
+			Name:   topicViewRequest.Topic,
+			Fields: getFields(topicViewRequest.Topic),
+		}
 		for _, shardConfig := range config.GetQueueShards() {
 			if topicViewRequest.Shard >= 0 && uint32(topicViewRequest.Shard) != shardConfig.Shard {
 				continue
 			}
 			db := client.NewClient(shardConfig.GetHost())
-			if err := db.Get(topicViewRequest.Topic, start, false); err != nil {
+			if err := db.GetWOpts(client.Opts{
+				Topic:    topicViewRequest.Topic,
+				Start:    start,
+				Prefixes: prefixes,
+			}); err != nil {
 				log.Printf("error getting topic items for admin view; %v", err)
 				return
 			}
 			for _, msg := range db.Messages {
-				topicViewResponse.Items = append(topicViewResponse.Items, admin.TopicItem{
-					Topic:   topicViewRequest.Topic,
-					Uid:     hex.EncodeToString(msg.Uid),
-					Message: hex.EncodeToString(msg.Message),
-					Shard:   uint(shardConfig.Shard),
-				})
+				if len(to) > 0 && isAfterKey(msg.Uid, to) {
+					break
+				}
+				topicViewResponse.Items = append(topicViewResponse.Items,
+					getTopicItem(topicViewRequest.Topic, uint(shardConfig.Shard), msg.Uid, msg.Message))
 			}
 		}
 		if err := json.NewEncoder(r.Writer).Encode(topicViewResponse); err != nil {
@@ -51,3 +89,11 @@ var viewRoute = admin.Route{
 		}
 	},
 }
+
+// isAfterKey checks if a UID sorts after every UID starting with the key prefix.
+func isAfterKey(uid, key []byte) bool {
+	if len(uid) > len(key) {
+		uid = uid[:len(key)]
+	}
+	return bytes.Compare(uid, key) > 0
+}
@@ -110,6 +110,21 @@ export default function Page(props) {
                                 Peer Report
                             </Link>
                         </li>
+                        <li>
+                            <Link href="/peer/sync">
+                                Sync Progress
+                            </Link>
+                        </li>
+                        <li>
+                            <Link href="/peer/network">
+                                Network Map
+                            </Link>
+                        </li>
+                        <li>
+                            <Link href="/peer/network_peers">
+                                Network Peers
+                            </Link>
+                        </li>
                     </ul>
                     <h3>Storage</h3>
                     <ul>
@@ -0,0 +1,36 @@
+// Key field searches are stored in the url query as "<search>.<Field>=<value>", search is prefix, from or to.
+export const KeySearches = ["prefix", "from", "to"]
+
+export function getKeyQuery(search, values) {
+    let query = {}
+    for (const field of Object.keys(values || {})) {
+        if (values[field] !== undefined && values[field].length) {
+            query[search + "." + field] = values[field]
+        }
+    }
+    return query
+}
+
+export function parseKeyQuery(query, search) {
+    let values = {}
+    for (const key of Object.keys(query || {})) {
+        if (key.startsWith(search + ".")) {
+            values[key.substring(search.length + 1)] = query[key]
+        }
+    }
+    return values
+}
+
+export function getLinkHref(link) {
+    return {
+        pathname: "/topic/" + link.Topic,
+        query: getKeyQuery("prefix", link.Prefix),
+    }
+}
+
+export function formatProp(prop) {
+    if (typeof prop === "boolean") {
+        return prop ? "True" : "False"
+    }
+    return prop
+}
@@ -0,0 +1,13 @@
+import {GetHost} from "../../../components/config"
+
+export default function handler(req, res) {
+    return new Promise((resolve, reject) => {
+        const url = req.query.action === "stop" ? "/node/crawler_stop" : "/node/crawler_start"
+        fetch(GetHost() + url).then(() => {
+            res.status(200).json({})
+            resolve()
+        }).catch(error => {
+            reject(error)
+        })
+    })
+}
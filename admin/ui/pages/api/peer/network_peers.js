@@ -0,0 +1,18 @@
+import {GetHost} from "../../../components/config"
+
+export default function handler(req, res) {
+    return new Promise((resolve, reject) => {
+        const {reachableOnly} = JSON.parse(req.body)
+        fetch(GetHost() + "/node/network_peers", {
+            method: "POST",
+            body: JSON.stringify({
+                ReachableOnly: reachableOnly
+            })
+        }).then(res => res.json()).then(data => {
+            res.status(200).json(data)
+            resolve()
+        }).catch(error => {
+            reject(error)
+        })
+    })
+}
@@ -0,0 +1,30 @@
+import {GetHost} from "../../../components/config"
+
+export default function handler(req, res) {
+    return new Promise((resolve, reject) => {
+        const {topic, shard, uid, props} = JSON.parse(req.body)
+        const shardInt = parseInt(shard)
+        let {server} = req.headers
+        if (!server || !server.length) {
+            server = GetHost()
+        }
+        fetch(server + "/topic/item_save", {
+            method: "POST",
+            body: JSON.stringify({
+                Topic: topic,
+                Shard: shardInt,
+                Uid: uid,
+                Props: props,
+            }),
+        }).then(async apiRes => {
+            if (!apiRes.ok) {
+                res.status(apiRes.status).send(await apiRes.text())
+            } else {
+                res.status(200).json(await apiRes.json())
+            }
+            resolve()
+        }).catch(error => {
+            reject(error)
+        })
+    })
+}
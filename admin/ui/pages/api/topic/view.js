@@ -2,7 +2,7 @@ import {GetHost} from "../../../components/config"
 
 export default function handler(req, res) {
     return new Promise((resolve, reject) => {
-        const {topic, start, shard} = JSON.parse(req.body)
+        const {topic, start, shard, prefix, from, to} = JSON.parse(req.body)
         const shardInt = parseInt(shard)
         let {server} = req.headers
         if (!server || !server.length) {
@@ -14,6 +14,9 @@ export default function handler(req, res) {
                 Topic: topic,
                 Start: start,
                 Shard: shardInt,
+                Prefix: prefix,
+                From: from,
+                To: to,
             }),
         }).then(res => res.json()).then(data => {
             res.status(200).json(data)
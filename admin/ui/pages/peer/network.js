@@ -0,0 +1,80 @@
+import Page from "../../components/page";
+import {useEffect, useState} from "react";
+import styles from "../../styles/Home.module.css";
+
+function Counts({title, counts}) {
+    return (
+        <>
+            <h3>{title}</h3>
+            <ul>
+                {counts.map((count, key) => (
+                    <li key={key}>{count.Name || "(none)"}: {count.Count}</li>
+                ))}
+            </ul>
+        </>
+    )
+}
+
+function Network() {
+    const [loading, setLoading] = useState(true)
+    const [errorMessage, setErrorMessage] = useState("")
+    const [networkMap, setNetworkMap] = useState({})
+    const [refresh, setRefresh] = useState(0)
+    useEffect(() => {
+        fetch("/api/peer/network_map").then(res => {
+            if (res.ok) {
+                return res.json()
+            }
+            return Promise.reject(res)
+        }).then(data => {
+            setNetworkMap(data)
+            setLoading(false)
+        }).catch(res => {
+            res.text().then(msg => {
+                setErrorMessage(<>Code: {res.status}<br/>Message: {msg}</>)
+            })
+        })
+    }, [refresh])
+
+    const toggleCrawler = () => {
+        const action = networkMap.CrawlerRunning ? "stop" : "start"
+        fetch("/api/peer/crawler?action=" + action).then(() => {
+            setRefresh(refresh + 1)
+        })
+    }
+
+    return (
+        <Page>
+            <div>
+                <h2 className={styles.subTitle}>
+                    Network Map
+                </h2>
+                {loading ?
+                    <>{!!errorMessage ?
+                        <>Error: {errorMessage}</>
+                        :
+                        <>Loading...</>
+                    }</>
+                    :
+                    <div>
+                        <p>
+                            Crawler: {networkMap.CrawlerRunning ? "Running" : "Stopped"} (crawled: {networkMap.Crawled},
+                            failed: {networkMap.Failed}) <button onClick={toggleCrawler}>
+                                {networkMap.CrawlerRunning ? "Stop" : "Start"}
+                            </button>
+                        </p>
+                        <ul>
+                            <li>Peers: {networkMap.TotalPeers}</li>
+                            <li>Reachable: {networkMap.Reachable}</li>
+                        </ul>
+                        <Counts title="User Agents" counts={networkMap.UserAgents}/>
+                        <Counts title="Protocol Versions" counts={networkMap.Versions}/>
+                        <Counts title="Heights" counts={networkMap.Heights}/>
+                    </div>
+                }
+            </div>
+        </Page>
+    )
+}
+
+export default Network
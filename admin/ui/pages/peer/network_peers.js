@@ -0,0 +1,85 @@
+import Page from "../../components/page";
+import Pagination from "../../components/util/pagination";
+import {useEffect, useRef, useState} from "react";
+import styles from '../../styles/list.module.css';
+import homeStyles from "../../styles/Home.module.css";
+import dropdownStyles from '../../styles/dropdown.module.css';
+
+function NetworkPeers() {
+    const [loading, setLoading] = useState(true)
+    const [allPeers, setAllPeers] = useState([])
+    const [peers, setPeers] = useState([])
+    const [errorMessage, setErrorMessage] = useState("")
+    const [reachableOnly, setReachableOnly] = useState(true)
+    const PageLimit = 20
+    const inputPagination = useRef(null)
+
+    useEffect(() => {
+        fetch("/api/peer/network_peers", {
+            method: "POST",
+            body: JSON.stringify({
+                reachableOnly: reachableOnly,
+            })
+        }).then(res => {
+            if (res.ok) {
+                return res.json()
+            }
+            return Promise.reject(res)
+        }).then(data => {
+            setAllPeers(data.Peers)
+            setPeers(data.Peers.slice(0, PageLimit))
+            setLoading(false)
+        }).catch(res => {
+            res.text().then(msg => {
+                setErrorMessage(<>Code: {res.status}<br/>Message: {msg}</>)
+            })
+        })
+    }, [reachableOnly])
+
+    const onPageChanged = (data) => {
+        const {currentPage} = data
+        const offset = (currentPage - 1) * PageLimit
+        setPeers(allPeers.slice(offset, offset + PageLimit))
+    }
+
+    return (
+        <Page>
+            <div>
+                <h2 className={homeStyles.subTitle}>
+                    Network Peers
+                </h2>
+                <div>
+                    <select className={dropdownStyles.select} value={reachableOnly ? "reachable" : "all"}
+                            onChange={e => setReachableOnly(e.target.value === "reachable")}>
+                        <option value={"reachable"}>Reachable</option>
+                        <option value={"all"}>All</option>
+                    </select>
+                </div>
+                {loading ?
+                    <>{!!errorMessage ?
+                        <>Error: {errorMessage}</>
+                        :
+                        <>Loading...</>
+                    }</>
+                    :
+                    <div>
+                        <ul className={styles.list}>
+                            {peers.map((peer, key) => (
+                                <li key={key}>
+                                    {peer.Ip}:{peer.Port} - {peer.UserAgent} ({peer.ProtocolVersion}) -
+                                    height: {peer.Height} - uptime: {(peer.Uptime * 100).toFixed(0)}% -
+                                    reliability: {(peer.Reliability * 100).toFixed(0)}%
+                                    ({peer.Successes}/{peer.Attempts})
+                                </li>
+                            ))}
+                        </ul>
+                        <Pagination ref={inputPagination} totalRecords={allPeers.length} pageLimit={PageLimit}
+                                    pageNeighbours={1} onPageChanged={onPageChanged}/>
+                    </div>
+                }
+            </div>
+        </Page>
+    )
+}
+
+export default NetworkPeers
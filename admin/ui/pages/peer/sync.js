@@ -0,0 +1,54 @@
+import Page from "../../components/page";
+import {useEffect, useState} from "react";
+import styles from "../../styles/Home.module.css";
+
+function Sync() {
+    const [loading, setLoading] = useState(true)
+    const [errorMessage, setErrorMessage] = useState("")
+    const [progress, setProgress] = useState({})
+    useEffect(() => {
+        fetch("/api/peer/sync").then(res => {
+            if (res.ok) {
+                return res.json()
+            }
+            return Promise.reject(res)
+        }).then(data => {
+            setProgress(data)
+            setLoading(false)
+        }).catch(res => {
+            res.text().then(msg => {
+                setErrorMessage(<>Code: {res.status}<br/>Message: {msg}</>)
+            })
+        })
+    }, [])
+
+    return (
+        <Page>
+            <div>
+                <h2 className={styles.subTitle}>
+                    Sync Progress
+                </h2>
+                {loading ?
+                    <>{!!errorMessage ?
+                        <>Error: {errorMessage}</>
+                        :
+                        <>Loading...</>
+                    }</>
+                    :
+                    <ul>
+                        <li>Status: {progress.Complete ? "Complete" : "Initial sync"}</li>
+                        <li>Height: {progress.Height} / {progress.Target}</li>
+                        <li>Start Height: {progress.StartHeight}</li>
+                        <li>In Flight: {progress.InFlight}</li>
+                        <li>Rate: {progress.Rate.toFixed(2)} blocks/s</li>
+                        <li>ETA: {progress.Eta}</li>
+                        <li>Started: {progress.Started}</li>
+                        <li>Updated: {progress.Updated}</li>
+                    </ul>
+                }
+            </div>
+        </Page>
+    )
+}
+
+export default Sync
@@ -4,33 +4,27 @@ import Link from "next/link";
 import {useRouter} from "next/router";
 import {useEffect, useRef, useState} from "react";
 import {getUrl} from "../../components/fetch";
+import {formatProp, getKeyQuery, getLinkHref, KeySearches, parseKeyQuery} from "../../components/topic";
 
 export default function Topic() {
     const [topic, setTopic] = useState("")
     const startRef = useRef()
     const shardRef = useRef()
+    const [keyValues, setKeyValues] = useState({prefix: {}, from: {}, to: {}})
     const [topicData, setTopicData] = useState({
+        Fields: [],
         Items: [],
     })
     const router = useRouter()
-    let lastTopic = undefined
-    let lastShard = undefined
-    let lastStart = undefined
+    let lastQuery = undefined
     useEffect(() => {
-        if (!router || !router.query || (router.query.topic === lastTopic && router.query.shard === lastShard &&
-            router.query.start === lastStart)) {
+        if (!router || !router.query || JSON.stringify(router.query) === lastQuery) {
             return
         }
-        lastTopic = router.query.topic
-        lastShard = router.query.shard
-        lastStart = router.query.start
+        lastQuery = JSON.stringify(router.query)
         setTopic(router.query.topic)
-        if (router.query.start && router.query.start.length) {
-            startRef.current.value = router.query.start
-        }
-        if (router.query.shard && router.query.shard.length) {
-            shardRef.current.value = router.query.shard
-        }
+        startRef.current.value = router.query.start || ""
+        shardRef.current.value = router.query.shard || ""
         let data = {
             topic: router.query.topic,
             shard: router.query.shard,
@@ -41,6 +35,12 @@ export default function Topic() {
         if (router.query.start !== undefined) {
             data.start = router.query.start
         }
+        let values = {}
+        for (const search of KeySearches) {
+            values[search] = parseKeyQuery(router.query, search)
+            data[search] = values[search]
+        }
+        setKeyValues(values)
         getUrl("/api/topic/view", {
             method: "POST",
             body: JSON.stringify(data),
@@ -55,9 +55,19 @@ export default function Topic() {
             console.log(err)
         })
     }, [router])
+    const setKeyValue = (search, field, value) => {
+        setKeyValues({...keyValues, [search]: {...keyValues[search], [field]: value}})
+    }
+    const getSearchQuery = () => {
+        let query = {}
+        for (const search of KeySearches) {
+            query = {...query, ...getKeyQuery(search, keyValues[search])}
+        }
+        return query
+    }
     const formSubmit = (e) => {
         e.preventDefault()
-        let query = {}
+        let query = getSearchQuery()
         if (startRef.current.value.length) {
             query.start = startRef.current.value
         }
@@ -69,6 +79,8 @@ export default function Topic() {
             query: query,
         })
     }
+    const keyFields = (topicData.Fields || []).filter(field => field.Key)
+    const lastItem = topicData.Items && topicData.Items.length ? topicData.Items[topicData.Items.length - 1] : undefined
     return (
         <Page>
             <div>
@@ -81,6 +93,31 @@ export default function Topic() {
                     </Link>
                 </h4>
                 <form onSubmit={formSubmit}>
+                    {keyFields.length > 0 &&
+                        <table>
+                            <thead>
+                            <tr>
+                                <th>Key Field</th>
+                                <th>Prefix</th>
+                                <th>From</th>
+                                <th>To</th>
+                            </tr>
+                            </thead>
+                            <tbody>
+                            {keyFields.map((field, key) => (
+                                <tr key={key}>
+                                    <td>{field.Name} ({field.Type})</td>
+                                    {KeySearches.map(search => (
+                                        <td key={search}>
+                                            <input type={"text"} value={keyValues[search][field.Name] || ""}
+                                                   onChange={(e) => setKeyValue(search, field.Name, e.target.value)}/>
+                                        </td>
+                                    ))}
+                                </tr>
+                            ))}
+                            </tbody>
+                        </table>
+                    }
                     <label>Start UID: <input type={"text"} ref={startRef}/></label>
                     &nbsp;&nbsp;
                     <label>Shard (empty=all): <input type={"number"} ref={shardRef}/></label>
@@ -88,19 +125,35 @@ export default function Topic() {
                     <input type={"submit"} value={"Update"}/>
                 </form>
                 {topicData.Items && topicData.Items.map((item, key) => (
-                    <p key={key}>{item.Shard}: <Link href={{
-                        pathname: "/topic/" + topic + "/" + item.Uid,
-                        query: {shard: item.Shard},
-                    }}>{item.Uid}</Link></p>
+                    <div key={key}>
+                        <p>{item.Shard}: <Link href={{
+                            pathname: "/topic/" + topic + "/" + item.Uid,
+                            query: {shard: item.Shard},
+                        }}>{item.Uid}</Link></p>
+                        <ul>
+                            {item.Props && (topicData.Fields || []).map((field, i) => (
+                                <li key={i}>{field.Name}: {formatProp(item.Props[field.Name])}</li>
+                            ))}
+                            {item.Links && item.Links.length > 0 &&
+                                <li>Links: {item.Links.map((link, i) => (
+                                    <span key={i}>
+                                        {i > 0 && <> &middot; </>}
+                                        <Link href={getLinkHref(link)}>{link.Name}</Link>
+                                    </span>
+                                ))}</li>
+                            }
+                        </ul>
+                    </div>
                 ))}
                 <p>
                     <Link href={{pathname: "/topic/list"}}>
                         Back to List
-                    </Link> &middot; {topicData.Items && topicData.Items.length &&
+                    </Link> &middot; {lastItem &&
                     <Link href={{
                         pathname: "/topic/" + topic, query: {
-                            shard: topicData.Items[topicData.Items.length - 1].Shard,
-                            start: topicData.Items[topicData.Items.length - 1].Uid,
+                            ...getSearchQuery(),
+                            shard: lastItem.Shard,
+                            start: lastItem.Uid,
                         }
                     }}>
                         Next Page
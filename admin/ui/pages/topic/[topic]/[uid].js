@@ -4,12 +4,16 @@ import Link from "next/link";
 import {useRouter} from "next/router";
 import {useEffect, useState} from "react";
 import {getUrl} from "../../../components/fetch";
+import {formatProp, getLinkHref} from "../../../components/topic";
 
 export default function Topic() {
     const [topic, setTopic] = useState("")
     const [shard, setShard] = useState(undefined)
     const [uid, setUid] = useState(undefined)
     const [item, setItem] = useState({Message: ""})
+    const [fields, setFields] = useState([])
+    const [editProps, setEditProps] = useState(undefined)
+    const [status, setStatus] = useState("")
     const router = useRouter()
     let lastTopic = undefined
     let lastUid = undefined
@@ -39,10 +43,52 @@ export default function Topic() {
             return Promise.reject(res)
         }).then(data => {
             setItem(data.Item)
+            setFields(data.Fields || [])
         }).catch(err => {
             console.log(err)
         })
     }, [router])
+    const postEdit = (action, body) => {
+        return getUrl("/api/topic/item_" + action, {
+            method: "POST",
+            body: JSON.stringify({topic, shard, uid, ...body}),
+        }).then(async res => {
+            if (res.ok) {
+                return res.json()
+            }
+            return Promise.reject(await res.text())
+        })
+    }
+    const startEdit = () => {
+        let props = {}
+        for (const field of fields) {
+            if (!field.Key) {
+                props[field.Name] = String(item.Props[field.Name])
+            }
+        }
+        setEditProps(props)
+        setStatus("")
+    }
+    const saveEdit = () => {
+        postEdit("save", {props: editProps}).then(data => {
+            setItem(data.Item)
+            setEditProps(undefined)
+            setStatus("Item saved")
+        }).catch(err => {
+            setStatus("Error saving item: " + err)
+        })
+    }
+    const deleteItem = () => {
+        if (!window.confirm("Delete item " + uid + " from " + topic + "?")) {
+            return
+        }
+        postEdit("delete", {}).then(() => {
+            setItem({Message: ""})
+            setStatus("Item deleted")
+        }).catch(err => {
+            setStatus("Error deleting item: " + err)
+        })
+    }
     return (
         <Page>
             <div>
@@ -59,13 +105,34 @@ export default function Topic() {
                     {item.Message}
                 </div>
                 <ul>
-                    {item.Props && Object.keys(item.Props).map((key, i) => {
-                        return (
-                            <li key={i}>{key}: {(typeof item.Props[key] === "boolean") ?
-                                (item.Props[key] ? "True" : "False") : item.Props[key]}</li>
-                        )
-                    })}
+                    {item.Props && fields.map((field, i) => (
+                        <li key={i}>{field.Name}{field.Key && " (key)"}: {editProps && !field.Key ?
+                            <input type={"text"} value={editProps[field.Name]} onChange={(e) =>
+                                setEditProps({...editProps, [field.Name]: e.target.value})}/> :
+                            formatProp(item.Props[field.Name])}</li>
+                    ))}
                 </ul>
+                {item.Links && item.Links.length > 0 &&
+                    <ul>
+                        {item.Links.map((link, i) => (
+                            <li key={i}><Link href={getLinkHref(link)}>{link.Name}</Link> ({link.Topic})</li>
+                        ))}
+                    </ul>
+                }
+                {item.Props &&
+                    <p>
+                        {editProps ? <>
+                            <button onClick={saveEdit}>Save</button>
+                            &nbsp;
+                            <button onClick={() => setEditProps(undefined)}>Cancel</button>
+                        </> : <>
+                            <button onClick={startEdit}>Edit</button>
+                            &nbsp;
+                            <button onClick={deleteItem}>Delete</button>
+                        </>}
+                    </p>
+                }
+                {status && <p>{status}</p>}
                 <p>
                     <Link href={{pathname: "/topic/list"}}>
                         Back to List
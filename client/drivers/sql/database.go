@@ -17,8 +17,9 @@ type Query struct {
 }
 
 type Database struct {
-	Prefix string
-	Db     *sql.DB
+	Prefix  string
+	Db      *sql.DB
+	Dialect Dialect
 }
 
 func (d *Database) GetTableName(table string) string {
@@ -34,38 +35,51 @@ func (d *Database) GetInsert(table string, values map[string]interface{}) *Query
 	if !ok {
 		return nil
 	}
-	var q = t.GetInsert(d.Prefix, values)
+	var q = t.GetInsert(d.Dialect, d.Prefix, values)
 	return &q
 }
 
+// NewDatabase returns a sqlite database, use NewDatabaseWithDialect for other databases.
 func NewDatabase(db *sql.DB, prefix string) (*Database, error) {
-	if err := initDb(db, prefix); err != nil {
-		return nil, fmt.Errorf("error initializing database; %w", err)
+	return NewDatabaseWithDialect(db, DialectSqlite, prefix)
+}
+
+// NewDatabaseWithDialect returns a database using the named dialect (sqlite, postgres or mysql) and applies any
+// pending schema migrations.
+func NewDatabaseWithDialect(db *sql.DB, dialectName string, prefix string) (*Database, error) {
+	dialect, err := GetDialect(dialectName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting database dialect; %w", err)
+	}
+	if err := migrate(db, dialect, prefix); err != nil {
+		return nil, fmt.Errorf("error migrating database; %w", err)
 	}
 	return &Database{
-		Db:     db,
-		Prefix: prefix,
+		Db:      db,
+		Prefix:  prefix,
+		Dialect: dialect,
 	}, nil
 }
 
 func (d *Database) GetAddressBalance(addresses []wallet.Addr) (*lib.Balance, error) {
+	index := d.Dialect.Quote("index")
 	query := "" +
 		"SELECT " +
-		"   IFNULL(SUM(CASE WHEN inputs.hash IS NULL THEN outputs.value ELSE 0 END), 0) AS balance, " +
-		"   IFNULL(SUM(CASE WHEN inputs.hash IS NULL THEN 1 ELSE 0 END), 0) AS utxo_count, " +
-		"   IFNULL(SUM(CASE WHEN inputs.hash IS NULL AND slp_outputs.hash IS NULL AND slp_batons.hash IS NULL THEN outputs.value ELSE 0 END), 0) AS spendable, " +
-		"   IFNULL(SUM(CASE WHEN inputs.hash IS NULL AND slp_outputs.hash IS NULL AND slp_batons.hash IS NULL THEN 1 ELSE 0 END), 0) AS spendable_count " +
+		"   COALESCE(SUM(CASE WHEN inputs.hash IS NULL THEN outputs.value ELSE 0 END), 0) AS balance, " +
+		"   COALESCE(SUM(CASE WHEN inputs.hash IS NULL THEN 1 ELSE 0 END), 0) AS utxo_count, " +
+		"   COALESCE(SUM(CASE WHEN inputs.hash IS NULL AND slp_outputs.hash IS NULL AND slp_batons.hash IS NULL THEN outputs.value ELSE 0 END), 0) AS spendable, " +
+		"   COALESCE(SUM(CASE WHEN inputs.hash IS NULL AND slp_outputs.hash IS NULL AND slp_batons.hash IS NULL THEN 1 ELSE 0 END), 0) AS spendable_count " +
 		"FROM " + d.GetTableName(TableOutputs) + " outputs " +
-		"LEFT JOIN " + d.GetTableName(TableInputs) + " inputs ON (inputs.prev_hash = outputs.hash AND inputs.prev_index = outputs.`index`) " +
-		"LEFT JOIN " + d.GetTableName(TableSlpOutputs) + " slp_outputs ON (slp_outputs.hash = outputs.hash AND slp_outputs.`index` = outputs.`index`) " +
-		"LEFT JOIN " + d.GetTableName(TableSlpBatons) + " slp_batons ON (slp_batons.hash = outputs.hash AND slp_batons.`index` = outputs.`index`) " +
+		"LEFT JOIN " + d.GetTableName(TableInputs) + " inputs ON (inputs.prev_hash = outputs.hash AND inputs.prev_index = outputs." + index + ") " +
+		"LEFT JOIN " + d.GetTableName(TableSlpOutputs) + " slp_outputs ON (slp_outputs.hash = outputs.hash AND slp_outputs." + index + " = outputs." + index + ") " +
+		"LEFT JOIN " + d.GetTableName(TableSlpBatons) + " slp_batons ON (slp_batons.hash = outputs.hash AND slp_batons." + index + " = outputs." + index + ") " +
 		"WHERE outputs.address IN (" + db_util.GetQuestionMarksCombined(len(addresses)) + ")"
 	var addressStrings = make([]interface{}, len(addresses))
 	for i := range addresses {
 		addressStrings[i] = addresses[i].String()
 	}
 	var result = new(lib.Balance)
-	if err := d.Db.QueryRow(query, addressStrings...).Scan(
+	if err := d.Db.QueryRow(d.Dialect.Rebind(query), addressStrings...).Scan(
 		&result.Balance,
 		&result.UtxoCount,
 		&result.Spendable,
@@ -80,13 +94,12 @@ func (d *Database) GetAddressLastUpdate(addresses []wallet.Addr) ([]graph.Addres
 	query := "" +
 		"SELECT address, time " +
 		"FROM " + d.GetTableName(TableAddressUpdates) + " " +
-		"WHERE address IN (" + db_util.GetQuestionMarksCombined(len(addresses)) + ") " +
-		"GROUP BY address"
+		"WHERE address IN (" + db_util.GetQuestionMarksCombined(len(addresses)) + ")"
 	var addressStrings = make([]interface{}, len(addresses))
 	for i := range addresses {
 		addressStrings[i] = addresses[i].String()
 	}
-	rows, err := d.Db.Query(query, addressStrings...)
+	rows, err := d.Db.Query(d.Dialect.Rebind(query), addressStrings...)
 	if err != nil {
 		return nil, fmt.Errorf("error address last update exec query; %w", err)
 	}
@@ -123,32 +136,83 @@ AddressLoop:
 	return addressUpdates, nil
 }
 
+// GetUtxos returns unspent outputs for the addresses including any SLP token or baton on the output so callers can
+// avoid spending tokens as BCH.
 func (d *Database) GetUtxos(addresses []wallet.Addr) ([]graph.Output, error) {
+	index := d.Dialect.Quote("index")
 	query := "" +
 		"SELECT " +
 		"	outputs.hash, " +
-		"	outputs.`index`, " +
+		"	outputs." + index + ", " +
 		"	outputs.address, " +
 		"	outputs.script, " +
-		"	outputs.value " +
+		"	outputs.value, " +
+		"	slp_outputs.token_hash, " +
+		"	slp_outputs.amount, " +
+		"	slp_batons.token_hash, " +
+		"	slp_geneses.token_type, " +
+		"	slp_geneses.decimals, " +
+		"	slp_geneses.ticker, " +
+		"	slp_geneses.name, " +
+		"	slp_geneses.doc_url " +
 		"FROM " + d.GetTableName(TableOutputs) + " outputs " +
-		"LEFT JOIN " + d.GetTableName(TableInputs) + " inputs ON (inputs.prev_hash = outputs.hash AND inputs.prev_index = outputs.`index`) " +
+		"LEFT JOIN " + d.GetTableName(TableInputs) + " inputs ON (inputs.prev_hash = outputs.hash AND inputs.prev_index = outputs." + index + ") " +
+		"LEFT JOIN " + d.GetTableName(TableSlpOutputs) + " slp_outputs ON (slp_outputs.hash = outputs.hash AND slp_outputs." + index + " = outputs." + index + ") " +
+		"LEFT JOIN " + d.GetTableName(TableSlpBatons) + " slp_batons ON (slp_batons.hash = outputs.hash AND slp_batons." + index + " = outputs." + index + ") " +
+		"LEFT JOIN " + d.GetTableName(TableSlpGeneses) + " slp_geneses ON (slp_geneses.hash = COALESCE(slp_outputs.token_hash, slp_batons.token_hash)) " +
 		"WHERE outputs.address IN (" + db_util.GetQuestionMarksCombined(len(addresses)) + ") " +
 		"AND inputs.hash IS NULL"
 	var addressStrings = make([]interface{}, len(addresses))
 	for i := range addresses {
 		addressStrings[i] = addresses[i].String()
 	}
-	rows, err := d.Db.Query(query, addressStrings...)
+	rows, err := d.Db.Query(d.Dialect.Rebind(query), addressStrings...)
 	if err != nil {
 		return nil, fmt.Errorf("error getting address utxos select query; %w", err)
 	}
+	defer rows.Close()
 	var results []graph.Output
 	for rows.Next() {
 		var result graph.Output
-		if err := rows.Scan(&result.Hash, &result.Index, &result.Lock.Address, &result.Script, &result.Amount); err != nil {
+		var slpTokenHash, batonTokenHash, ticker, name, docUrl sql.NullString
+		var slpAmount, tokenType, decimals sql.NullInt64
+		if err := rows.Scan(&result.Hash, &result.Index, &result.Lock.Address, &result.Script, &result.Amount,
+			&slpTokenHash, &slpAmount, &batonTokenHash, &tokenType, &decimals, &ticker, &name, &docUrl); err != nil {
 			return nil, fmt.Errorf("error getting address utxos scan query; %w", err)
 		}
+		var genesis *graph.SlpGenesis
+		if tokenType.Valid {
+			genesis = &graph.SlpGenesis{
+				TokenType: uint8(tokenType.Int64),
+				Decimals:  uint8(decimals.Int64),
+				Ticker:    ticker.String,
+				Name:      name.String,
+				DocUrl:    docUrl.String,
+			}
+		}
+		if slpTokenHash.Valid {
+			result.Slp = &graph.Slp{
+				Hash:      result.Hash,
+				Index:     uint32(result.Index),
+				TokenHash: slpTokenHash.String,
+				Amount:    uint64(slpAmount.Int64),
+				Genesis:   genesis,
+			}
+			if genesis != nil {
+				genesis.Hash = slpTokenHash.String
+			}
+		}
+		if batonTokenHash.Valid {
+			result.SlpBaton = &graph.SlpBaton{
+				Hash:      result.Hash,
+				Index:     uint32(result.Index),
+				TokenHash: batonTokenHash.String,
+				Genesis:   genesis,
+			}
+			if genesis != nil {
+				genesis.Hash = batonTokenHash.String
+			}
+		}
 		results = append(results, result)
 	}
 	return results, nil
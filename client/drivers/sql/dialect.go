@@ -0,0 +1,207 @@
+package sql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	DialectSqlite   = "sqlite"
+	DialectPostgres = "postgres"
+	DialectMysql    = "mysql"
+)
+
+// Column types used in table definitions, mapped to a type for each dialect.
+const (
+	// ColumnString is short text that can be indexed, e.g. hashes and addresses.
+	ColumnString = "string"
+	// ColumnText is text of any length that is not indexed, e.g. scripts and post text.
+	ColumnText = "text"
+	// ColumnInt is a 64-bit integer.
+	ColumnInt = "int"
+)
+
+// Dialect is the SQL differences between databases supported by the driver. Queries are written with ? placeholders
+// and rebound for the dialect.
+type Dialect interface {
+	GetName() string
+	Quote(identifier string) string
+	Rebind(query string) string
+	GetColumnType(columnType string) string
+	// GetUpsert returns an insert that replaces the row with the same unique columns.
+	GetUpsert(table string, columns []string, unique []string) string
+	// GetIndex returns an index definition that is either inline in the create table statement or a separate
+	// statement run after it. Separate statements are skipped if the index already exists.
+	GetIndex(table string, name string, columns []string) (definition string, inline bool)
+}
+
+func GetDialect(name string) (Dialect, error) {
+	switch name {
+	case DialectSqlite, "sqlite3", "":
+		return Sqlite{}, nil
+	case DialectPostgres, "postgresql", "pgx":
+		return Postgres{}, nil
+	case DialectMysql:
+		return Mysql{}, nil
+	}
+	return nil, fmt.Errorf("error unknown sql dialect: %s", name)
+}
+
+func quoteAll(d Dialect, identifiers []string) []string {
+	var quoted = make([]string, len(identifiers))
+	for i := range identifiers {
+		quoted[i] = d.Quote(identifiers[i])
+	}
+	return quoted
+}
+
+func getPlaceholders(count int) string {
+	if count == 0 {
+		return ""
+	}
+	return "?" + strings.Repeat(", ?", count-1)
+}
+
+// getUpdateColumns returns the columns not in the unique key, or the first unique column if all are in the key.
+func getUpdateColumns(columns []string, unique []string) []string {
+	var update []string
+ColumnLoop:
+	for _, column := range columns {
+		for _, uniqueColumn := range unique {
+			if column == uniqueColumn {
+				continue ColumnLoop
+			}
+		}
+		update = append(update, column)
+	}
+	if len(update) == 0 && len(unique) > 0 {
+		update = append(update, unique[0])
+	}
+	return update
+}
+
+type Sqlite struct{}
+
+func (Sqlite) GetName() string {
+	return DialectSqlite
+}
+
+func (Sqlite) Quote(identifier string) string {
+	return "`" + identifier + "`"
+}
+
+func (Sqlite) Rebind(query string) string {
+	return query
+}
+
+func (Sqlite) GetColumnType(columnType string) string {
+	switch columnType {
+	case ColumnInt:
+		return "INTEGER"
+	default:
+		return "TEXT"
+	}
+}
+
+func (s Sqlite) GetUpsert(table string, columns []string, _ []string) string {
+	return "INSERT OR REPLACE INTO " + table + " (" + strings.Join(quoteAll(s, columns), ", ") + ") " +
+		"VALUES (" + getPlaceholders(len(columns)) + ")"
+}
+
+func (s Sqlite) GetIndex(table string, name string, columns []string) (string, bool) {
+	return getCreateIndexIfNotExists(s, table, name, columns), false
+}
+
+func getCreateIndexIfNotExists(d Dialect, table string, name string, columns []string) string {
+	return "CREATE INDEX IF NOT EXISTS " + name + " ON " + table + " (" + strings.Join(quoteAll(d, columns), ", ") + ")"
+}
+
+type Postgres struct{}
+
+func (Postgres) GetName() string {
+	return DialectPostgres
+}
+
+func (Postgres) Quote(identifier string) string {
+	return `"` + identifier + `"`
+}
+
+// Rebind converts ? placeholders to numbered $1, $2, ... placeholders.
+func (Postgres) Rebind(query string) string {
+	var rebound strings.Builder
+	var count int
+	for _, r := range query {
+		if r == '?' {
+			count++
+			rebound.WriteString("$" + strconv.Itoa(count))
+			continue
+		}
+		rebound.WriteRune(r)
+	}
+	return rebound.String()
+}
+
+func (Postgres) GetColumnType(columnType string) string {
+	switch columnType {
+	case ColumnInt:
+		return "BIGINT"
+	default:
+		return "TEXT"
+	}
+}
+
+func (p Postgres) GetUpsert(table string, columns []string, unique []string) string {
+	var sets []string
+	for _, column := range getUpdateColumns(columns, unique) {
+		sets = append(sets, p.Quote(column)+" = EXCLUDED."+p.Quote(column))
+	}
+	return p.Rebind("INSERT INTO " + table + " (" + strings.Join(quoteAll(p, columns), ", ") + ") " +
+		"VALUES (" + getPlaceholders(len(columns)) + ") " +
+		"ON CONFLICT (" + strings.Join(quoteAll(p, unique), ", ") + ") DO UPDATE SET " + strings.Join(sets, ", "))
+}
+
+func (p Postgres) GetIndex(table string, name string, columns []string) (string, bool) {
+	return getCreateIndexIfNotExists(p, table, name, columns), false
+}
+
+type Mysql struct{}
+
+func (Mysql) GetName() string {
+	return DialectMysql
+}
+
+func (Mysql) Quote(identifier string) string {
+	return "`" + identifier + "`"
+}
+
+func (Mysql) Rebind(query string) string {
+	return query
+}
+
+func (Mysql) GetColumnType(columnType string) string {
+	switch columnType {
+	case ColumnInt:
+		return "BIGINT"
+	case ColumnString:
+		// Unique indexes on TEXT columns are not supported.
+		return "VARCHAR(255)"
+	default:
+		return "TEXT"
+	}
+}
+
+func (m Mysql) GetUpsert(table string, columns []string, unique []string) string {
+	var sets []string
+	for _, column := range getUpdateColumns(columns, unique) {
+		sets = append(sets, m.Quote(column)+" = VALUES("+m.Quote(column)+")")
+	}
+	return "INSERT INTO " + table + " (" + strings.Join(quoteAll(m, columns), ", ") + ") " +
+		"VALUES (" + getPlaceholders(len(columns)) + ") " +
+		"ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+}
+
+// GetIndex returns an inline index since MySQL does not support create index if not exists.
+func (m Mysql) GetIndex(_ string, name string, columns []string) (string, bool) {
+	return "INDEX " + name + " (" + strings.Join(quoteAll(m, columns), ", ") + ")", true
+}
@@ -0,0 +1,93 @@
+package sql_test
+
+import (
+	"github.com/memocash/index/client/drivers/sql"
+	"reflect"
+	"testing"
+)
+
+type dialectTest struct {
+	Dialect    string
+	Rebind     string
+	Upsert     string
+	UpsertKey  string
+	Definition []string
+}
+
+const (
+	testRebindQuery = "SELECT * FROM txs WHERE hash = ? AND `index` IN (?, ?)"
+	testTable       = "test_posts"
+)
+
+var testTableDefinition = sql.Table{
+	Name: "posts",
+	Columns: []sql.Column{
+		{Name: "hash", Type: sql.ColumnString},
+		{Name: "text", Type: sql.ColumnText},
+		{Name: "seen", Type: sql.ColumnInt},
+	},
+	Unique:  []string{"hash"},
+	Indexes: [][]string{{"seen"}},
+}
+
+var dialectTests = []dialectTest{{
+	Dialect:   sql.DialectSqlite,
+	Rebind:    testRebindQuery,
+	Upsert:    "INSERT OR REPLACE INTO test_posts (`hash`, `text`, `seen`) VALUES (?, ?, ?)",
+	UpsertKey: "INSERT OR REPLACE INTO test_posts (`hash`) VALUES (?)",
+	Definition: []string{
+		"CREATE TABLE IF NOT EXISTS test_posts (`hash` TEXT, `text` TEXT, `seen` INTEGER, UNIQUE (`hash`))",
+		"CREATE INDEX IF NOT EXISTS test_posts_seen ON test_posts (`seen`)",
+	},
+}, {
+	Dialect: sql.DialectPostgres,
+	Rebind:  "SELECT * FROM txs WHERE hash = $1 AND `index` IN ($2, $3)",
+	Upsert: `INSERT INTO test_posts ("hash", "text", "seen") VALUES ($1, $2, $3) ` +
+		`ON CONFLICT ("hash") DO UPDATE SET "text" = EXCLUDED."text", "seen" = EXCLUDED."seen"`,
+	UpsertKey: `INSERT INTO test_posts ("hash") VALUES ($1) ON CONFLICT ("hash") DO UPDATE SET "hash" = EXCLUDED."hash"`,
+	Definition: []string{
+		`CREATE TABLE IF NOT EXISTS test_posts ("hash" TEXT, "text" TEXT, "seen" BIGINT, UNIQUE ("hash"))`,
+		`CREATE INDEX IF NOT EXISTS test_posts_seen ON test_posts ("seen")`,
+	},
+}, {
+	Dialect: sql.DialectMysql,
+	Rebind:  testRebindQuery,
+	Upsert: "INSERT INTO test_posts (`hash`, `text`, `seen`) VALUES (?, ?, ?) " +
+		"ON DUPLICATE KEY UPDATE `text` = VALUES(`text`), `seen` = VALUES(`seen`)",
+	UpsertKey: "INSERT INTO test_posts (`hash`) VALUES (?) ON DUPLICATE KEY UPDATE `hash` = VALUES(`hash`)",
+	Definition: []string{
+		"CREATE TABLE IF NOT EXISTS test_posts (`hash` VARCHAR(255), `text` TEXT, `seen` BIGINT, UNIQUE (`hash`), " +
+			"INDEX test_posts_seen (`seen`))",
+	},
+}}
+
+func TestDialects(t *testing.T) {
+	for _, tst := range dialectTests {
+		t.Run(tst.Dialect, func(t *testing.T) {
+			dialect, err := sql.GetDialect(tst.Dialect)
+			if err != nil {
+				t.Fatalf("error getting dialect; %v", err)
+			}
+			if rebind := dialect.Rebind(testRebindQuery); rebind != tst.Rebind {
+				t.Errorf("unexpected rebind: %s, expected: %s", rebind, tst.Rebind)
+			}
+			if upsert := dialect.GetUpsert(testTable, []string{"hash", "text", "seen"},
+				testTableDefinition.Unique); upsert != tst.Upsert {
+				t.Errorf("unexpected upsert: %s, expected: %s", upsert, tst.Upsert)
+			}
+			if upsert := dialect.GetUpsert(testTable, []string{"hash"}, testTableDefinition.Unique); upsert != tst.UpsertKey {
+				t.Errorf("unexpected upsert of only unique columns: %s, expected: %s", upsert, tst.UpsertKey)
+			}
+			definition := testTableDefinition.GetDefinition(dialect, "test")
+			if !reflect.DeepEqual(definition, tst.Definition) {
+				t.Errorf("unexpected definition: %q, expected: %q", definition, tst.Definition)
+			}
+		})
+	}
+}
+
+func TestGetDialectUnknown(t *testing.T) {
+	if _, err := sql.GetDialect("oracle"); err == nil {
+		t.Error("expected error getting unknown dialect")
+	}
+}
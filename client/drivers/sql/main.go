@@ -3,41 +3,42 @@ package sql
 import (
 	"database/sql"
 	"fmt"
+	"sort"
 	"strings"
 )
 
-func initDb(db *sql.DB, prefix string) error {
-	for _, table := range tables {
-		if _, err := db.Exec(table.GetDefinition(prefix)); err != nil {
-			return fmt.Errorf("error creating table; %w", err)
-		}
-	}
-	return nil
-}
-
 func execQueries(db *sql.DB, queries []*Query) error {
 	tx, err := db.Begin()
 	if err != nil {
-		return fmt.Errorf("error starting sqlite transaction; %w", err)
+		return fmt.Errorf("error starting database transaction; %w", err)
 	}
 	for _, query := range queries {
 		if query == nil {
+			tx.Rollback()
 			return fmt.Errorf("exec query is nil")
 		}
 		if _, err := tx.Exec(query.Query, query.Variables...); err != nil {
+			tx.Rollback()
 			return fmt.Errorf("error executing query: %s; %w", query.Name, err)
 		}
 	}
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("error committing sqlite transaction; %w", err)
+		return fmt.Errorf("error committing database transaction; %w", err)
 	}
 	return nil
 }
 
+type Column struct {
+	Name string
+	Type string
+}
+
 type Table struct {
 	Name    string
-	Columns map[string]string
-	Indexes []string
+	Columns []Column
+	// Unique is the unique key, inserts replace the row with the same unique values.
+	Unique  []string
+	Indexes [][]string
 }
 
 func (t Table) GetName(prefix string) string {
@@ -47,39 +48,50 @@ func (t Table) GetName(prefix string) string {
 	return prefix + "_" + t.Name
 }
 
-func (t Table) GetInsert(prefix string, values map[string]interface{}) Query {
+func (t Table) GetInsert(dialect Dialect, prefix string, values map[string]interface{}) Query {
 	var cols []string
-	var variables []interface{}
-	for col, val := range values {
-		if col == "index" {
-			col = "`index`"
-		}
+	for col := range values {
 		cols = append(cols, col)
-		variables = append(variables, val)
+	}
+	sort.Strings(cols)
+	var variables = make([]interface{}, len(cols))
+	for i, col := range cols {
+		variables[i] = values[col]
 	}
 	return Query{
-		Name: t.GetName(prefix),
-		Query: "INSERT OR REPLACE INTO " + t.GetName(prefix) +
-			" (" + strings.Join(cols, ", ") + ") VALUES (" + "?" + strings.Repeat(", ?", len(cols)-1) + ")",
+		Name:      t.GetName(prefix),
+		Query:     dialect.GetUpsert(t.GetName(prefix), cols, t.Unique),
 		Variables: variables,
 	}
 }
 
-func (t Table) GetDefinition(prefix string) string {
+// GetDefinition returns the create table statement followed by any create index statements. Statements are skipped
+// for tables and indexes that already exist, so a migration can be applied to a database with tables from before
+// versioning or applied again after failing part way. MySQL commits each DDL statement so a failed migration is not
+// rolled back.
+func (t Table) GetDefinition(dialect Dialect, prefix string) []string {
 	var columns []string
-	for colName, colType := range t.Columns {
-		if colName == "index" {
-			colName = "`index`"
-		}
-		columns = append(columns, colName+" "+colType)
+	for _, column := range t.Columns {
+		columns = append(columns, dialect.Quote(column.Name)+" "+dialect.GetColumnType(column.Type))
+	}
+	if len(t.Unique) > 0 {
+		columns = append(columns, "UNIQUE ("+strings.Join(quoteAll(dialect, t.Unique), ", ")+")")
 	}
+	var indexes []string
 	for _, index := range t.Indexes {
-		columns = append(columns, index)
+		definition, inline := dialect.GetIndex(t.GetName(prefix), t.GetName(prefix)+"_"+strings.Join(index, "_"), index)
+		if inline {
+			columns = append(columns, definition)
+		} else {
+			indexes = append(indexes, definition)
+		}
 	}
-	return "CREATE TABLE IF NOT EXISTS " + t.GetName(prefix) + " (" + strings.Join(columns, ", ") + ")"
+	return append([]string{"CREATE TABLE IF NOT EXISTS " + t.GetName(prefix) + " (" + strings.Join(columns, ", ") + ")"},
+		indexes...)
 }
 
 const (
+	TableSchemaVersions = "schema_versions"
 	TableAddressUpdates = "address_updates"
 	TableTxs            = "txs"
 	TableInputs         = "inputs"
@@ -89,91 +101,126 @@ const (
 	TableSlpBatons      = "slp_batons"
 	TableSlpGeneses     = "slp_geneses"
 	TableSlpOutputs     = "slp_outputs"
+	TableMemoPosts      = "memo_posts"
+	TableMemoProfiles   = "memo_profiles"
 )
 
 var tables = map[string]Table{
+	TableSchemaVersions: {
+		Name: TableSchemaVersions,
+		Columns: []Column{
+			{Name: "version", Type: ColumnInt},
+			{Name: "name", Type: ColumnString},
+			{Name: "time", Type: ColumnInt},
+		},
+		Unique: []string{"version"},
+	},
 	TableAddressUpdates: {
 		Name: TableAddressUpdates,
-		Columns: map[string]string{
-			"address": "CHAR",
-			"time":    "INT",
+		Columns: []Column{
+			{Name: "address", Type: ColumnString},
+			{Name: "time", Type: ColumnInt},
 		},
-		Indexes: []string{"UNIQUE(address)"},
+		Unique: []string{"address"},
 	},
 	TableTxs: {
 		Name: TableTxs,
-		Columns: map[string]string{
-			"hash": "CHAR",
+		Columns: []Column{
+			{Name: "hash", Type: ColumnString},
 		},
-		Indexes: []string{"UNIQUE(hash)"},
+		Unique: []string{"hash"},
 	},
 	TableInputs: {
 		Name: TableInputs,
-		Columns: map[string]string{
-			"hash":       "CHAR",
-			"index":      "INT",
-			"prev_hash":  "CHAR",
-			"prev_index": "INT",
+		Columns: []Column{
+			{Name: "hash", Type: ColumnString},
+			{Name: "index", Type: ColumnInt},
+			{Name: "prev_hash", Type: ColumnString},
+			{Name: "prev_index", Type: ColumnInt},
 		},
-		Indexes: []string{"UNIQUE(hash, `index`)"},
+		Unique: []string{"hash", "index"},
 	},
 	TableOutputs: {
 		Name: TableOutputs,
-		Columns: map[string]string{
-			"hash":    "CHAR",
-			"index":   "INT",
-			"address": "CHAR",
-			"value":   "INT",
-			"script":  "CHAR",
+		Columns: []Column{
+			{Name: "hash", Type: ColumnString},
+			{Name: "index", Type: ColumnInt},
+			{Name: "address", Type: ColumnString},
+			{Name: "value", Type: ColumnInt},
+			{Name: "script", Type: ColumnText},
 		},
-		Indexes: []string{"UNIQUE(hash, `index`)"},
+		Unique: []string{"hash", "index"},
 	},
 	TableBlocks: {
 		Name: TableBlocks,
-		Columns: map[string]string{
-			"hash":      "CHAR",
-			"timestamp": "CHAR",
-			"height":    "INT",
+		Columns: []Column{
+			{Name: "hash", Type: ColumnString},
+			{Name: "timestamp", Type: ColumnString},
+			{Name: "height", Type: ColumnInt},
 		},
-		Indexes: []string{"UNIQUE(hash)"},
+		Unique: []string{"hash"},
 	},
 	TableBlockTxs: {
 		Name: TableBlockTxs,
-		Columns: map[string]string{
-			"block_hash": "CHAR",
-			"tx_hash":    "CHAR",
+		Columns: []Column{
+			{Name: "block_hash", Type: ColumnString},
+			{Name: "tx_hash", Type: ColumnString},
 		},
-		Indexes: []string{"UNIQUE(block_hash, tx_hash)"},
+		Unique: []string{"block_hash", "tx_hash"},
 	},
 	TableSlpGeneses: {
 		Name: TableSlpGeneses,
-		Columns: map[string]string{
-			"hash":       "CHAR",
-			"token_type": "INT",
-			"decimals":   "INT",
-			"ticker":     "CHAR",
-			"name":       "CHAR",
-			"doc_url":    "CHAR",
+		Columns: []Column{
+			{Name: "hash", Type: ColumnString},
+			{Name: "token_type", Type: ColumnInt},
+			{Name: "decimals", Type: ColumnInt},
+			{Name: "ticker", Type: ColumnText},
+			{Name: "name", Type: ColumnText},
+			{Name: "doc_url", Type: ColumnText},
 		},
-		Indexes: []string{"UNIQUE(hash)"},
+		Unique: []string{"hash"},
 	},
 	TableSlpBatons: {
 		Name: TableSlpBatons,
-		Columns: map[string]string{
-			"hash":       "CHAR",
-			"index":      "INT",
-			"token_hash": "CHAR",
+		Columns: []Column{
+			{Name: "hash", Type: ColumnString},
+			{Name: "index", Type: ColumnInt},
+			{Name: "token_hash", Type: ColumnString},
 		},
-		Indexes: []string{"UNIQUE(hash, `index`)"},
+		Unique: []string{"hash", "index"},
 	},
 	TableSlpOutputs: {
 		Name: TableSlpOutputs,
-		Columns: map[string]string{
-			"hash":       "CHAR",
-			"index":      "INT",
-			"token_hash": "CHAR",
-			"amount":     "INT",
+		Columns: []Column{
+			{Name: "hash", Type: ColumnString},
+			{Name: "index", Type: ColumnInt},
+			{Name: "token_hash", Type: ColumnString},
+			{Name: "amount", Type: ColumnInt},
+		},
+		Unique: []string{"hash", "index"},
+	},
+	TableMemoPosts: {
+		Name: TableMemoPosts,
+		Columns: []Column{
+			{Name: "tx_hash", Type: ColumnString},
+			{Name: "address", Type: ColumnString},
+			{Name: "text", Type: ColumnText},
+			{Name: "room", Type: ColumnString},
+			{Name: "parent_tx_hash", Type: ColumnString},
+			{Name: "seen", Type: ColumnInt},
+		},
+		Unique:  []string{"tx_hash"},
+		Indexes: [][]string{{"address"}, {"room"}},
+	},
+	TableMemoProfiles: {
+		Name: TableMemoProfiles,
+		Columns: []Column{
+			{Name: "address", Type: ColumnString},
+			{Name: "name", Type: ColumnText},
+			{Name: "profile", Type: ColumnText},
+			{Name: "pic", Type: ColumnText},
+			{Name: "time", Type: ColumnInt},
 		},
-		Indexes: []string{"UNIQUE(hash, `index`)"},
+		Unique: []string{"address"},
 	},
 }
@@ -0,0 +1,117 @@
+package sql
+
+import (
+	"fmt"
+	"github.com/jchavannes/jgo/db_util"
+	"time"
+)
+
+type MemoPost struct {
+	TxHash       string
+	Address      string
+	Text         string
+	Room         string
+	ParentTxHash string
+	Seen         time.Time
+}
+
+type MemoProfile struct {
+	Address string
+	Name    string
+	Profile string
+	Pic     string
+	Time    time.Time
+}
+
+func (d *Database) SaveMemoPosts(posts []MemoPost) error {
+	var queries []*Query
+	for _, post := range posts {
+		queries = append(queries, d.GetInsert(TableMemoPosts, map[string]interface{}{
+			"tx_hash":        post.TxHash,
+			"address":        post.Address,
+			"text":           post.Text,
+			"room":           post.Room,
+			"parent_tx_hash": post.ParentTxHash,
+			"seen":           post.Seen.UnixNano(),
+		}))
+	}
+	if err := execQueries(d.Db, queries); err != nil {
+		return fmt.Errorf("error saving memo posts; %w", err)
+	}
+	return nil
+}
+
+// GetMemoPosts returns posts by any of the addresses, newest first.
+func (d *Database) GetMemoPosts(addresses []string) ([]MemoPost, error) {
+	query := "" +
+		"SELECT tx_hash, address, text, room, parent_tx_hash, seen " +
+		"FROM " + d.GetTableName(TableMemoPosts) + " " +
+		"WHERE address IN (" + db_util.GetQuestionMarksCombined(len(addresses)) + ") " +
+		"ORDER BY seen DESC"
+	var addressStrings = make([]interface{}, len(addresses))
+	for i := range addresses {
+		addressStrings[i] = addresses[i]
+	}
+	rows, err := d.Db.Query(d.Dialect.Rebind(query), addressStrings...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting memo posts select query; %w", err)
+	}
+	defer rows.Close()
+	var posts []MemoPost
+	for rows.Next() {
+		var post MemoPost
+		var seen int64
+		if err := rows.Scan(&post.TxHash, &post.Address, &post.Text, &post.Room, &post.ParentTxHash,
+			&seen); err != nil {
+			return nil, fmt.Errorf("error getting memo posts scan query; %w", err)
+		}
+		post.Seen = time.Unix(0, seen)
+		posts = append(posts, post)
+	}
+	return posts, nil
+}
+
+func (d *Database) SaveMemoProfiles(profiles []MemoProfile) error {
+	var queries []*Query
+	for _, profile := range profiles {
+		queries = append(queries, d.GetInsert(TableMemoProfiles, map[string]interface{}{
+			"address": profile.Address,
+			"name":    profile.Name,
+			"profile": profile.Profile,
+			"pic":     profile.Pic,
+			"time":    profile.Time.UnixNano(),
+		}))
+	}
+	if err := execQueries(d.Db, queries); err != nil {
+		return fmt.Errorf("error saving memo profiles; %w", err)
+	}
+	return nil
+}
+
+func (d *Database) GetMemoProfiles(addresses []string) ([]MemoProfile, error) {
+	query := "" +
+		"SELECT address, name, profile, pic, time " +
+		"FROM " + d.GetTableName(TableMemoProfiles) + " " +
+		"WHERE address IN (" + db_util.GetQuestionMarksCombined(len(addresses)) + ")"
+	var addressStrings = make([]interface{}, len(addresses))
+	for i := range addresses {
+		addressStrings[i] = addresses[i]
+	}
+	rows, err := d.Db.Query(d.Dialect.Rebind(query), addressStrings...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting memo profiles select query; %w", err)
+	}
+	defer rows.Close()
+	var profiles []MemoProfile
+	for rows.Next() {
+		var profile MemoProfile
+		var profileTime int64
+		if err := rows.Scan(&profile.Address, &profile.Name, &profile.Profile, &profile.Pic,
+			&profileTime); err != nil {
+			return nil, fmt.Errorf("error getting memo profiles scan query; %w", err)
+		}
+		profile.Time = time.Unix(0, profileTime)
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
@@ -0,0 +1,80 @@
+package sql
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type Migration struct {
+	Version int
+	Name    string
+	Tables  []string
+}
+
+// Migrations are applied in order, each one once. Add new migrations to the end, never change applied ones.
+var Migrations = []Migration{{
+	Version: 1,
+	Name:    "initial tables",
+	Tables: []string{
+		TableAddressUpdates,
+		TableTxs,
+		TableInputs,
+		TableOutputs,
+		TableBlocks,
+		TableBlockTxs,
+		TableSlpGeneses,
+		TableSlpBatons,
+		TableSlpOutputs,
+	},
+}, {
+	Version: 2,
+	Name:    "memo tables",
+	Tables:  []string{TableMemoPosts, TableMemoProfiles},
+}}
+
+func (m Migration) GetQueries(dialect Dialect, prefix string) []*Query {
+	var queries []*Query
+	for _, tableName := range m.Tables {
+		for _, statement := range tables[tableName].GetDefinition(dialect, prefix) {
+			queries = append(queries, &Query{Name: tableName, Query: statement})
+		}
+	}
+	version := tables[TableSchemaVersions].GetInsert(dialect, prefix, map[string]interface{}{
+		"version": m.Version,
+		"name":    m.Name,
+		"time":    time.Now().Unix(),
+	})
+	return append(queries, &version)
+}
+
+func getSchemaVersion(db *sql.DB, dialect Dialect, prefix string) (int, error) {
+	var version int
+	if err := db.QueryRow("SELECT COALESCE(MAX(" + dialect.Quote("version") + "), 0) " +
+		"FROM " + tables[TableSchemaVersions].GetName(prefix)).Scan(&version); err != nil {
+		return 0, fmt.Errorf("error getting schema version; %w", err)
+	}
+	return version, nil
+}
+
+// migrate creates the version table if needed and applies migrations after the current version.
+func migrate(db *sql.DB, dialect Dialect, prefix string) error {
+	for _, statement := range tables[TableSchemaVersions].GetDefinition(dialect, prefix) {
+		if _, err := db.Exec(statement); err != nil {
+			return fmt.Errorf("error creating schema version table; %w", err)
+		}
+	}
+	version, err := getSchemaVersion(db, dialect, prefix)
+	if err != nil {
+		return fmt.Errorf("error getting current schema version for migrate; %w", err)
+	}
+	for _, migration := range Migrations {
+		if migration.Version <= version {
+			continue
+		}
+		if err := execQueries(db, migration.GetQueries(dialect, prefix)); err != nil {
+			return fmt.Errorf("error applying migration %d (%s); %w", migration.Version, migration.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package sql_test
+
+import (
+	dbsql "database/sql"
+	"fmt"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/memocash/index/client/drivers/sql"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testPrefix = "test"
+
+func getTestDb(t *testing.T) *dbsql.DB {
+	db, err := dbsql.Open("sqlite3", filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(fmt.Errorf("error opening sqlite test db; %w", err))
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func getTestSchemaVersion(t *testing.T, db *dbsql.DB) int {
+	var version int
+	if err := db.QueryRow("SELECT MAX(version) FROM test_schema_versions").Scan(&version); err != nil {
+		t.Fatal(fmt.Errorf("error getting test schema version; %w", err))
+	}
+	return version
+}
+
+func TestMigrationQueries(t *testing.T) {
+	for _, dialectName := range []string{sql.DialectSqlite, sql.DialectPostgres, sql.DialectMysql} {
+		t.Run(dialectName, func(t *testing.T) {
+			dialect, err := sql.GetDialect(dialectName)
+			if err != nil {
+				t.Fatalf("error getting dialect; %v", err)
+			}
+			for _, migration := range sql.Migrations {
+				queries := migration.GetQueries(dialect, testPrefix)
+				if len(queries) <= len(migration.Tables) {
+					t.Errorf("unexpected query count for migration %d: %d", migration.Version, len(queries))
+					continue
+				}
+				for _, query := range queries[:len(queries)-1] {
+					if !strings.HasPrefix(query.Query, "CREATE TABLE IF NOT EXISTS ") &&
+						!strings.HasPrefix(query.Query, "CREATE INDEX IF NOT EXISTS ") {
+						t.Errorf("migration %d statement can not be applied again: %s", migration.Version, query.Query)
+					}
+				}
+				version := queries[len(queries)-1]
+				if !strings.Contains(version.Query, "test_schema_versions") || len(version.Variables) != 3 ||
+					version.Variables[2] != migration.Version {
+					t.Errorf("unexpected migration %d version insert: %s %v",
+						migration.Version, version.Query, version.Variables)
+				}
+			}
+		})
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	db := getTestDb(t)
+	if _, err := sql.NewDatabase(db, testPrefix); err != nil {
+		t.Fatal(fmt.Errorf("error creating database; %w", err))
+	}
+	latestVersion := sql.Migrations[len(sql.Migrations)-1].Version
+	if version := getTestSchemaVersion(t, db); version != latestVersion {
+		t.Errorf("unexpected schema version: %d, expected: %d", version, latestVersion)
+	}
+	if _, err := sql.NewDatabase(db, testPrefix); err != nil {
+		t.Fatal(fmt.Errorf("error creating database again; %w", err))
+	}
+	var versionCount int
+	if err := db.QueryRow("SELECT COUNT(*) FROM test_schema_versions").Scan(&versionCount); err != nil {
+		t.Fatal(fmt.Errorf("error getting schema version count; %w", err))
+	}
+	if versionCount != len(sql.Migrations) {
+		t.Errorf("unexpected schema version count: %d, expected: %d", versionCount, len(sql.Migrations))
+	}
+}
+
+// TestMigratePartial applies migrations again when the last migration failed after creating some of its tables, as
+// with MySQL which does not roll back DDL.
+func TestMigratePartial(t *testing.T) {
+	db := getTestDb(t)
+	if _, err := sql.NewDatabase(db, testPrefix); err != nil {
+		t.Fatal(fmt.Errorf("error creating database; %w", err))
+	}
+	lastMigration := sql.Migrations[len(sql.Migrations)-1]
+	if _, err := db.Exec("DELETE FROM test_schema_versions WHERE version = ?", lastMigration.Version); err != nil {
+		t.Fatal(fmt.Errorf("error removing last schema version; %w", err))
+	}
+	if _, err := db.Exec("DROP TABLE test_" + lastMigration.Tables[len(lastMigration.Tables)-1]); err != nil {
+		t.Fatal(fmt.Errorf("error dropping last migration table; %w", err))
+	}
+	if _, err := sql.NewDatabase(db, testPrefix); err != nil {
+		t.Fatal(fmt.Errorf("error creating database after partial migration; %w", err))
+	}
+	if version := getTestSchemaVersion(t, db); version != lastMigration.Version {
+		t.Errorf("unexpected schema version: %d, expected: %d", version, lastMigration.Version)
+	}
+}
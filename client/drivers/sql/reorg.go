@@ -0,0 +1,222 @@
+package sql
+
+import (
+	"fmt"
+	"github.com/jchavannes/jgo/db_util"
+	"github.com/memocash/index/client/lib/graph"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"time"
+)
+
+func getInterfaces(values []string) []interface{} {
+	var interfaces = make([]interface{}, len(values))
+	for i := range values {
+		interfaces[i] = values[i]
+	}
+	return interfaces
+}
+
+func getAddressStrings(addresses []wallet.Addr) []string {
+	var addressStrings = make([]string, len(addresses))
+	for i := range addresses {
+		addressStrings[i] = addresses[i].String()
+	}
+	return addressStrings
+}
+
+// selectStrings runs a query selecting a single string column.
+func (d *Database) selectStrings(query string, variables []interface{}) ([]string, error) {
+	rows, err := d.Db.Query(d.Dialect.Rebind(query), variables...)
+	if err != nil {
+		return nil, fmt.Errorf("error select strings exec query; %w", err)
+	}
+	defer rows.Close()
+	var results []string
+	for rows.Next() {
+		var result string
+		if err := rows.Scan(&result); err != nil {
+			return nil, fmt.Errorf("error select strings scan query; %w", err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (d *Database) getDelete(table, column string, values []string) *Query {
+	return &Query{
+		Name: d.GetTableName(table),
+		Query: d.Dialect.Rebind("DELETE FROM " + d.GetTableName(table) + " " +
+			"WHERE " + d.Dialect.Quote(column) + " IN (" + db_util.GetQuestionMarksCombined(len(values)) + ")"),
+		Variables: getInterfaces(values),
+	}
+}
+
+// GetBlocks returns the newest stored blocks by height.
+func (d *Database) GetBlocks(limit int) ([]graph.Block, error) {
+	query := "" +
+		"SELECT hash, timestamp, height " +
+		"FROM " + d.GetTableName(TableBlocks) + " " +
+		"ORDER BY height DESC " +
+		"LIMIT ?"
+	rows, err := d.Db.Query(d.Dialect.Rebind(query), limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting blocks select query; %w", err)
+	}
+	defer rows.Close()
+	var blocks []graph.Block
+	for rows.Next() {
+		var block graph.Block
+		var timestamp string
+		if err := rows.Scan(&block.Hash, &timestamp, &block.Height); err != nil {
+			return nil, fmt.Errorf("error getting blocks scan query; %w", err)
+		}
+		if block.Timestamp, err = time.Parse(time.RFC3339Nano, timestamp); err != nil {
+			return nil, fmt.Errorf("error parsing block timestamp; %w", err)
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// getTxAddresses returns addresses with outputs in the txs or with outputs spent by the txs.
+func (d *Database) getTxAddresses(txHashes []string) ([]string, error) {
+	if len(txHashes) == 0 {
+		return nil, nil
+	}
+	index := d.Dialect.Quote("index")
+	query := "" +
+		"SELECT address FROM " + d.GetTableName(TableOutputs) + " " +
+		"WHERE hash IN (" + db_util.GetQuestionMarksCombined(len(txHashes)) + ") " +
+		"UNION " +
+		"SELECT outputs.address FROM " + d.GetTableName(TableInputs) + " inputs " +
+		"JOIN " + d.GetTableName(TableOutputs) + " outputs ON (outputs.hash = inputs.prev_hash AND outputs." + index + " = inputs.prev_index) " +
+		"WHERE inputs.hash IN (" + db_util.GetQuestionMarksCombined(len(txHashes)) + ")"
+	variables := append(getInterfaces(txHashes), getInterfaces(txHashes)...)
+	addresses, err := d.selectStrings(query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tx addresses; %w", err)
+	}
+	return addresses, nil
+}
+
+// getTxsWithDescendants returns the txs along with any stored txs spending their outputs, recursively.
+func (d *Database) getTxsWithDescendants(txHashes []string) ([]string, error) {
+	var allTxHashes []string
+	var seen = make(map[string]bool)
+	for len(txHashes) > 0 {
+		var newTxHashes []string
+		for _, txHash := range txHashes {
+			if !seen[txHash] {
+				seen[txHash] = true
+				newTxHashes = append(newTxHashes, txHash)
+			}
+		}
+		if len(newTxHashes) == 0 {
+			break
+		}
+		allTxHashes = append(allTxHashes, newTxHashes...)
+		var err error
+		if txHashes, err = d.selectStrings(""+
+			"SELECT DISTINCT hash FROM "+d.GetTableName(TableInputs)+" "+
+			"WHERE prev_hash IN ("+db_util.GetQuestionMarksCombined(len(newTxHashes))+")",
+			getInterfaces(newTxHashes)); err != nil {
+			return nil, fmt.Errorf("error getting spending txs for descendants; %w", err)
+		}
+	}
+	return allTxHashes, nil
+}
+
+func (d *Database) getRemoveTxsQueries(txHashes []string) []*Query {
+	return []*Query{
+		d.getDelete(TableTxs, "hash", txHashes),
+		d.getDelete(TableInputs, "hash", txHashes),
+		d.getDelete(TableOutputs, "hash", txHashes),
+		d.getDelete(TableSlpOutputs, "hash", txHashes),
+		d.getDelete(TableSlpBatons, "hash", txHashes),
+		d.getDelete(TableBlockTxs, "tx_hash", txHashes),
+		d.getDelete(TableMemoPosts, "tx_hash", txHashes),
+	}
+}
+
+// RemoveTxs deletes txs and any stored txs spending their outputs, such as txs the index marks as conflicted. Outputs
+// spent by removed txs become unspent again.
+func (d *Database) RemoveTxs(txHashes []string) error {
+	if len(txHashes) == 0 {
+		return nil
+	}
+	allTxHashes, err := d.getTxsWithDescendants(txHashes)
+	if err != nil {
+		return fmt.Errorf("error getting txs with descendants for remove txs; %w", err)
+	}
+	if err := execQueries(d.Db, d.getRemoveTxsQueries(allTxHashes)); err != nil {
+		return fmt.Errorf("error removing txs; %w", err)
+	}
+	return nil
+}
+
+// RollbackBlocks deletes blocks no longer in the main chain along with their tx links. The txs are kept as unconfirmed
+// and address updates are cleared for addresses in the txs so their history is fetched again from the start.
+func (d *Database) RollbackBlocks(blockHashes []string) error {
+	if len(blockHashes) == 0 {
+		return nil
+	}
+	txHashes, err := d.selectStrings(""+
+		"SELECT DISTINCT tx_hash FROM "+d.GetTableName(TableBlockTxs)+" "+
+		"WHERE block_hash IN ("+db_util.GetQuestionMarksCombined(len(blockHashes))+")", getInterfaces(blockHashes))
+	if err != nil {
+		return fmt.Errorf("error getting block txs for rollback; %w", err)
+	}
+	addresses, err := d.getTxAddresses(txHashes)
+	if err != nil {
+		return fmt.Errorf("error getting tx addresses for rollback; %w", err)
+	}
+	var queries = []*Query{
+		d.getDelete(TableBlockTxs, "block_hash", blockHashes),
+		d.getDelete(TableBlocks, "hash", blockHashes),
+	}
+	if len(addresses) > 0 {
+		queries = append(queries, d.getDelete(TableAddressUpdates, "address", addresses))
+	}
+	if err := execQueries(d.Db, queries); err != nil {
+		return fmt.Errorf("error rolling back blocks; %w", err)
+	}
+	return nil
+}
+
+// ResetAddresses deletes all txs for the addresses, along with txs spending their outputs, and clears address updates
+// for the addresses and any other address in the removed txs so their history is fetched again from the start.
+func (d *Database) ResetAddresses(addresses []wallet.Addr) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+	addressStrings := getAddressStrings(addresses)
+	index := d.Dialect.Quote("index")
+	query := "" +
+		"SELECT hash FROM " + d.GetTableName(TableOutputs) + " " +
+		"WHERE address IN (" + db_util.GetQuestionMarksCombined(len(addressStrings)) + ") " +
+		"UNION " +
+		"SELECT inputs.hash FROM " + d.GetTableName(TableInputs) + " inputs " +
+		"JOIN " + d.GetTableName(TableOutputs) + " outputs ON (outputs.hash = inputs.prev_hash AND outputs." + index + " = inputs.prev_index) " +
+		"WHERE outputs.address IN (" + db_util.GetQuestionMarksCombined(len(addressStrings)) + ")"
+	txHashes, err := d.selectStrings(query, append(getInterfaces(addressStrings), getInterfaces(addressStrings)...))
+	if err != nil {
+		return fmt.Errorf("error getting address txs for reset; %w", err)
+	}
+	allTxHashes, err := d.getTxsWithDescendants(txHashes)
+	if err != nil {
+		return fmt.Errorf("error getting txs with descendants for reset; %w", err)
+	}
+	txAddresses, err := d.getTxAddresses(allTxHashes)
+	if err != nil {
+		return fmt.Errorf("error getting tx addresses for reset; %w", err)
+	}
+	var queries []*Query
+	if len(allTxHashes) > 0 {
+		queries = d.getRemoveTxsQueries(allTxHashes)
+	}
+	queries = append(queries, d.getDelete(TableAddressUpdates, "address", append(addressStrings, txAddresses...)))
+	if err := execQueries(d.Db, queries); err != nil {
+		return fmt.Errorf("error resetting addresses; %w", err)
+	}
+	return nil
+}
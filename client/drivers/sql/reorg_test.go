@@ -0,0 +1,146 @@
+package sql_test
+
+import (
+	"fmt"
+	"github.com/memocash/index/client/drivers/sql"
+	"github.com/memocash/index/client/lib/graph"
+	"github.com/memocash/index/ref/bitcoin/util/testing/test_tx"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"testing"
+	"time"
+)
+
+const (
+	testAddress1 = "1QCBiyfwdjXDsHghBEr5U2KxUpM2BmmJVt"
+	testAddress2 = "1mW6fDEMjKrDHvLvoEsaeLxSCzZBf3Bfg"
+
+	testBlockHashMain   = test_tx.GenericTxHashString8
+	testBlockHashOrphan = test_tx.GenericTxHashString9
+)
+
+func getTestTx(hash, prevHash, address string, blocks ...graph.Block) graph.Tx {
+	var tx = graph.Tx{
+		Hash:    hash,
+		Outputs: []graph.Output{{Index: 0, Amount: 1000, Lock: graph.Lock{Address: address}}},
+	}
+	if prevHash != "" {
+		tx.Inputs = []graph.Input{{Index: 0, PrevHash: prevHash, PrevIndex: 0}}
+	}
+	for _, block := range blocks {
+		tx.Blocks = append(tx.Blocks, graph.TxBlock{Block: block})
+	}
+	return tx
+}
+
+func getTestDatabase(t *testing.T) *sql.Database {
+	database, err := sql.NewDatabase(getTestDb(t), testPrefix)
+	if err != nil {
+		t.Fatal(fmt.Errorf("error creating database; %w", err))
+	}
+	return database
+}
+
+func getTestCount(t *testing.T, database *sql.Database, table, column, value string) int {
+	var count int
+	if err := database.Db.QueryRow("SELECT COUNT(*) FROM "+database.GetTableName(table)+" "+
+		"WHERE "+column+" = ?", value).Scan(&count); err != nil {
+		t.Fatal(fmt.Errorf("error getting test count: %s; %w", table, err))
+	}
+	return count
+}
+
+func getTestAddrs(t *testing.T, addresses ...string) []wallet.Addr {
+	var addrs = make([]wallet.Addr, len(addresses))
+	for i := range addresses {
+		addr, err := wallet.GetAddrFromString(addresses[i])
+		if err != nil {
+			t.Fatal(fmt.Errorf("error getting test addr; %w", err))
+		}
+		addrs[i] = *addr
+	}
+	return addrs
+}
+
+func TestRollbackBlocks(t *testing.T) {
+	database := getTestDatabase(t)
+	mainBlock := graph.Block{Hash: testBlockHashMain, Height: 1, Timestamp: time.Unix(1600000000, 0)}
+	orphanBlock := graph.Block{Hash: testBlockHashOrphan, Height: 2, Timestamp: time.Unix(1600000600, 0)}
+	if err := database.SaveTxs([]graph.Tx{
+		getTestTx(test_tx.GenericTxHashString0, "", testAddress1, mainBlock),
+		getTestTx(test_tx.GenericTxHashString1, test_tx.GenericTxHashString0, testAddress2, orphanBlock),
+	}); err != nil {
+		t.Fatal(fmt.Errorf("error saving test txs; %w", err))
+	}
+	addrs := getTestAddrs(t, testAddress1, testAddress2)
+	if err := database.SetAddressLastUpdate([]graph.AddressUpdate{
+		{Address: addrs[0], Time: time.Unix(1600000600, 0)},
+		{Address: addrs[1], Time: time.Unix(1600000600, 0)},
+	}); err != nil {
+		t.Fatal(fmt.Errorf("error setting test address last updates; %w", err))
+	}
+	if err := database.RollbackBlocks([]string{testBlockHashOrphan}); err != nil {
+		t.Fatal(fmt.Errorf("error rolling back orphaned block; %w", err))
+	}
+	blocks, err := database.GetBlocks(10)
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting blocks after rollback; %w", err))
+	}
+	if len(blocks) != 1 || blocks[0].Hash != testBlockHashMain {
+		t.Errorf("unexpected blocks after rollback: %d", len(blocks))
+	}
+	if count := getTestCount(t, database, sql.TableBlockTxs, "block_hash", testBlockHashOrphan); count != 0 {
+		t.Errorf("unexpected orphaned block txs after rollback: %d", count)
+	}
+	if count := getTestCount(t, database, sql.TableBlockTxs, "block_hash", testBlockHashMain); count != 1 {
+		t.Errorf("unexpected main block txs after rollback: %d", count)
+	}
+	if count := getTestCount(t, database, sql.TableTxs, "hash", test_tx.GenericTxHashString1); count != 1 {
+		t.Errorf("expected orphaned block tx kept as unconfirmed, count: %d", count)
+	}
+	addressUpdates, err := database.GetAddressLastUpdate(addrs)
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting address last updates after rollback; %w", err))
+	}
+	for _, addressUpdate := range addressUpdates {
+		if addressUpdate.Time.Unix() != 0 {
+			t.Errorf("address update not cleared after rollback: %s", addressUpdate.Address)
+		}
+	}
+}
+
+func TestRemoveTxsDescendants(t *testing.T) {
+	database := getTestDatabase(t)
+	mainBlock := graph.Block{Hash: testBlockHashMain, Height: 1, Timestamp: time.Unix(1600000000, 0)}
+	if err := database.SaveTxs([]graph.Tx{
+		getTestTx(test_tx.GenericTxHashString0, "", testAddress1, mainBlock),
+		getTestTx(test_tx.GenericTxHashString1, test_tx.GenericTxHashString0, testAddress2),
+		getTestTx(test_tx.GenericTxHashString2, test_tx.GenericTxHashString1, testAddress1),
+		getTestTx(test_tx.GenericTxHashString3, test_tx.GenericTxHashString2, testAddress2),
+	}); err != nil {
+		t.Fatal(fmt.Errorf("error saving test txs; %w", err))
+	}
+	if err := database.RemoveTxs([]string{test_tx.GenericTxHashString1}); err != nil {
+		t.Fatal(fmt.Errorf("error removing conflicted tx; %w", err))
+	}
+	for _, txHash := range []string{
+		test_tx.GenericTxHashString1,
+		test_tx.GenericTxHashString2,
+		test_tx.GenericTxHashString3,
+	} {
+		for _, table := range []string{sql.TableTxs, sql.TableInputs, sql.TableOutputs} {
+			if count := getTestCount(t, database, table, "hash", txHash); count != 0 {
+				t.Errorf("unexpected %s for removed tx: %s, count: %d", table, txHash, count)
+			}
+		}
+	}
+	if count := getTestCount(t, database, sql.TableTxs, "hash", test_tx.GenericTxHashString0); count != 1 {
+		t.Errorf("expected parent of conflicted tx kept, count: %d", count)
+	}
+	utxos, err := database.GetUtxos(getTestAddrs(t, testAddress1))
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting utxos after remove txs; %w", err))
+	}
+	if len(utxos) != 1 || utxos[0].Hash != test_tx.GenericTxHashString0 {
+		t.Errorf("expected output spent by removed tx to be unspent, utxos: %d", len(utxos))
+	}
+}
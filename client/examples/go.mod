@@ -14,6 +14,7 @@ require (
 require (
 	github.com/FactomProject/basen v0.0.0-20150613233007-fe3947df716e // indirect
 	github.com/FactomProject/btcutilecc v0.0.0-20130527213604-d3a63a5752ec // indirect
+	github.com/gorilla/websocket v1.5.0 // indirect
 	github.com/jchavannes/bchutil v1.1.5-0.20220519214029-6a6c086b1f21 // indirect
 	github.com/jchavannes/btclog v1.1.0 // indirect
 	github.com/jchavannes/btcutil v1.1.4 // indirect
@@ -21,8 +22,10 @@ require (
 	github.com/jchavannes/gorm v0.0.0-20190714222221-6e209826f9bd // indirect
 	github.com/jchavannes/jgo v0.0.0-20240515195449-361d07b9e227 // indirect
 	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/stretchr/testify v1.7.0 // indirect
 	github.com/tyler-smith/go-bip32 v1.0.0 // indirect
 	golang.org/x/crypto v0.21.0 // indirect
 	golang.org/x/sys v0.20.0 // indirect
 	golang.org/x/text v0.14.0 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b // indirect
 )
@@ -1,12 +1,8 @@
 package main
 
 import (
-	"encoding/hex"
 	"example/common"
-	"github.com/memocash/index/ref/bitcoin/memo"
-	"github.com/memocash/index/ref/bitcoin/tx/gen"
 	"github.com/memocash/index/ref/bitcoin/tx/parse"
-	"github.com/memocash/index/ref/bitcoin/tx/script"
 	"log"
 	"os"
 	"strings"
@@ -21,21 +17,12 @@ func main() {
 	if err != nil {
 		log.Fatalf("error creating new wallet; %v", err)
 	}
-	tx, err := gen.Tx(gen.TxRequest{
-		Getter: wlt,
-		Outputs: []*memo.Output{{Script: &script.Post{
-			Message: msg,
-		}}},
-		Change:  wlt.Change,
-		KeyRing: wlt.KeyRing,
-	})
+	txs, err := wlt.Post(msg)
 	if err != nil {
-		log.Fatalf("error generating memo post tx; %v", err)
+		log.Fatalf("error sending memo post tx; %v", err)
 	}
-	txInfo := parse.GetTxInfo(tx)
-	txInfo.Print()
-	if err := wlt.Client.Broadcast(hex.EncodeToString(txInfo.Raw)); err != nil {
-		log.Fatalf("error broadcasting tx; %v", err)
+	for _, tx := range txs {
+		parse.GetTxInfo(tx).Print()
 	}
 	log.Println("Tx broadcast!")
 }
@@ -1,13 +1,9 @@
 package main
 
 import (
-	"encoding/hex"
 	"example/common"
 	"github.com/jchavannes/btcd/chaincfg/chainhash"
-	"github.com/memocash/index/ref/bitcoin/memo"
-	"github.com/memocash/index/ref/bitcoin/tx/gen"
 	"github.com/memocash/index/ref/bitcoin/tx/parse"
-	"github.com/memocash/index/ref/bitcoin/tx/script"
 	"log"
 	"os"
 	"strings"
@@ -29,22 +25,12 @@ func main() {
 	if err != nil {
 		log.Fatalf("error creating new wallet; %v", err)
 	}
-	tx, err := gen.Tx(gen.TxRequest{
-		Getter: wlt,
-		Outputs: []*memo.Output{{Script: &script.Reply{
-			TxHash:  parentHash[:],
-			Message: msg,
-		}}},
-		Change:  wlt.Change,
-		KeyRing: wlt.KeyRing,
-	})
+	txs, err := wlt.Reply(parentHash[:], msg)
 	if err != nil {
-		log.Fatalf("error generating memo reply tx; %v", err)
+		log.Fatalf("error sending memo reply tx; %v", err)
 	}
-	txInfo := parse.GetTxInfo(tx)
-	txInfo.Print()
-	if err := wlt.Client.Broadcast(hex.EncodeToString(txInfo.Raw)); err != nil {
-		log.Fatalf("error broadcasting memo reply tx; %v", err)
+	for _, tx := range txs {
+		parse.GetTxInfo(tx).Print()
 	}
 	log.Println("Memo reply tx broadcast!")
 }
@@ -6,15 +6,149 @@ import (
 	"fmt"
 	"github.com/memocash/index/client/lib/graph"
 	"github.com/memocash/index/ref/bitcoin/wallet"
+	"sync"
 	"time"
 )
 
+const (
+	// ReorgCheckDepth is the number of newest stored blocks compared with the index when checking for a reorg.
+	ReorgCheckDepth = 10
+	// ReorgCheckInterval is the minimum time between reorg checks.
+	ReorgCheckInterval = time.Minute
+)
+
 type Client struct {
-	GraphUrl string
-	Database Database
+	GraphUrl       string
+	Database       Database
+	lives          []*Live
+	lastReorgCheck time.Time
+	mutex          sync.Mutex
+}
+
+func (c *Client) addLive(live *Live) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.lives = append(c.lives, live)
+}
+
+func (c *Client) removeLive(live *Live) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for i := range c.lives {
+		if c.lives[i] == live {
+			c.lives = append(c.lives[:i], c.lives[i+1:]...)
+			return
+		}
+	}
+}
+
+// getNotLiveAddresses filters out addresses kept up to date by a synced live subscription.
+func (c *Client) getNotLiveAddresses(addresses []wallet.Addr) []wallet.Addr {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if len(c.lives) == 0 {
+		return addresses
+	}
+	var notLive []wallet.Addr
+AddressLoop:
+	for _, address := range addresses {
+		for _, live := range c.lives {
+			if live.IsSynced(address) {
+				continue AddressLoop
+			}
+		}
+		notLive = append(notLive, address)
+	}
+	return notLive
+}
+
+// resyncLives makes live subscriptions reconnect and catch up, used after stored data is rolled back or reset.
+func (c *Client) resyncLives() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, live := range c.lives {
+		live.resync()
+	}
+}
+
+// checkReorg compares the newest stored blocks with the index and rolls back stored blocks replaced at the same
+// height. Returns true if any blocks were rolled back.
+func (c *Client) checkReorg() (bool, error) {
+	c.mutex.Lock()
+	if time.Since(c.lastReorgCheck) < ReorgCheckInterval {
+		c.mutex.Unlock()
+		return false, nil
+	}
+	c.lastReorgCheck = time.Now()
+	c.mutex.Unlock()
+	storedBlocks, err := c.Database.GetBlocks(ReorgCheckDepth)
+	if err != nil {
+		return false, fmt.Errorf("error getting stored blocks for reorg check; %w", err)
+	}
+	var minHeight int64
+	for _, storedBlock := range storedBlocks {
+		if storedBlock.Height > 0 && (minHeight == 0 || storedBlock.Height < minHeight) {
+			minHeight = storedBlock.Height
+		}
+	}
+	if minHeight == 0 {
+		return false, nil
+	}
+	blocks, err := graph.GetBlocks(c.GraphUrl, minHeight)
+	if err != nil {
+		return false, fmt.Errorf("error getting index blocks for reorg check; %w", err)
+	}
+	var orphanedHashes []string
+	for _, storedBlock := range storedBlocks {
+		for _, block := range blocks {
+			if block.Height == storedBlock.Height && block.Hash != storedBlock.Hash {
+				orphanedHashes = append(orphanedHashes, storedBlock.Hash)
+				break
+			}
+		}
+	}
+	if len(orphanedHashes) == 0 {
+		return false, nil
+	}
+	if err := c.Database.RollbackBlocks(orphanedHashes); err != nil {
+		return false, fmt.Errorf("error rolling back orphaned blocks; %w", err)
+	}
+	c.resyncLives()
+	return true, nil
+}
+
+// saveTxs saves txs and removes any the index marks as conflicted.
+func (c *Client) saveTxs(txs []graph.Tx) error {
+	valid, conflicted := SplitConflicted(txs)
+	if len(conflicted) > 0 {
+		if err := c.Database.RemoveTxs(conflicted); err != nil {
+			return fmt.Errorf("error removing conflicted txs; %w", err)
+		}
+	}
+	if len(valid) > 0 {
+		if err := c.Database.SaveTxs(valid); err != nil {
+			return fmt.Errorf("error saving valid txs; %w", err)
+		}
+	}
+	return nil
 }
 
 func (c *Client) updateDb(addresses []wallet.Addr) error {
+	rolledBack, err := c.checkReorg()
+	if err != nil {
+		return fmt.Errorf("error checking reorg for update db; %w", err)
+	}
+	if !rolledBack {
+		addresses = c.getNotLiveAddresses(addresses)
+	}
+	return c.syncAddresses(addresses)
+}
+
+// syncAddresses fetches and saves history for addresses from their last update times until caught up.
+func (c *Client) syncAddresses(addresses []wallet.Addr) error {
+	if len(addresses) == 0 {
+		return nil
+	}
 	var prevLastUpdates []graph.AddressUpdate
 	for {
 		lastUpdates, err := c.Database.GetAddressLastUpdate(addresses)
@@ -39,7 +173,7 @@ func (c *Client) updateDb(addresses []wallet.Addr) error {
 		if err != nil {
 			return fmt.Errorf("error getting history txs; %w", err)
 		}
-		if err := c.Database.SaveTxs(history.GetAllTxs()); err != nil {
+		if err := c.saveTxs(history.GetAllTxs()); err != nil {
 			return fmt.Errorf("error saving txs; %w", err)
 		}
 		var addressUpdates []graph.AddressUpdate
@@ -62,6 +196,19 @@ func (c *Client) updateDb(addresses []wallet.Addr) error {
 	return nil
 }
 
+// Resync removes stored txs for the addresses and fetches their history again. Use as a last resort if stored data
+// is inconsistent with the index.
+func (c *Client) Resync(addresses []wallet.Addr) error {
+	if err := c.Database.ResetAddresses(addresses); err != nil {
+		return fmt.Errorf("error resetting addresses for resync; %w", err)
+	}
+	c.resyncLives()
+	if err := c.syncAddresses(addresses); err != nil {
+		return fmt.Errorf("error syncing addresses for resync; %w", err)
+	}
+	return nil
+}
+
 func (c *Client) Broadcast(txRaw string) error {
 	if err := graph.Broadcast(c.GraphUrl, txRaw); err != nil {
 		return fmt.Errorf("error broadcasting lib client tx; %w", err)
@@ -99,6 +246,24 @@ func (c *Client) GetUtxos(addresses []wallet.Addr) ([]graph.Output, error) {
 	return utxos, nil
 }
 
+// GetUsedAddresses returns the addresses with any tx history in the index.
+func (c *Client) GetUsedAddresses(addresses []wallet.Addr) ([]wallet.Addr, error) {
+	if err := c.updateDb(addresses); err != nil {
+		return nil, fmt.Errorf("error updating db for get used addresses; %w", err)
+	}
+	lastUpdates, err := c.Database.GetAddressLastUpdate(addresses)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("error getting address last update for used addresses; %w", err)
+	}
+	var used []wallet.Addr
+	for _, lastUpdate := range lastUpdates {
+		if lastUpdate.Time.Unix() > 0 {
+			used = append(used, lastUpdate.Address)
+		}
+	}
+	return used, nil
+}
+
 func NewClient(graphUrl string, database Database) *Client {
 	return &Client{
 		GraphUrl: graphUrl,
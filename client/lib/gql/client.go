@@ -0,0 +1,139 @@
+//go:generate go run ./gen
+
+// Package gql is a typed GraphQL client for the index. Types and operations in generated.go are generated from
+// graph/schema, run go generate after changing the schema.
+package gql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/memocash/index/client/lib/graph"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const DefaultTimeout = 60 * time.Second
+
+// Error is an error returned in a GraphQL response.
+type Error struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path"`
+}
+
+func (e Error) Error() string {
+	if len(e.Path) == 0 {
+		return fmt.Sprintf("error graph response: %s", e.Message)
+	}
+	return fmt.Sprintf("error graph response: %v %s", e.Path, e.Message)
+}
+
+type Client struct {
+	Url  string
+	Http *http.Client
+}
+
+// GetSelection wraps a selection in braces, using the default fields if empty.
+func GetSelection(selection, defaultFields string) string {
+	return "{ " + GetSelectionFields(selection, defaultFields) + " }"
+}
+
+// GetSelectionFields returns the selection without braces, using the default fields if empty.
+func GetSelectionFields(selection, defaultFields string) string {
+	if selection == "" {
+		return defaultFields
+	}
+	return selection
+}
+
+// Select joins fields into a selection, e.g. Select(TxFields, Field("inputs", TxInputFields)).
+func Select(fields ...string) string {
+	return strings.Join(fields, " ")
+}
+
+// Field selects an object field with a sub selection, args are included as written, e.g. "(start: 10)".
+func Field(name, selection string, args ...string) string {
+	return name + strings.Join(args, "") + " { " + selection + " }"
+}
+
+// Do posts a query and unmarshals the response data into result.
+func (c *Client) Do(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	reqBody, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return fmt.Errorf("error marshaling graph request; %w", err)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.Url, bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("error creating graph request; %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := c.Http.Do(request)
+	if err != nil {
+		return fmt.Errorf("error graph request failed; %w", err)
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("error reading graph response body; %w", err)
+	}
+	var resp struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []Error         `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("error unmarshalling graph response (status: %d); %w", response.StatusCode, err)
+	}
+	if len(resp.Errors) > 0 {
+		return resp.Errors[0]
+	}
+	if err := json.Unmarshal(resp.Data, result); err != nil {
+		return fmt.Errorf("error unmarshalling graph response data; %w", err)
+	}
+	return nil
+}
+
+// Subscribe opens a websocket subscription and calls handler with the data for each result until ctx is done, the
+// server completes the subscription or handler returns an error.
+func (c *Client) Subscribe(ctx context.Context, query string, variables map[string]interface{},
+	handler func(data json.RawMessage) error) error {
+	sub, err := graph.Subscribe(c.Url, query, variables)
+	if err != nil {
+		return fmt.Errorf("error opening graph subscription; %w", err)
+	}
+	var done = make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			sub.Close()
+		case <-done:
+			sub.Close()
+		}
+	}()
+	for {
+		data, err := sub.NextData()
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, graph.SubscriptionCompleteError) {
+				return nil
+			}
+			return fmt.Errorf("error getting graph subscription data; %w", err)
+		}
+		if err := handler(data); err != nil {
+			return fmt.Errorf("error handling graph subscription data; %w", err)
+		}
+	}
+}
+
+func NewClient(url string) *Client {
+	return &Client{
+		Url:  url,
+		Http: &http.Client{Timeout: DefaultTimeout},
+	}
+}
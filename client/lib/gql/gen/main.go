@@ -0,0 +1,316 @@
+// Command gen generates the typed GraphQL client in client/lib/gql from the graph schema files used by gqlgen.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// scalars maps schema scalars to Go types, custom scalars use the same string encodings as graph/model.
+var scalars = map[string]string{
+	"Boolean":   "bool",
+	"String":    "string",
+	"ID":        "string",
+	"Int":       "int",
+	"Float":     "float64",
+	"Int32":     "int32",
+	"Int64":     "int64",
+	"Uint8":     "uint8",
+	"Uint32":    "uint32",
+	"Uint64":    "uint64",
+	"HashIndex": "string",
+	"Date":      "time.Time",
+	"Hash":      "string",
+	"Address":   "string",
+	"Bytes":     "string",
+}
+
+type generator struct {
+	Schema *ast.Schema
+	buf    bytes.Buffer
+}
+
+func (g *generator) p(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+	g.buf.WriteString("\n")
+}
+
+// goName converts snake_case and camelCase schema names to exported Go names.
+func goName(name string) string {
+	var out strings.Builder
+	upper := true
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			out.WriteRune(unicode.ToUpper(r))
+			upper = false
+		} else {
+			out.WriteRune(r)
+		}
+	}
+	return out.String()
+}
+
+func (g *generator) isObject(name string) bool {
+	def := g.Schema.Types[name]
+	return def != nil && (def.Kind == ast.Object || def.Kind == ast.Interface || def.Kind == ast.Union)
+}
+
+func (g *generator) namedType(name string) string {
+	if scalar, ok := scalars[name]; ok {
+		return scalar
+	}
+	def := g.Schema.Types[name]
+	if def != nil && def.Kind == ast.Scalar {
+		return "string"
+	}
+	return name
+}
+
+// goType is the Go type for a field or optional argument, objects and nullable scalars are pointers.
+func (g *generator) goType(t *ast.Type) string {
+	if t.Elem != nil {
+		return "[]" + g.goType(t.Elem)
+	}
+	if g.isObject(t.NamedType) || !t.NonNull {
+		return "*" + g.namedType(t.NamedType)
+	}
+	return g.namedType(t.NamedType)
+}
+
+// argType is the Go type for an argument, required scalars are values.
+func (g *generator) argType(t *ast.Type) string {
+	if t.Elem != nil {
+		return "[]" + g.argType(t.Elem)
+	}
+	if !t.NonNull {
+		return "*" + g.namedType(t.NamedType)
+	}
+	return g.namedType(t.NamedType)
+}
+
+func (g *generator) sortedTypes(kind ast.DefinitionKind) []*ast.Definition {
+	var defs []*ast.Definition
+	for _, def := range g.Schema.Types {
+		if def.Kind != kind || def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		if def == g.Schema.Query || def == g.Schema.Mutation || def == g.Schema.Subscription {
+			continue
+		}
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool {
+		return defs[i].Name < defs[j].Name
+	})
+	return defs
+}
+
+func getFields(def *ast.Definition) ast.FieldList {
+	var fields ast.FieldList
+	for _, field := range def.Fields {
+		if !strings.HasPrefix(field.Name, "__") {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func (g *generator) generateEnums() {
+	for _, def := range g.sortedTypes(ast.Enum) {
+		g.p("type %s string", def.Name)
+		g.p("")
+		g.p("const (")
+		for _, value := range def.EnumValues {
+			g.p("%s%s %s = %q", def.Name, goName(strings.ToLower(value.Name)), def.Name, value.Name)
+		}
+		g.p(")")
+		g.p("")
+	}
+}
+
+func (g *generator) generateObjects() {
+	for _, def := range g.sortedTypes(ast.Object) {
+		g.p("type %s struct {", def.Name)
+		var scalarFields []string
+		for _, field := range getFields(def) {
+			g.p("%s %s `json:\"%s\"`", goName(field.Name), g.goType(field.Type), field.Name)
+			if !g.isObject(field.Type.Name()) && !hasRequiredArgs(field) {
+				scalarFields = append(scalarFields, field.Name)
+			}
+		}
+		g.p("}")
+		g.p("")
+		g.p("// %sFields selects the scalar fields of %s.", def.Name, def.Name)
+		g.p("const %sFields = %q", def.Name, strings.Join(scalarFields, " "))
+		g.p("")
+	}
+}
+
+func hasRequiredArgs(field *ast.FieldDefinition) bool {
+	for _, arg := range field.Arguments {
+		if arg.Type.NonNull {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) getOperation(opType string, field *ast.FieldDefinition) (string, string, string) {
+	var params, varDefs, fieldArgs, variables []string
+	for _, arg := range field.Arguments {
+		argName := goName(arg.Name)
+		argName = strings.ToLower(argName[:1]) + argName[1:]
+		params = append(params, fmt.Sprintf("%s %s", argName, g.argType(arg.Type)))
+		varDefs = append(varDefs, fmt.Sprintf("$%s: %s", arg.Name, arg.Type.String()))
+		fieldArgs = append(fieldArgs, fmt.Sprintf("%s: $%s", arg.Name, arg.Name))
+		variables = append(variables, fmt.Sprintf("%q: %s", arg.Name, argName))
+	}
+	var query = opType
+	if len(varDefs) > 0 {
+		query += " (" + strings.Join(varDefs, ", ") + ")"
+	}
+	query += " { " + field.Name
+	if len(fieldArgs) > 0 {
+		query += "(" + strings.Join(fieldArgs, ", ") + ")"
+	}
+	var queryExpr = fmt.Sprintf("%q", query+" }")
+	if g.isObject(field.Type.Name()) {
+		params = append(params, "selection string")
+		queryExpr = fmt.Sprintf("%q + GetSelection(selection, %sFields) + \" }\"", query+" ", field.Type.Name())
+	}
+	var variablesExpr = "nil"
+	if len(variables) > 0 {
+		variablesExpr = "map[string]interface{}{" + strings.Join(variables, ", ") + "}"
+	}
+	return strings.Join(append([]string{"ctx context.Context"}, params...), ", "), queryExpr, variablesExpr
+}
+
+func (g *generator) generateOperations(def *ast.Definition, opType string) {
+	if def == nil {
+		return
+	}
+	for _, field := range getFields(def) {
+		name := goName(field.Name)
+		resultType := g.goType(field.Type)
+		params, queryExpr, variablesExpr := g.getOperation(opType, field)
+		var selectionDoc string
+		if g.isObject(field.Type.Name()) {
+			selectionDoc = fmt.Sprintf(" Selection is the fields selected on the result, %sFields if empty.",
+				field.Type.Name())
+		}
+		if opType == "subscription" {
+			g.p("// Subscribe%s runs the %s subscription, calling handler for each result until ctx is done or "+
+				"handler returns an error.%s", name, field.Name, selectionDoc)
+			g.p("func (c *Client) Subscribe%s(%s, handler func(%s) error) error {", name, params, resultType)
+			g.p("return c.Subscribe(ctx, %s, %s, func(data json.RawMessage) error {", queryExpr, variablesExpr)
+			g.p("var result %s", resultType)
+			g.p("if err := json.Unmarshal(data, &result); err != nil {")
+			g.p("return fmt.Errorf(\"error unmarshalling %s subscription result; %%w\", err)", field.Name)
+			g.p("}")
+			g.p("return handler(result)")
+			g.p("})")
+			g.p("}")
+			g.p("")
+			continue
+		}
+		g.p("// %s runs the %s %s.%s", name, field.Name, opType, selectionDoc)
+		g.p("func (c *Client) %s(%s) (%s, error) {", name, params, resultType)
+		g.p("var result struct {")
+		g.p("Result %s `json:\"%s\"`", resultType, field.Name)
+		g.p("}")
+		g.p("if err := c.Do(ctx, %s, %s, &result); err != nil {", queryExpr, variablesExpr)
+		g.p("return result.Result, fmt.Errorf(\"error running %s %s; %%w\", err)", field.Name, opType)
+		g.p("}")
+		g.p("return result.Result, nil")
+		g.p("}")
+		g.p("")
+	}
+}
+
+func (g *generator) generate() ([]byte, error) {
+	g.generateEnums()
+	g.generateObjects()
+	g.generateOperations(g.Schema.Query, "query")
+	g.generateOperations(g.Schema.Mutation, "mutation")
+	g.generateOperations(g.Schema.Subscription, "subscription")
+	body := g.buf.String()
+	var imports = []string{"context", "fmt"}
+	if strings.Contains(body, "json.") {
+		imports = append(imports, "encoding/json")
+	}
+	if strings.Contains(body, "time.") {
+		imports = append(imports, "time")
+	}
+	sort.Strings(imports)
+	g.buf.Reset()
+	g.p("// Code generated by client/lib/gql/gen from graph/schema; DO NOT EDIT.")
+	g.p("")
+	g.p("package gql")
+	g.p("")
+	g.p("import (")
+	for _, imp := range imports {
+		g.p("%q", imp)
+	}
+	g.p(")")
+	g.p("")
+	g.buf.WriteString(body)
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error formatting generated client; %w", err)
+	}
+	return src, nil
+}
+
+func loadSchema(dir string) (*ast.Schema, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.graphqls"))
+	if err != nil {
+		return nil, fmt.Errorf("error finding schema files; %w", err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("error no schema files found in: %s", dir)
+	}
+	var sources []*ast.Source
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading schema file: %s; %w", file, err)
+		}
+		sources = append(sources, &ast.Source{Name: filepath.Base(file), Input: string(data)})
+	}
+	schema, err := gqlparser.LoadSchema(sources...)
+	if err != nil {
+		return nil, fmt.Errorf("error loading schema; %w", err)
+	}
+	return schema, nil
+}
+
+func main() {
+	schemaDir := flag.String("schema", "../../../graph/schema", "Directory of schema files")
+	out := flag.String("out", "generated.go", "Generated client file")
+	flag.Parse()
+	schema, err := loadSchema(*schemaDir)
+	if err != nil {
+		log.Fatalf("fatal error loading graph schema; %v", err)
+	}
+	src, err := (&generator{Schema: schema}).generate()
+	if err != nil {
+		log.Fatalf("fatal error generating graph client; %v", err)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatalf("fatal error writing generated graph client; %v", err)
+	}
+}
@@ -0,0 +1,543 @@
+// Code generated by client/lib/gql/gen from graph/schema; DO NOT EDIT.
+
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type ThreadSort string
+
+const (
+	ThreadSortOldest ThreadSort = "OLDEST"
+	ThreadSortNewest ThreadSort = "NEWEST"
+	ThreadSortLikes  ThreadSort = "LIKES"
+)
+
+type Block struct {
+	Hash      string     `json:"hash"`
+	Raw       string     `json:"raw"`
+	Timestamp time.Time  `json:"timestamp"`
+	Height    *int       `json:"height"`
+	Size      *int64     `json:"size"`
+	TxCount   *int       `json:"tx_count"`
+	Txs       []*TxBlock `json:"txs"`
+}
+
+// BlockFields selects the scalar fields of Block.
+const BlockFields = "hash raw timestamp height size tx_count"
+
+type BroadcastResult struct {
+	TxHash    string    `json:"tx_hash"`
+	Status    string    `json:"status"`
+	Code      int       `json:"code"`
+	Reason    string    `json:"reason"`
+	Host      string    `json:"host"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// BroadcastResultFields selects the scalar fields of BroadcastResult.
+const BroadcastResultFields = "tx_hash status code reason host timestamp"
+
+type Follow struct {
+	Tx            *Tx    `json:"tx"`
+	TxHash        string `json:"tx_hash"`
+	Lock          *Lock  `json:"lock"`
+	Address       string `json:"address"`
+	FollowLock    *Lock  `json:"follow_lock"`
+	FollowAddress string `json:"follow_address"`
+	Unfollow      bool   `json:"unfollow"`
+}
+
+// FollowFields selects the scalar fields of Follow.
+const FollowFields = "tx_hash address follow_address unfollow"
+
+type Like struct {
+	Tx         *Tx    `json:"tx"`
+	TxHash     string `json:"tx_hash"`
+	Lock       *Lock  `json:"lock"`
+	Address    string `json:"address"`
+	PostTxHash string `json:"post_tx_hash"`
+	Post       *Post  `json:"post"`
+	Tip        *int64 `json:"tip"`
+}
+
+// LikeFields selects the scalar fields of Like.
+const LikeFields = "tx_hash address post_tx_hash tip"
+
+type Lock struct {
+	Address *string  `json:"address"`
+	Profile *Profile `json:"profile"`
+	Txs     []*Tx    `json:"txs"`
+}
+
+// LockFields selects the scalar fields of Lock.
+const LockFields = "address"
+
+type Picture struct {
+	Tx         *Tx     `json:"tx"`
+	TxHash     string  `json:"tx_hash"`
+	Lock       *Lock   `json:"lock"`
+	Address    string  `json:"address"`
+	PostTxHash string  `json:"post_tx_hash"`
+	Picture    string  `json:"picture"`
+	Url        *string `json:"url"`
+}
+
+// PictureFields selects the scalar fields of Picture.
+const PictureFields = "tx_hash address post_tx_hash picture url"
+
+type Post struct {
+	Tx          *Tx        `json:"tx"`
+	TxHash      string     `json:"tx_hash"`
+	Lock        *Lock      `json:"lock"`
+	Address     string     `json:"address"`
+	Text        string     `json:"text"`
+	Likes       []*Like    `json:"likes"`
+	Parent      *Post      `json:"parent"`
+	Replies     []*Post    `json:"replies"`
+	Room        *Room      `json:"room"`
+	RepostOf    *Post      `json:"repost_of"`
+	Reposts     []*Post    `json:"reposts"`
+	RepostCount int        `json:"repost_count"`
+	Pictures    []*Picture `json:"pictures"`
+}
+
+// PostFields selects the scalar fields of Post.
+const PostFields = "tx_hash address text repost_count"
+
+type Profile struct {
+	Lock         *Lock         `json:"lock"`
+	Address      string        `json:"address"`
+	Name         *SetName      `json:"name"`
+	Profile      *SetProfile   `json:"profile"`
+	Pic          *SetPic       `json:"pic"`
+	ImageBaseUrl *string       `json:"image_base_url"`
+	Following    []*Follow     `json:"following"`
+	Followers    []*Follow     `json:"followers"`
+	Posts        []*Post       `json:"posts"`
+	Reposts      []*Post       `json:"reposts"`
+	Rooms        []*RoomFollow `json:"rooms"`
+}
+
+// ProfileFields selects the scalar fields of Profile.
+const ProfileFields = "address image_base_url"
+
+type Room struct {
+	Name      string        `json:"name"`
+	Posts     []*Post       `json:"posts"`
+	Followers []*RoomFollow `json:"followers"`
+}
+
+// RoomFields selects the scalar fields of Room.
+const RoomFields = "name"
+
+type RoomFollow struct {
+	Name     string `json:"name"`
+	Room     *Room  `json:"room"`
+	Lock     *Lock  `json:"lock"`
+	Address  string `json:"address"`
+	Unfollow bool   `json:"unfollow"`
+	TxHash   string `json:"tx_hash"`
+	Tx       *Tx    `json:"tx"`
+}
+
+// RoomFollowFields selects the scalar fields of RoomFollow.
+const RoomFollowFields = "name address unfollow tx_hash"
+
+type SetName struct {
+	Tx      *Tx    `json:"tx"`
+	TxHash  string `json:"tx_hash"`
+	Lock    *Lock  `json:"lock"`
+	Address string `json:"address"`
+	Name    string `json:"name"`
+}
+
+// SetNameFields selects the scalar fields of SetName.
+const SetNameFields = "tx_hash address name"
+
+type SetPic struct {
+	Tx      *Tx    `json:"tx"`
+	TxHash  string `json:"tx_hash"`
+	Lock    *Lock  `json:"lock"`
+	Address string `json:"address"`
+	Pic     string `json:"pic"`
+}
+
+// SetPicFields selects the scalar fields of SetPic.
+const SetPicFields = "tx_hash address pic"
+
+type SetProfile struct {
+	Tx      *Tx    `json:"tx"`
+	TxHash  string `json:"tx_hash"`
+	Lock    *Lock  `json:"lock"`
+	Address string `json:"address"`
+	Text    string `json:"text"`
+}
+
+// SetProfileFields selects the scalar fields of SetProfile.
+const SetProfileFields = "tx_hash address text"
+
+type SlpBaton struct {
+	Output    *TxOutput   `json:"output"`
+	Hash      string      `json:"hash"`
+	Index     uint32      `json:"index"`
+	TokenHash string      `json:"token_hash"`
+	Genesis   *SlpGenesis `json:"genesis"`
+}
+
+// SlpBatonFields selects the scalar fields of SlpBaton.
+const SlpBatonFields = "hash index token_hash"
+
+type SlpGenesis struct {
+	Tx         *Tx        `json:"tx"`
+	Hash       string     `json:"hash"`
+	TokenType  uint8      `json:"token_type"`
+	Decimals   uint8      `json:"decimals"`
+	Output     *SlpOutput `json:"output"`
+	Baton      *SlpBaton  `json:"baton"`
+	BatonIndex uint32     `json:"baton_index"`
+	Ticker     string     `json:"ticker"`
+	Name       string     `json:"name"`
+	DocUrl     string     `json:"doc_url"`
+	DocHash    string     `json:"doc_hash"`
+}
+
+// SlpGenesisFields selects the scalar fields of SlpGenesis.
+const SlpGenesisFields = "hash token_type decimals baton_index ticker name doc_url doc_hash"
+
+type SlpOutput struct {
+	Output    *TxOutput   `json:"output"`
+	Hash      string      `json:"hash"`
+	Index     uint32      `json:"index"`
+	Amount    uint64      `json:"amount"`
+	TokenHash string      `json:"token_hash"`
+	Genesis   *SlpGenesis `json:"genesis"`
+}
+
+// SlpOutputFields selects the scalar fields of SlpOutput.
+const SlpOutputFields = "hash index amount token_hash"
+
+type ThreadNode struct {
+	TxHash       string   `json:"tx_hash"`
+	ParentTxHash *string  `json:"parent_tx_hash"`
+	Path         []string `json:"path"`
+	Depth        int      `json:"depth"`
+	ReplyCount   int      `json:"reply_count"`
+	LikeCount    int      `json:"like_count"`
+	Post         *Post    `json:"post"`
+}
+
+// ThreadNodeFields selects the scalar fields of ThreadNode.
+const ThreadNodeFields = "tx_hash parent_tx_hash path depth reply_count like_count"
+
+type Tx struct {
+	Hash       string      `json:"hash"`
+	Raw        string      `json:"raw"`
+	Inputs     []*TxInput  `json:"inputs"`
+	Outputs    []*TxOutput `json:"outputs"`
+	Blocks     []*TxBlock  `json:"blocks"`
+	Seen       *time.Time  `json:"seen"`
+	Version    int32       `json:"version"`
+	Locktime   uint32      `json:"locktime"`
+	Conflicted bool        `json:"conflicted"`
+}
+
+// TxFields selects the scalar fields of Tx.
+const TxFields = "hash raw seen version locktime conflicted"
+
+type TxBlock struct {
+	TxHash    string `json:"tx_hash"`
+	Tx        *Tx    `json:"tx"`
+	BlockHash string `json:"block_hash"`
+	Block     *Block `json:"block"`
+	Index     uint32 `json:"index"`
+}
+
+// TxBlockFields selects the scalar fields of TxBlock.
+const TxBlockFields = "tx_hash block_hash index"
+
+type TxInput struct {
+	Tx        *Tx       `json:"tx"`
+	Hash      string    `json:"hash"`
+	Index     uint32    `json:"index"`
+	Script    string    `json:"script"`
+	PrevHash  string    `json:"prev_hash"`
+	PrevIndex uint32    `json:"prev_index"`
+	Output    *TxOutput `json:"output"`
+	Sequence  uint32    `json:"sequence"`
+}
+
+// TxInputFields selects the scalar fields of TxInput.
+const TxInputFields = "hash index script prev_hash prev_index sequence"
+
+type TxOutput struct {
+	Tx       *Tx        `json:"tx"`
+	Hash     string     `json:"hash"`
+	Index    uint32     `json:"index"`
+	Amount   int64      `json:"amount"`
+	Script   string     `json:"script"`
+	Spends   []*TxInput `json:"spends"`
+	Slp      *SlpOutput `json:"slp"`
+	SlpBaton *SlpBaton  `json:"slp_baton"`
+	Lock     *Lock      `json:"lock"`
+}
+
+// TxOutputFields selects the scalar fields of TxOutput.
+const TxOutputFields = "hash index amount script"
+
+// Tx runs the tx query. Selection is the fields selected on the result, TxFields if empty.
+func (c *Client) Tx(ctx context.Context, hash string, selection string) (*Tx, error) {
+	var result struct {
+		Result *Tx `json:"tx"`
+	}
+	if err := c.Do(ctx, "query ($hash: Hash!) { tx(hash: $hash) "+GetSelection(selection, TxFields)+" }", map[string]interface{}{"hash": hash}, &result); err != nil {
+		return result.Result, fmt.Errorf("error running tx query; %w", err)
+	}
+	return result.Result, nil
+}
+
+// Txs runs the txs query. Selection is the fields selected on the result, TxFields if empty.
+func (c *Client) Txs(ctx context.Context, hashes []string, selection string) ([]*Tx, error) {
+	var result struct {
+		Result []*Tx `json:"txs"`
+	}
+	if err := c.Do(ctx, "query ($hashes: [Hash!]) { txs(hashes: $hashes) "+GetSelection(selection, TxFields)+" }", map[string]interface{}{"hashes": hashes}, &result); err != nil {
+		return result.Result, fmt.Errorf("error running txs query; %w", err)
+	}
+	return result.Result, nil
+}
+
+// Address runs the address query. Selection is the fields selected on the result, LockFields if empty.
+func (c *Client) Address(ctx context.Context, address string, selection string) (*Lock, error) {
+	var result struct {
+		Result *Lock `json:"address"`
+	}
+	if err := c.Do(ctx, "query ($address: Address!) { address(address: $address) "+GetSelection(selection, LockFields)+" }", map[string]interface{}{"address": address}, &result); err != nil {
+		return result.Result, fmt.Errorf("error running address query; %w", err)
+	}
+	return result.Result, nil
+}
+
+// Addresses runs the addresses query. Selection is the fields selected on the result, LockFields if empty.
+func (c *Client) Addresses(ctx context.Context, addresses []string, selection string) ([]*Lock, error) {
+	var result struct {
+		Result []*Lock `json:"addresses"`
+	}
+	if err := c.Do(ctx, "query ($addresses: [Address!]) { addresses(addresses: $addresses) "+GetSelection(selection, LockFields)+" }", map[string]interface{}{"addresses": addresses}, &result); err != nil {
+		return result.Result, fmt.Errorf("error running addresses query; %w", err)
+	}
+	return result.Result, nil
+}
+
+// Block runs the block query. Selection is the fields selected on the result, BlockFields if empty.
+func (c *Client) Block(ctx context.Context, hash string, selection string) (*Block, error) {
+	var result struct {
+		Result *Block `json:"block"`
+	}
+	if err := c.Do(ctx, "query ($hash: Hash!) { block(hash: $hash) "+GetSelection(selection, BlockFields)+" }", map[string]interface{}{"hash": hash}, &result); err != nil {
+		return result.Result, fmt.Errorf("error running block query; %w", err)
+	}
+	return result.Result, nil
+}
+
+// BlockNewest runs the block_newest query. Selection is the fields selected on the result, BlockFields if empty.
+func (c *Client) BlockNewest(ctx context.Context, selection string) (*Block, error) {
+	var result struct {
+		Result *Block `json:"block_newest"`
+	}
+	if err := c.Do(ctx, "query { block_newest "+GetSelection(selection, BlockFields)+" }", nil, &result); err != nil {
+		return result.Result, fmt.Errorf("error running block_newest query; %w", err)
+	}
+	return result.Result, nil
+}
+
+// Blocks runs the blocks query. Selection is the fields selected on the result, BlockFields if empty.
+func (c *Client) Blocks(ctx context.Context, newest *bool, start *uint32, selection string) ([]*Block, error) {
+	var result struct {
+		Result []*Block `json:"blocks"`
+	}
+	if err := c.Do(ctx, "query ($newest: Boolean, $start: Uint32) { blocks(newest: $newest, start: $start) "+GetSelection(selection, BlockFields)+" }", map[string]interface{}{"newest": newest, "start": start}, &result); err != nil {
+		return result.Result, fmt.Errorf("error running blocks query; %w", err)
+	}
+	return result.Result, nil
+}
+
+// Profiles runs the profiles query. Selection is the fields selected on the result, ProfileFields if empty.
+func (c *Client) Profiles(ctx context.Context, addresses []string, selection string) ([]*Profile, error) {
+	var result struct {
+		Result []*Profile `json:"profiles"`
+	}
+	if err := c.Do(ctx, "query ($addresses: [Address!]) { profiles(addresses: $addresses) "+GetSelection(selection, ProfileFields)+" }", map[string]interface{}{"addresses": addresses}, &result); err != nil {
+		return result.Result, fmt.Errorf("error running profiles query; %w", err)
+	}
+	return result.Result, nil
+}
+
+// Posts runs the posts query. Selection is the fields selected on the result, PostFields if empty.
+func (c *Client) Posts(ctx context.Context, txHashes []string, selection string) ([]*Post, error) {
+	var result struct {
+		Result []*Post `json:"posts"`
+	}
+	if err := c.Do(ctx, "query ($txHashes: [Hash!]) { posts(txHashes: $txHashes) "+GetSelection(selection, PostFields)+" }", map[string]interface{}{"txHashes": txHashes}, &result); err != nil {
+		return result.Result, fmt.Errorf("error running posts query; %w", err)
+	}
+	return result.Result, nil
+}
+
+// PostsNewest runs the posts_newest query. Selection is the fields selected on the result, PostFields if empty.
+func (c *Client) PostsNewest(ctx context.Context, start *time.Time, tx *string, limit *uint32, selection string) ([]*Post, error) {
+	var result struct {
+		Result []*Post `json:"posts_newest"`
+	}
+	if err := c.Do(ctx, "query ($start: Date, $tx: Hash, $limit: Uint32) { posts_newest(start: $start, tx: $tx, limit: $limit) "+GetSelection(selection, PostFields)+" }", map[string]interface{}{"start": start, "tx": tx, "limit": limit}, &result); err != nil {
+		return result.Result, fmt.Errorf("error running posts_newest query; %w", err)
+	}
+	return result.Result, nil
+}
+
+// Room runs the room query. Selection is the fields selected on the result, RoomFields if empty.
+func (c *Client) Room(ctx context.Context, name string, selection string) (*Room, error) {
+	var result struct {
+		Result *Room `json:"room"`
+	}
+	if err := c.Do(ctx, "query ($name: String!) { room(name: $name) "+GetSelection(selection, RoomFields)+" }", map[string]interface{}{"name": name}, &result); err != nil {
+		return result.Result, fmt.Errorf("error running room query; %w", err)
+	}
+	return result.Result, nil
+}
+
+// Thread runs the thread query. Selection is the fields selected on the result, ThreadNodeFields if empty.
+func (c *Client) Thread(ctx context.Context, root string, maxDepth *int, sort *ThreadSort, selection string) ([]*ThreadNode, error) {
+	var result struct {
+		Result []*ThreadNode `json:"thread"`
+	}
+	if err := c.Do(ctx, "query ($root: Hash!, $maxDepth: Int, $sort: ThreadSort) { thread(root: $root, maxDepth: $maxDepth, sort: $sort) "+GetSelection(selection, ThreadNodeFields)+" }", map[string]interface{}{"root": root, "maxDepth": maxDepth, "sort": sort}, &result); err != nil {
+		return result.Result, fmt.Errorf("error running thread query; %w", err)
+	}
+	return result.Result, nil
+}
+
+// BroadcastResult runs the broadcast_result query. Selection is the fields selected on the result, BroadcastResultFields if empty.
+func (c *Client) BroadcastResult(ctx context.Context, hash string, selection string) (*BroadcastResult, error) {
+	var result struct {
+		Result *BroadcastResult `json:"broadcast_result"`
+	}
+	if err := c.Do(ctx, "query ($hash: Hash!) { broadcast_result(hash: $hash) "+GetSelection(selection, BroadcastResultFields)+" }", map[string]interface{}{"hash": hash}, &result); err != nil {
+		return result.Result, fmt.Errorf("error running broadcast_result query; %w", err)
+	}
+	return result.Result, nil
+}
+
+// Broadcast runs the broadcast mutation.
+func (c *Client) Broadcast(ctx context.Context, raw string) (bool, error) {
+	var result struct {
+		Result bool `json:"broadcast"`
+	}
+	if err := c.Do(ctx, "mutation ($raw: String!) { broadcast(raw: $raw) }", map[string]interface{}{"raw": raw}, &result); err != nil {
+		return result.Result, fmt.Errorf("error running broadcast mutation; %w", err)
+	}
+	return result.Result, nil
+}
+
+// SubscribeAddress runs the address subscription, calling handler for each result until ctx is done or handler returns an error. Selection is the fields selected on the result, TxFields if empty.
+func (c *Client) SubscribeAddress(ctx context.Context, address string, selection string, handler func(*Tx) error) error {
+	return c.Subscribe(ctx, "subscription ($address: Address!) { address(address: $address) "+GetSelection(selection, TxFields)+" }", map[string]interface{}{"address": address}, func(data json.RawMessage) error {
+		var result *Tx
+		if err := json.Unmarshal(data, &result); err != nil {
+			return fmt.Errorf("error unmarshalling address subscription result; %w", err)
+		}
+		return handler(result)
+	})
+}
+
+// SubscribeAddresses runs the addresses subscription, calling handler for each result until ctx is done or handler returns an error. Selection is the fields selected on the result, TxFields if empty.
+func (c *Client) SubscribeAddresses(ctx context.Context, addresses []string, selection string, handler func(*Tx) error) error {
+	return c.Subscribe(ctx, "subscription ($addresses: [Address!]) { addresses(addresses: $addresses) "+GetSelection(selection, TxFields)+" }", map[string]interface{}{"addresses": addresses}, func(data json.RawMessage) error {
+		var result *Tx
+		if err := json.Unmarshal(data, &result); err != nil {
+			return fmt.Errorf("error unmarshalling addresses subscription result; %w", err)
+		}
+		return handler(result)
+	})
+}
+
+// SubscribeBlocks runs the blocks subscription, calling handler for each result until ctx is done or handler returns an error. Selection is the fields selected on the result, BlockFields if empty.
+func (c *Client) SubscribeBlocks(ctx context.Context, selection string, handler func(*Block) error) error {
+	return c.Subscribe(ctx, "subscription { blocks "+GetSelection(selection, BlockFields)+" }", nil, func(data json.RawMessage) error {
+		var result *Block
+		if err := json.Unmarshal(data, &result); err != nil {
+			return fmt.Errorf("error unmarshalling blocks subscription result; %w", err)
+		}
+		return handler(result)
+	})
+}
+
+// SubscribePosts runs the posts subscription, calling handler for each result until ctx is done or handler returns an error. Selection is the fields selected on the result, PostFields if empty.
+func (c *Client) SubscribePosts(ctx context.Context, hashes []string, selection string, handler func(*Post) error) error {
+	return c.Subscribe(ctx, "subscription ($hashes: [Hash!]) { posts(hashes: $hashes) "+GetSelection(selection, PostFields)+" }", map[string]interface{}{"hashes": hashes}, func(data json.RawMessage) error {
+		var result *Post
+		if err := json.Unmarshal(data, &result); err != nil {
+			return fmt.Errorf("error unmarshalling posts subscription result; %w", err)
+		}
+		return handler(result)
+	})
+}
+
+// SubscribeProfiles runs the profiles subscription, calling handler for each result until ctx is done or handler returns an error. Selection is the fields selected on the result, ProfileFields if empty.
+func (c *Client) SubscribeProfiles(ctx context.Context, addresses []string, selection string, handler func(*Profile) error) error {
+	return c.Subscribe(ctx, "subscription ($addresses: [Address!]) { profiles(addresses: $addresses) "+GetSelection(selection, ProfileFields)+" }", map[string]interface{}{"addresses": addresses}, func(data json.RawMessage) error {
+		var result *Profile
+		if err := json.Unmarshal(data, &result); err != nil {
+			return fmt.Errorf("error unmarshalling profiles subscription result; %w", err)
+		}
+		return handler(result)
+	})
+}
+
+// SubscribeRooms runs the rooms subscription, calling handler for each result until ctx is done or handler returns an error. Selection is the fields selected on the result, PostFields if empty.
+func (c *Client) SubscribeRooms(ctx context.Context, names []string, selection string, handler func(*Post) error) error {
+	return c.Subscribe(ctx, "subscription ($names: [String!]) { rooms(names: $names) "+GetSelection(selection, PostFields)+" }", map[string]interface{}{"names": names}, func(data json.RawMessage) error {
+		var result *Post
+		if err := json.Unmarshal(data, &result); err != nil {
+			return fmt.Errorf("error unmarshalling rooms subscription result; %w", err)
+		}
+		return handler(result)
+	})
+}
+
+// SubscribeRoomFollows runs the room_follows subscription, calling handler for each result until ctx is done or handler returns an error. Selection is the fields selected on the result, RoomFollowFields if empty.
+func (c *Client) SubscribeRoomFollows(ctx context.Context, addresses []string, selection string, handler func(*RoomFollow) error) error {
+	return c.Subscribe(ctx, "subscription ($addresses: [Address!]) { room_follows(addresses: $addresses) "+GetSelection(selection, RoomFollowFields)+" }", map[string]interface{}{"addresses": addresses}, func(data json.RawMessage) error {
+		var result *RoomFollow
+		if err := json.Unmarshal(data, &result); err != nil {
+			return fmt.Errorf("error unmarshalling room_follows subscription result; %w", err)
+		}
+		return handler(result)
+	})
+}
+
+// SubscribeThread runs the thread subscription, calling handler for each result until ctx is done or handler returns an error. Selection is the fields selected on the result, ThreadNodeFields if empty.
+func (c *Client) SubscribeThread(ctx context.Context, root string, selection string, handler func(*ThreadNode) error) error {
+	return c.Subscribe(ctx, "subscription ($root: Hash!) { thread(root: $root) "+GetSelection(selection, ThreadNodeFields)+" }", map[string]interface{}{"root": root}, func(data json.RawMessage) error {
+		var result *ThreadNode
+		if err := json.Unmarshal(data, &result); err != nil {
+			return fmt.Errorf("error unmarshalling thread subscription result; %w", err)
+		}
+		return handler(result)
+	})
+}
+
+// SubscribeBroadcastResults runs the broadcast_results subscription, calling handler for each result until ctx is done or handler returns an error. Selection is the fields selected on the result, BroadcastResultFields if empty.
+func (c *Client) SubscribeBroadcastResults(ctx context.Context, hashes []string, selection string, handler func(*BroadcastResult) error) error {
+	return c.Subscribe(ctx, "subscription ($hashes: [Hash!]!) { broadcast_results(hashes: $hashes) "+GetSelection(selection, BroadcastResultFields)+" }", map[string]interface{}{"hashes": hashes}, func(data json.RawMessage) error {
+		var result *BroadcastResult
+		if err := json.Unmarshal(data, &result); err != nil {
+			return fmt.Errorf("error unmarshalling broadcast_results subscription result; %w", err)
+		}
+		return handler(result)
+	})
+}
@@ -0,0 +1,124 @@
+package gql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// StopError can be returned by a pagination handler to stop without an error.
+var StopError = errors.New("stop pagination")
+
+// EachPostNewest calls handler for posts from newest to oldest, paging with posts_newest from the last post seen.
+// The tx_hash field is always selected.
+func (c *Client) EachPostNewest(ctx context.Context, selection string, handler func(*Post) error) error {
+	selection = Select("tx_hash", GetSelectionFields(selection, PostFields))
+	var lastTxHash *string
+	for {
+		posts, err := c.PostsNewest(ctx, nil, lastTxHash, nil, selection)
+		if err != nil {
+			return fmt.Errorf("error getting posts newest page; %w", err)
+		}
+		var found bool
+		for _, post := range posts {
+			// Page start is inclusive.
+			if post == nil || (lastTxHash != nil && post.TxHash == *lastTxHash) {
+				continue
+			}
+			found = true
+			if err := handler(post); err != nil {
+				return getPaginationError(err)
+			}
+			lastTxHash = &post.TxHash
+		}
+		if !found {
+			return nil
+		}
+	}
+}
+
+// EachBlock calls handler for blocks by height, oldest first unless newest, starting from start if not nil. The
+// height field is always selected.
+func (c *Client) EachBlock(ctx context.Context, newest bool, start *uint32, selection string,
+	handler func(*Block) error) error {
+	selection = Select("height", GetSelectionFields(selection, BlockFields))
+	for {
+		blocks, err := c.Blocks(ctx, &newest, start, selection)
+		if err != nil {
+			return fmt.Errorf("error getting blocks page; %w", err)
+		}
+		var next *uint32
+		for _, block := range blocks {
+			if block == nil || block.Height == nil {
+				continue
+			}
+			if err := handler(block); err != nil {
+				return getPaginationError(err)
+			}
+			height := uint32(*block.Height)
+			if newest {
+				if height == 0 {
+					return nil
+				}
+				height--
+			} else {
+				height++
+			}
+			next = &height
+		}
+		if next == nil {
+			return nil
+		}
+		start = next
+	}
+}
+
+// EachAddressTx calls handler for an address's txs, oldest first, paging with the lock txs field from the last tx
+// seen. The hash and seen fields are always selected.
+func (c *Client) EachAddressTx(ctx context.Context, address string, selection string,
+	handler func(*Tx) error) error {
+	selection = Select("hash seen", GetSelectionFields(selection, TxFields))
+	var startTime *time.Time
+	var startTx *string
+	for {
+		var result struct {
+			Address *Lock `json:"address"`
+		}
+		if err := c.Do(ctx, "query ($address: Address!, $start: Date, $tx: Hash) { address(address: $address) { "+
+			Field("txs", selection, "(start: $start, tx: $tx)")+" } }", map[string]interface{}{
+			"address": address,
+			"start":   startTime,
+			"tx":      startTx,
+		}, &result); err != nil {
+			return fmt.Errorf("error getting address txs page; %w", err)
+		}
+		if result.Address == nil {
+			return nil
+		}
+		var found bool
+		for _, tx := range result.Address.Txs {
+			// Page start is inclusive.
+			if tx == nil || (startTx != nil && tx.Hash == *startTx) {
+				continue
+			}
+			found = true
+			if err := handler(tx); err != nil {
+				return getPaginationError(err)
+			}
+			if tx.Seen != nil {
+				startTime, startTx = tx.Seen, &tx.Hash
+			}
+		}
+		if !found || startTime == nil {
+			return nil
+		}
+	}
+}
+
+func getPaginationError(err error) error {
+	if errors.Is(err, StopError) {
+		return nil
+	}
+	return fmt.Errorf("error handling paginated item; %w", err)
+}
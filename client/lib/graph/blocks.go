@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// GetBlocks returns main chain blocks in height order from the start height, up to the index's default limit.
+func GetBlocks(url string, start int64) ([]Block, error) {
+	const query = `
+	query ($start: Uint32) {
+		blocks (start: $start) {
+			hash
+			timestamp
+			height
+		}
+	}`
+	jsonData := map[string]interface{}{
+		"query":     query,
+		"variables": map[string]interface{}{"start": start},
+	}
+	jsonValue, err := json.Marshal(jsonData)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling json for get blocks query; %w", err)
+	}
+	request, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return nil, fmt.Errorf("error creating new request for get blocks; %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{Timeout: time.Second * 60}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("error the graph blocks HTTP request failed; %w", err)
+	}
+	defer response.Body.Close()
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body; %w", err)
+	}
+	var dataStruct = struct {
+		Data struct {
+			Blocks []Block `json:"blocks"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}{}
+	if err := json.Unmarshal(data, &dataStruct); err != nil {
+		return nil, fmt.Errorf("error unmarshalling json; %w", err)
+	}
+	if len(dataStruct.Errors) > 0 {
+		return nil, fmt.Errorf("error index client blocks response data; %w", fmt.Errorf(dataStruct.Errors[0].Message))
+	}
+	return dataStruct.Data.Blocks, nil
+}
@@ -79,10 +79,16 @@ const txQuery = `{
 	hash
 	seen
 	raw
+	conflicted
 	inputs {
 		index
 		prev_hash
 		prev_index
+		output {
+			lock {
+				address
+			}
+		}
 	}
 	outputs {
 		index
@@ -166,12 +172,12 @@ func GetHistoryQuery(addressUpdates []AddressUpdate) ([]byte, error) {
 		if addressUpdate.Time.After(memo.GetGenesisTime()) {
 			variables[fmt.Sprintf("start%d", i)] = addressUpdate.Time.Format(time.RFC3339Nano)
 			paramString += fmt.Sprintf(", $start%d: Date", i)
-			startString = fmt.Sprintf("start: $start%d", i)
+			startString = fmt.Sprintf("(start: $start%d)", i)
 		}
 		paramsStrings = append(paramsStrings, paramString)
 		subQueries = append(subQueries, fmt.Sprintf(`address%d: address(address: $address%d) {
 			address
-			txs(%s) %s
+			txs%s %s
 		}`, i, i, startString, txQuery))
 	}
 	var query = fmt.Sprintf("query (%s) { %s }", strings.Join(paramsStrings, ", "), strings.Join(subQueries, "\n"))
@@ -0,0 +1,163 @@
+package graph
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gorilla/websocket"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	wsSubprotocol = "graphql-transport-ws"
+	wsTimeout     = 10 * time.Second
+	wsSubscribeId = "1"
+
+	wsMessageConnectionInit = "connection_init"
+	wsMessageConnectionAck  = "connection_ack"
+	wsMessageSubscribe      = "subscribe"
+	wsMessageNext           = "next"
+	wsMessageError          = "error"
+	wsMessageComplete       = "complete"
+	wsMessagePing           = "ping"
+	wsMessagePong           = "pong"
+)
+
+var SubscriptionCompleteError = errors.New("subscription complete")
+
+type wsMessage struct {
+	Id      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// Subscription is a websocket GraphQL subscription using the graphql-transport-ws protocol.
+type Subscription struct {
+	conn *websocket.Conn
+}
+
+// GetWebsocketUrl converts a GraphQL http(s) url to the ws(s) url used for subscriptions.
+func GetWebsocketUrl(graphUrl string) string {
+	if strings.HasPrefix(graphUrl, "https://") {
+		return "wss://" + strings.TrimPrefix(graphUrl, "https://")
+	}
+	return "ws://" + strings.TrimPrefix(graphUrl, "http://")
+}
+
+// SubscribeAddresses opens an addresses subscription, each tx received includes the same fields as GetHistory.
+func SubscribeAddresses(graphUrl string, addresses []wallet.Addr) (*Subscription, error) {
+	var addressStrings = make([]string, len(addresses))
+	for i := range addresses {
+		addressStrings[i] = addresses[i].String()
+	}
+	subscription, err := Subscribe(graphUrl, fmt.Sprintf(`subscription ($addresses: [Address!]) {
+		addresses(addresses: $addresses) %s
+	}`, txQuery), map[string]interface{}{"addresses": addressStrings})
+	if err != nil {
+		return nil, fmt.Errorf("error subscribing to addresses; %w", err)
+	}
+	return subscription, nil
+}
+
+func Subscribe(graphUrl, query string, variables map[string]interface{}) (*Subscription, error) {
+	dialer := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: wsTimeout,
+		Subprotocols:     []string{wsSubprotocol},
+	}
+	conn, _, err := dialer.Dial(GetWebsocketUrl(graphUrl), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error dialing graph websocket; %w", err)
+	}
+	var s = &Subscription{conn: conn}
+	if err := s.init(query, variables); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("error initializing graph subscription; %w", err)
+	}
+	return s, nil
+}
+
+func (s *Subscription) init(query string, variables map[string]interface{}) error {
+	if err := s.conn.WriteJSON(wsMessage{Type: wsMessageConnectionInit}); err != nil {
+		return fmt.Errorf("error writing connection init; %w", err)
+	}
+	s.conn.SetReadDeadline(time.Now().Add(wsTimeout))
+	var ack wsMessage
+	if err := s.conn.ReadJSON(&ack); err != nil {
+		return fmt.Errorf("error reading connection ack; %w", err)
+	} else if ack.Type != wsMessageConnectionAck {
+		return fmt.Errorf("error unexpected message waiting for connection ack: %s", ack.Type)
+	}
+	s.conn.SetReadDeadline(time.Time{})
+	payload, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return fmt.Errorf("error marshaling subscribe payload; %w", err)
+	}
+	if err := s.conn.WriteJSON(wsMessage{Id: wsSubscribeId, Type: wsMessageSubscribe, Payload: payload}); err != nil {
+		return fmt.Errorf("error writing subscribe; %w", err)
+	}
+	return nil
+}
+
+// NextData reads until a result is received, replying to pings, and returns the data for the subscription field.
+// Returns SubscriptionCompleteError when the server ends the subscription.
+func (s *Subscription) NextData() (json.RawMessage, error) {
+	for {
+		var msg wsMessage
+		if err := s.conn.ReadJSON(&msg); err != nil {
+			return nil, fmt.Errorf("error reading subscription message; %w", err)
+		}
+		switch msg.Type {
+		case wsMessagePing:
+			if err := s.conn.WriteJSON(wsMessage{Type: wsMessagePong}); err != nil {
+				return nil, fmt.Errorf("error writing subscription pong; %w", err)
+			}
+		case wsMessageNext:
+			var result struct {
+				Data   map[string]json.RawMessage `json:"data"`
+				Errors []struct {
+					Message string `json:"message"`
+				} `json:"errors"`
+			}
+			if err := json.Unmarshal(msg.Payload, &result); err != nil {
+				return nil, fmt.Errorf("error unmarshalling subscription result; %w", err)
+			}
+			if len(result.Errors) > 0 {
+				return nil, fmt.Errorf("error subscription result: %s", result.Errors[0].Message)
+			}
+			for _, data := range result.Data {
+				return data, nil
+			}
+		case wsMessageError:
+			return nil, fmt.Errorf("error subscription: %s", msg.Payload)
+		case wsMessageComplete:
+			return nil, SubscriptionCompleteError
+		}
+	}
+}
+
+// NextTx waits for the next tx from an addresses subscription.
+func (s *Subscription) NextTx() (*Tx, error) {
+	data, err := s.NextData()
+	if err != nil {
+		return nil, fmt.Errorf("error getting next subscription tx; %w", err)
+	}
+	var tx Tx
+	if err := json.Unmarshal(data, &tx); err != nil {
+		return nil, fmt.Errorf("error unmarshalling subscription tx; %w", err)
+	}
+	return &tx, nil
+}
+
+func (s *Subscription) Close() error {
+	if err := s.conn.Close(); err != nil {
+		return fmt.Errorf("error closing graph subscription; %w", err)
+	}
+	return nil
+}
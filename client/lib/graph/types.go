@@ -62,6 +62,8 @@ type Tx struct {
 	Inputs  []Input   `json:"inputs"`
 	Outputs []Output  `json:"outputs"`
 	Blocks  []TxBlock `json:"blocks"`
+	// Conflicted is set by the index if a different tx spending one of the same outputs is in a block.
+	Conflicted bool `json:"conflicted"`
 }
 
 type TxBlock struct {
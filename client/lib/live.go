@@ -0,0 +1,197 @@
+package lib
+
+import (
+	"fmt"
+	"github.com/memocash/index/client/lib/graph"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"sync"
+	"time"
+)
+
+const LiveDefaultReconnect = 5 * time.Second
+
+// Live keeps the database updated for a set of addresses using an addresses subscription. After each connect the
+// database is caught up from the stored address update times, then txs are saved as they arrive. While synced,
+// GetBalance and GetUtxos for these addresses skip polling history.
+type Live struct {
+	Client    *Client
+	Addresses []wallet.Addr
+	Reconnect time.Duration
+	// OnTx is called after a received tx is saved.
+	OnTx func(tx graph.Tx)
+	// OnSync is called after each catch-up when the subscription is live.
+	OnSync func()
+	// OnError is called when the connection fails, before reconnecting. Not called when the connection is closed to
+	// resync.
+	OnError   func(err error)
+	synced    bool
+	stopped   bool
+	resyncing bool
+	sub       *graph.Subscription
+	mutex     sync.Mutex
+	done      chan struct{}
+}
+
+func (l *Live) run() {
+	for {
+		if err := l.connect(); err != nil {
+			l.setSynced(false)
+			if l.isStopped() {
+				return
+			}
+			if l.takeResyncing() {
+				continue
+			}
+			if l.OnError != nil {
+				l.OnError(err)
+			}
+		}
+		select {
+		case <-l.done:
+			return
+		case <-time.After(l.Reconnect):
+		}
+	}
+}
+
+func (l *Live) connect() error {
+	sub, err := graph.SubscribeAddresses(l.Client.GraphUrl, l.Addresses)
+	if err != nil {
+		return fmt.Errorf("error subscribing for live client; %w", err)
+	}
+	defer sub.Close()
+	l.mutex.Lock()
+	if l.stopped {
+		l.mutex.Unlock()
+		return nil
+	}
+	l.sub = sub
+	l.mutex.Unlock()
+	// Subscribed before catching up so txs seen during catch-up are not missed.
+	if err := l.Client.updateDb(l.Addresses); err != nil {
+		return fmt.Errorf("error catching up db for live client; %w", err)
+	}
+	l.setSynced(true)
+	if l.OnSync != nil {
+		l.OnSync()
+	}
+	for {
+		tx, err := sub.NextTx()
+		if err != nil {
+			return fmt.Errorf("error getting next tx for live client; %w", err)
+		}
+		if err := l.saveTx(*tx); err != nil {
+			return fmt.Errorf("error saving tx for live client; %w", err)
+		}
+		if l.OnTx != nil {
+			l.OnTx(*tx)
+		}
+	}
+}
+
+// saveTx saves a tx and moves the update time forward for subscribed addresses receiving outputs in it or spending
+// outputs in it.
+func (l *Live) saveTx(tx graph.Tx) error {
+	if err := l.Client.saveTxs([]graph.Tx{tx}); err != nil {
+		return fmt.Errorf("error saving live tx; %w", err)
+	}
+	var txAddresses = make(map[string]bool)
+	for _, output := range tx.Outputs {
+		txAddresses[output.Lock.Address] = true
+	}
+	for _, input := range tx.Inputs {
+		txAddresses[input.Output.Lock.Address] = true
+	}
+	var addressUpdates []graph.AddressUpdate
+	for _, address := range l.Addresses {
+		if txAddresses[address.String()] {
+			addressUpdates = append(addressUpdates, graph.AddressUpdate{Address: address, Time: tx.Seen})
+		}
+	}
+	if len(addressUpdates) == 0 {
+		return nil
+	}
+	if err := l.Client.Database.SetAddressLastUpdate(addressUpdates); err != nil {
+		return fmt.Errorf("error setting address last update for live tx; %w", err)
+	}
+	return nil
+}
+
+func (l *Live) setSynced(synced bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.synced = synced
+}
+
+// resync drops the subscription so it reconnects and catches up again.
+func (l *Live) resync() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.synced = false
+	if l.sub != nil {
+		l.resyncing = true
+		l.sub.Close()
+		l.sub = nil
+	}
+}
+
+// takeResyncing checks if the connection was closed to resync and clears the flag.
+func (l *Live) takeResyncing() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	resyncing := l.resyncing
+	l.resyncing = false
+	return resyncing
+}
+
+func (l *Live) isStopped() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.stopped
+}
+
+// IsSynced checks if the subscription is connected and caught up for an address.
+func (l *Live) IsSynced(address wallet.Addr) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if !l.synced {
+		return false
+	}
+	for _, liveAddress := range l.Addresses {
+		if liveAddress.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *Live) Stop() {
+	l.mutex.Lock()
+	if l.stopped {
+		l.mutex.Unlock()
+		return
+	}
+	l.stopped = true
+	l.synced = false
+	close(l.done)
+	if l.sub != nil {
+		l.sub.Close()
+	}
+	l.mutex.Unlock()
+	l.Client.removeLive(l)
+}
+
+// Start connects in the background, set callbacks before starting.
+func (l *Live) Start() {
+	l.Client.addLive(l)
+	go l.run()
+}
+
+func NewLive(client *Client, addresses []wallet.Addr) *Live {
+	return &Live{
+		Client:    client,
+		Addresses: addresses,
+		Reconnect: LiveDefaultReconnect,
+		done:      make(chan struct{}),
+	}
+}
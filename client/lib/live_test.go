@@ -0,0 +1,165 @@
+package lib_test
+
+import (
+	dbsql "database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/gorilla/websocket"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/memocash/index/client/drivers/sql"
+	"github.com/memocash/index/client/lib"
+	"github.com/memocash/index/client/lib/graph"
+	"github.com/memocash/index/ref/bitcoin/util/testing/test_tx"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const (
+	testLiveAddress1 = "1QCBiyfwdjXDsHghBEr5U2KxUpM2BmmJVt"
+	testLiveAddress2 = "1mW6fDEMjKrDHvLvoEsaeLxSCzZBf3Bfg"
+	testLiveTimeout  = 5 * time.Second
+)
+
+var testLiveSeen = time.Unix(1700000000, 0).UTC()
+
+// testGraphServer serves empty history over http and passes subscription websocket connections to the test.
+type testGraphServer struct {
+	*httptest.Server
+	conns chan *websocket.Conn
+}
+
+func (s *testGraphServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !websocket.IsWebSocketUpgrade(r) {
+		w.Write([]byte(`{"data":{}}`))
+		return
+	}
+	upgrader := websocket.Upgrader{Subprotocols: []string{"graphql-transport-ws"}}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	var msg map[string]interface{}
+	if err := conn.ReadJSON(&msg); err != nil || msg["type"] != "connection_init" {
+		conn.Close()
+		return
+	}
+	if err := conn.WriteJSON(map[string]string{"type": "connection_ack"}); err != nil {
+		conn.Close()
+		return
+	}
+	if err := conn.ReadJSON(&msg); err != nil || msg["type"] != "subscribe" {
+		conn.Close()
+		return
+	}
+	s.conns <- conn
+}
+
+func newTestGraphServer() *testGraphServer {
+	var server = &testGraphServer{conns: make(chan *websocket.Conn, 10)}
+	server.Server = httptest.NewServer(server)
+	return server
+}
+
+func getTestLiveTx(t *testing.T) []byte {
+	tx, err := json.Marshal(map[string]interface{}{
+		"hash": test_tx.GenericTxHashString1,
+		"seen": testLiveSeen.Format(time.RFC3339Nano),
+		"inputs": []interface{}{map[string]interface{}{
+			"index":      0,
+			"prev_hash":  test_tx.GenericTxHashString0,
+			"prev_index": 0,
+			"output":     map[string]interface{}{"lock": map[string]string{"address": testLiveAddress2}},
+		}},
+		"outputs": []interface{}{map[string]interface{}{
+			"index":  0,
+			"amount": 1000,
+			"lock":   map[string]string{"address": testLiveAddress1},
+		}},
+	})
+	if err != nil {
+		t.Fatal(fmt.Errorf("error marshaling test live tx; %w", err))
+	}
+	return []byte(`{"id":"1","type":"next","payload":{"data":{"addresses":` + string(tx) + `}}}`)
+}
+
+func waitTestLive(t *testing.T, c chan struct{}, name string) {
+	select {
+	case <-c:
+	case <-time.After(testLiveTimeout):
+		t.Fatalf("timeout waiting for live %s", name)
+	}
+}
+
+func TestLiveSyncResync(t *testing.T) {
+	server := newTestGraphServer()
+	defer server.Close()
+	db, err := dbsql.Open("sqlite3", filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(fmt.Errorf("error opening sqlite test db; %w", err))
+	}
+	defer db.Close()
+	database, err := sql.NewDatabase(db, "test")
+	if err != nil {
+		t.Fatal(fmt.Errorf("error creating database; %w", err))
+	}
+	var addrs []wallet.Addr
+	for _, address := range []string{testLiveAddress1, testLiveAddress2} {
+		addr, err := wallet.GetAddrFromString(address)
+		if err != nil {
+			t.Fatal(fmt.Errorf("error getting test addr; %w", err))
+		}
+		addrs = append(addrs, *addr)
+	}
+	client := lib.NewClient(server.URL, database)
+	live := lib.NewLive(client, addrs)
+	live.Reconnect = time.Hour
+	var synced = make(chan struct{}, 10)
+	var received = make(chan struct{}, 10)
+	var errs = make(chan error, 10)
+	live.OnSync = func() { synced <- struct{}{} }
+	live.OnTx = func(graph.Tx) { received <- struct{}{} }
+	live.OnError = func(err error) { errs <- err }
+	live.Start()
+	defer live.Stop()
+	var conn *websocket.Conn
+	select {
+	case conn = <-server.conns:
+	case <-time.After(testLiveTimeout):
+		t.Fatal("timeout waiting for live subscription")
+	}
+	waitTestLive(t, synced, "sync")
+	if !live.IsSynced(addrs[0]) {
+		t.Error("live not synced after catch-up")
+	}
+	if err := conn.WriteMessage(websocket.TextMessage, getTestLiveTx(t)); err != nil {
+		t.Fatal(fmt.Errorf("error writing test live tx; %w", err))
+	}
+	waitTestLive(t, received, "tx")
+	lastUpdates, err := database.GetAddressLastUpdate(addrs)
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting address last updates; %w", err))
+	}
+	for _, lastUpdate := range lastUpdates {
+		if !lastUpdate.Time.Equal(testLiveSeen) {
+			t.Errorf("unexpected last update for %s: %s, expected: %s", lastUpdate.Address, lastUpdate.Time, testLiveSeen)
+		}
+	}
+	if err := client.Resync(addrs); err != nil {
+		t.Fatal(fmt.Errorf("error resyncing client; %w", err))
+	}
+	select {
+	case <-server.conns:
+	case <-time.After(testLiveTimeout):
+		t.Fatal("timeout waiting for live subscription after resync")
+	}
+	waitTestLive(t, synced, "sync after resync")
+	select {
+	case err := <-errs:
+		t.Errorf("unexpected live error on resync; %v", err)
+	default:
+	}
+}
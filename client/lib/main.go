@@ -15,7 +15,29 @@ type Balance struct {
 type Database interface {
 	GetAddressBalance([]wallet.Addr) (*Balance, error)
 	GetAddressLastUpdate([]wallet.Addr) ([]graph.AddressUpdate, error)
+	// GetBlocks returns the newest stored blocks by height, up to the limit.
+	GetBlocks(limit int) ([]graph.Block, error)
 	GetUtxos([]wallet.Addr) ([]graph.Output, error)
+	// RemoveTxs deletes txs and any stored txs spending their outputs.
+	RemoveTxs(txHashes []string) error
+	// ResetAddresses deletes txs for the addresses and clears address updates so history is fetched again.
+	ResetAddresses([]wallet.Addr) error
+	// RollbackBlocks deletes orphaned blocks and clears address updates for addresses in their txs.
+	RollbackBlocks(blockHashes []string) error
 	SaveTxs([]graph.Tx) error
 	SetAddressLastUpdate([]graph.AddressUpdate) error
 }
+
+// SplitConflicted separates txs the index marks as conflicted from txs to save.
+func SplitConflicted(txs []graph.Tx) ([]graph.Tx, []string) {
+	var valid []graph.Tx
+	var conflicted []string
+	for _, tx := range txs {
+		if tx.Conflicted {
+			conflicted = append(conflicted, tx.Hash)
+		} else {
+			valid = append(valid, tx)
+		}
+	}
+	return valid, conflicted
+}
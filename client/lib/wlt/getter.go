@@ -1,59 +1,152 @@
 package wlt
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/memocash/index/client/lib"
+	"github.com/memocash/index/client/lib/graph"
 	"github.com/memocash/index/ref/bitcoin/memo"
 	"github.com/memocash/index/ref/bitcoin/tx/hs"
 	"github.com/memocash/index/ref/bitcoin/tx/script"
 	"github.com/memocash/index/ref/bitcoin/wallet"
 )
 
+// InputGetter gets UTXOs for a set of P2PKH addresses through a lib.Client. SetPkHashesToUse limits the addresses
+// used to the given pk hashes until it is set again, an empty set uses all addresses.
 type InputGetter struct {
-	Address wallet.Addr
-	UTXOs   []memo.UTXO
-	Client  *lib.Client
-	reset   bool
+	Addresses []wallet.Addr
+	UTXOs     []memo.UTXO
+	Client    *lib.Client
+	pkHashes  [][]byte
+	reset     bool
+	returned  []memo.UTXO
 }
 
 func NewInputGetter(address wallet.Addr, client *lib.Client) *InputGetter {
+	return NewInputGetterAddresses([]wallet.Addr{address}, client)
+}
+
+func NewInputGetterAddresses(addresses []wallet.Addr, client *lib.Client) *InputGetter {
 	return &InputGetter{
-		Address: address,
-		Client:  client,
+		Addresses: addresses,
+		Client:    client,
+	}
+}
+
+func (g *InputGetter) SetPkHashesToUse(pkHashes [][]byte) {
+	g.pkHashes = pkHashes
+}
+
+func (g *InputGetter) getAddresses() []wallet.Addr {
+	if len(g.pkHashes) == 0 {
+		return g.Addresses
+	}
+	var addresses []wallet.Addr
+	for _, address := range g.Addresses {
+		for _, pkHash := range g.pkHashes {
+			if bytes.Equal(address.GetPkHash(), pkHash) {
+				addresses = append(addresses, address)
+				break
+			}
+		}
+	}
+	return addresses
+}
+
+// GetUTXOs returns UTXOs matching the request that have not already been returned for the current tx. A nil request
+// or one without a token hash returns only plain BCH UTXOs so token outputs and batons are never spent as fees. A
+// request with a token hash returns that token's outputs, or its batons if Baton is set.
+func (g *InputGetter) GetUTXOs(request *memo.UTXORequest) ([]memo.UTXO, error) {
+	if !g.reset || len(g.UTXOs) == 0 {
+		utxos, err := g.getClientUTXOs()
+		if err != nil {
+			return nil, fmt.Errorf("error getting client utxos for input getter; %w", err)
+		}
+		g.UTXOs = utxos
+	}
+	g.reset = false
+	var utxos []memo.UTXO
+UtxoLoop:
+	for _, utxo := range g.UTXOs {
+		if !isRequestUTXO(request, utxo) {
+			continue
+		}
+		for _, returned := range g.returned {
+			if returned.IsEqual(utxo) {
+				continue UtxoLoop
+			}
+		}
+		utxos = append(utxos, utxo)
+	}
+	g.returned = append(g.returned, utxos...)
+	return utxos, nil
+}
+
+func isRequestUTXO(request *memo.UTXORequest, utxo memo.UTXO) bool {
+	if request == nil || len(request.TokenHash) == 0 {
+		return !utxo.IsSlp()
 	}
+	return bytes.Equal(utxo.SlpToken, request.TokenHash) && request.Baton == IsBatonUTXO(utxo)
 }
 
-func (g *InputGetter) SetPkHashesToUse([][]byte) {
+// IsBatonUTXO returns true for a UTXO holding a token mint baton instead of a token quantity.
+func IsBatonUTXO(utxo memo.UTXO) bool {
+	return utxo.IsSlp() && utxo.SlpType == memo.SlpTxTypeMint && utxo.SlpQuantity == 0
 }
 
-func (g *InputGetter) GetUTXOs(*memo.UTXORequest) ([]memo.UTXO, error) {
-	if g.reset && len(g.UTXOs) > 0 {
-		g.reset = false
-		return g.UTXOs, nil
+func (g *InputGetter) getClientUTXOs() ([]memo.UTXO, error) {
+	addresses := g.getAddresses()
+	if len(addresses) == 0 {
+		return nil, nil
 	}
-	outputs, err := g.Client.GetUtxos([]wallet.Addr{g.Address})
+	outputs, err := g.Client.GetUtxos(addresses)
 	if err != nil {
 		return nil, fmt.Errorf("error getting utxos from input getter client; %w", err)
 	}
 	var utxos []memo.UTXO
-	pkHash := g.Address.GetPkHash()
+	for _, output := range outputs {
+		utxo, err := GetUTXO(output, addresses[0])
+		if err != nil {
+			return nil, fmt.Errorf("error getting utxo from output for input getter; %w", err)
+		}
+		utxos = append(utxos, *utxo)
+	}
+	return utxos, nil
+}
+
+// GetUTXO converts a client output to a UTXO, setting the token and quantity for SLP outputs and batons. The
+// default address is used if the output does not have a lock address.
+func GetUTXO(output graph.Output, defaultAddress wallet.Addr) (*memo.UTXO, error) {
+	var pkHash = defaultAddress.GetPkHash()
+	if output.Lock.Address != "" {
+		addr, err := wallet.GetAddrFromString(output.Lock.Address)
+		if err != nil {
+			return nil, fmt.Errorf("error getting address for utxo; %w", err)
+		}
+		pkHash = addr.GetPkHash()
+	}
 	pkScript, err := script.P2pkh{PkHash: pkHash}.Get()
 	if err != nil {
 		return nil, fmt.Errorf("error getting pk script; %w", err)
 	}
-	for _, output := range outputs {
-		utxos = append(utxos, memo.UTXO{
-			Input: memo.TxInput{
-				PkScript:     pkScript,
-				PkHash:       pkHash,
-				Value:        output.Amount,
-				PrevOutHash:  hs.GetTxHash(output.Hash),
-				PrevOutIndex: uint32(output.Index),
-			},
-		})
-	}
-	g.UTXOs = utxos
-	return utxos, nil
+	var utxo = &memo.UTXO{
+		Input: memo.TxInput{
+			PkScript:     pkScript,
+			PkHash:       pkHash,
+			Value:        output.Amount,
+			PrevOutHash:  hs.GetTxHash(output.Hash),
+			PrevOutIndex: uint32(output.Index),
+		},
+	}
+	if output.Slp != nil {
+		utxo.SlpToken = hs.GetTxHash(output.Slp.TokenHash)
+		utxo.SlpQuantity = output.Slp.Amount
+		utxo.SlpType = memo.SlpTxTypeSend
+	} else if output.SlpBaton != nil {
+		utxo.SlpToken = hs.GetTxHash(output.SlpBaton.TokenHash)
+		utxo.SlpType = memo.SlpTxTypeMint
+	}
+	return utxo, nil
 }
 
 func (g *InputGetter) MarkUTXOsUsed(used []memo.UTXO) {
@@ -68,10 +161,29 @@ func (g *InputGetter) MarkUTXOsUsed(used []memo.UTXO) {
 	}
 }
 
+// AddChangeUTXO adds an output of a generated tx to use in following txs, outputs to other addresses are ignored.
 func (g *InputGetter) AddChangeUTXO(utxo memo.UTXO) {
-	g.UTXOs = append(g.UTXOs, utxo)
+	for _, address := range g.Addresses {
+		if bytes.Equal(address.GetPkHash(), utxo.Input.PkHash) {
+			g.UTXOs = append(g.UTXOs, utxo)
+			return
+		}
+	}
+}
+
+// setBaton marks an output added as change as a token baton so it is not spent as BCH, generated tx outputs only
+// have token info for token quantities.
+func (g *InputGetter) setBaton(txHash []byte, index uint32, tokenHash []byte) {
+	for i := range g.UTXOs {
+		if bytes.Equal(g.UTXOs[i].Input.PrevOutHash, txHash) && g.UTXOs[i].Input.PrevOutIndex == index {
+			g.UTXOs[i].SlpToken = tokenHash
+			g.UTXOs[i].SlpQuantity = 0
+			g.UTXOs[i].SlpType = memo.SlpTxTypeMint
+		}
+	}
 }
 
 func (g *InputGetter) NewTx() {
 	g.reset = true
+	g.returned = nil
 }
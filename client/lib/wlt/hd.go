@@ -0,0 +1,282 @@
+package wlt
+
+import (
+	"encoding/hex"
+	"fmt"
+	"github.com/jchavannes/btcutil/hdkeychain"
+	"github.com/memocash/index/client/lib"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/tx/gen"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+)
+
+// DefaultGapLimit is the number of unused addresses after the last used address checked before discovery stops.
+const DefaultGapLimit = 20
+
+type HdKey struct {
+	Index  uint
+	Change bool
+	Key    wallet.PrivateKey
+	Addr   wallet.Addr
+	Used   bool
+}
+
+// HdWallet derives BIP44 receive and change addresses from a mnemonic (m/44'/coin'/0'/change/index). Discover finds
+// used addresses in the index, deriving until GapLimit unused addresses follow the last used address on each chain.
+type HdWallet struct {
+	Mnemonic *wallet.Mnemonic
+	CoinType uint
+	GapLimit int
+	Client   *lib.Client
+	Receive  []*HdKey
+	Change   []*HdKey
+	Getter   *InputGetter
+	chains   map[bool]*hdkeychain.ExtendedKey
+}
+
+func NewHdWallet(mnemonic *wallet.Mnemonic, client *lib.Client) *HdWallet {
+	return &HdWallet{
+		Mnemonic: mnemonic,
+		CoinType: wallet.Bip44CoinTypeBCH,
+		GapLimit: DefaultGapLimit,
+		Client:   client,
+		Getter:   NewInputGetterAddresses(nil, client),
+	}
+}
+
+func (w *HdWallet) getChain(change bool) []*HdKey {
+	if change {
+		return w.Change
+	}
+	return w.Receive
+}
+
+func (w *HdWallet) setChain(change bool, keys []*HdKey) {
+	if change {
+		w.Change = keys
+	} else {
+		w.Receive = keys
+	}
+	w.Getter.Addresses = w.GetAddresses()
+}
+
+func (w *HdWallet) derive(change bool, index uint) (*HdKey, error) {
+	if w.chains == nil {
+		w.chains = make(map[bool]*hdkeychain.ExtendedKey)
+	}
+	chainKey, ok := w.chains[change]
+	if !ok {
+		accountKey, err := w.Mnemonic.GetPathExtended(wallet.GetBip44CoinPath(w.CoinType))
+		if err != nil {
+			return nil, fmt.Errorf("error getting hd wallet account key; %w", err)
+		}
+		var changeId uint32
+		if change {
+			changeId = 1
+		}
+		if chainKey, err = accountKey.Derive(changeId); err != nil {
+			return nil, fmt.Errorf("error getting hd wallet chain key; %w", err)
+		}
+		w.chains[change] = chainKey
+	}
+	childKey, err := chainKey.Derive(uint32(index))
+	if err != nil {
+		return nil, fmt.Errorf("error deriving hd wallet key for index: %d; %w", index, err)
+	}
+	ecPrivateKey, err := childKey.ECPrivKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting hd wallet private key for index: %d; %w", index, err)
+	}
+	key := wallet.PrivateKey{Secret: ecPrivateKey.Serialize()}
+	return &HdKey{
+		Index:  index,
+		Change: change,
+		Key:    key,
+		Addr:   key.GetAddr(),
+	}, nil
+}
+
+// getNextIndex is the index after the last used key on a chain.
+func (w *HdWallet) getNextIndex(change bool) uint {
+	keys := w.getChain(change)
+	for i := len(keys) - 1; i >= 0; i-- {
+		if keys[i].Used {
+			return keys[i].Index + 1
+		}
+	}
+	return 0
+}
+
+// Discover checks derived keys not yet used and derives more until the gap limit is reached on both chains.
+func (w *HdWallet) Discover() error {
+	for _, change := range []bool{false, true} {
+		if err := w.discoverChain(change); err != nil {
+			return fmt.Errorf("error discovering hd wallet chain (change: %t); %w", change, err)
+		}
+	}
+	return nil
+}
+
+func (w *HdWallet) discoverChain(change bool) error {
+	var check []*HdKey
+	for _, key := range w.getChain(change) {
+		if !key.Used {
+			check = append(check, key)
+		}
+	}
+	for {
+		if len(check) > 0 {
+			if err := w.checkUsed(check); err != nil {
+				return fmt.Errorf("error checking used hd wallet keys; %w", err)
+			}
+		}
+		keys := w.getChain(change)
+		target := w.getNextIndex(change) + uint(w.GapLimit)
+		check = nil
+		for index := uint(len(keys)); index < target; index++ {
+			key, err := w.derive(change, index)
+			if err != nil {
+				return fmt.Errorf("error deriving hd wallet key for discovery; %w", err)
+			}
+			check = append(check, key)
+		}
+		if len(check) == 0 {
+			return nil
+		}
+		w.setChain(change, append(keys, check...))
+	}
+}
+
+func (w *HdWallet) checkUsed(keys []*HdKey) error {
+	var addresses = make([]wallet.Addr, len(keys))
+	for i := range keys {
+		addresses[i] = keys[i].Addr
+	}
+	used, err := w.Client.GetUsedAddresses(addresses)
+	if err != nil {
+		return fmt.Errorf("error getting used addresses from client; %w", err)
+	}
+	for _, key := range keys {
+		for _, addr := range used {
+			if key.Addr.Equals(addr) {
+				key.Used = true
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// getNextKey returns the first unused key after the last used key on a chain, deriving it if needed.
+func (w *HdWallet) getNextKey(change bool) (*HdKey, error) {
+	keys := w.getChain(change)
+	index := w.getNextIndex(change)
+	if index < uint(len(keys)) {
+		return keys[index], nil
+	}
+	for i := uint(len(keys)); i <= index; i++ {
+		key, err := w.derive(change, i)
+		if err != nil {
+			return nil, fmt.Errorf("error deriving next hd wallet key; %w", err)
+		}
+		keys = append(keys, key)
+	}
+	w.setChain(change, keys)
+	return keys[index], nil
+}
+
+// GetReceiveAddress returns the next unused receive address.
+func (w *HdWallet) GetReceiveAddress() (wallet.Addr, error) {
+	key, err := w.getNextKey(false)
+	if err != nil {
+		return wallet.Addr{}, fmt.Errorf("error getting next receive key; %w", err)
+	}
+	return key.Addr, nil
+}
+
+// GetChangeAddress returns the next unused change address.
+func (w *HdWallet) GetChangeAddress() (wallet.Addr, error) {
+	key, err := w.getNextKey(true)
+	if err != nil {
+		return wallet.Addr{}, fmt.Errorf("error getting next change key; %w", err)
+	}
+	return key.Addr, nil
+}
+
+func (w *HdWallet) GetAddresses() []wallet.Addr {
+	var addresses []wallet.Addr
+	for _, keys := range [][]*HdKey{w.Receive, w.Change} {
+		for _, key := range keys {
+			addresses = append(addresses, key.Addr)
+		}
+	}
+	return addresses
+}
+
+func (w *HdWallet) GetKeyRing() wallet.KeyRing {
+	var keyRing wallet.KeyRing
+	for _, keys := range [][]*HdKey{w.Receive, w.Change} {
+		for _, key := range keys {
+			keyRing.Keys = append(keyRing.Keys, key.Key)
+		}
+	}
+	return keyRing
+}
+
+func (w *HdWallet) GetBalance() (*lib.Balance, error) {
+	balance, err := w.Client.GetBalance(w.GetAddresses())
+	if err != nil {
+		return nil, fmt.Errorf("error getting hd wallet balance; %w", err)
+	}
+	return balance, nil
+}
+
+// BasicTx creates a tx with inputs from any derived address, change is sent to the next unused change address. The
+// change address is not marked used until the tx is broadcast with Send.
+func (w *HdWallet) BasicTx(script memo.Script) (*memo.Tx, error) {
+	changeKey, err := w.getNextKey(true)
+	if err != nil {
+		return nil, fmt.Errorf("error getting change key for hd wallet tx; %w", err)
+	}
+	memoTx, err := gen.Tx(gen.TxRequest{
+		Getter: w.Getter,
+		Outputs: []*memo.Output{{
+			Script: script,
+		}},
+		Change:  wallet.Change{Main: changeKey.Addr.OldAddress()},
+		KeyRing: w.GetKeyRing(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error generating basic hd wallet tx; %w", err)
+	}
+	return memoTx, nil
+}
+
+// Send broadcasts txs in order, marking derived keys receiving outputs as used after each successful broadcast so a
+// failed broadcast does not skip addresses.
+func (w *HdWallet) Send(memoTxs []*memo.Tx) error {
+	for _, memoTx := range memoTxs {
+		if err := w.Client.Broadcast(hex.EncodeToString(memo.GetRaw(memoTx.MsgTx))); err != nil {
+			return fmt.Errorf("error broadcasting hd wallet tx: %s; %w", memoTx.MsgTx.TxHash(), err)
+		}
+		w.markOutputsUsed(memoTx)
+	}
+	return nil
+}
+
+// markOutputsUsed marks derived keys receiving outputs in a tx as used, so the next address is not reused.
+func (w *HdWallet) markOutputsUsed(memoTx *memo.Tx) {
+	for _, txOut := range memoTx.MsgTx.TxOut {
+		addr, err := wallet.GetAddrFromLockScript(txOut.PkScript)
+		if err != nil {
+			continue
+		}
+		for _, keys := range [][]*HdKey{w.Receive, w.Change} {
+			for _, key := range keys {
+				if key.Addr.Equals(*addr) {
+					key.Used = true
+				}
+			}
+		}
+	}
+}
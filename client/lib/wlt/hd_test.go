@@ -0,0 +1,91 @@
+package wlt_test
+
+import (
+	"fmt"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/client/lib"
+	"github.com/memocash/index/client/lib/wlt"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/tx/script"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	testMnemonic       = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	testReceiveAddress = "1mW6fDEMjKrDHvLvoEsaeLxSCzZBf3Bfg"
+)
+
+func getTestHdWallet(t *testing.T, graphUrl string) *wlt.HdWallet {
+	mnemonic, err := wallet.GetMnemonicFromString(testMnemonic)
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting mnemonic from string; %w", err))
+	}
+	return wlt.NewHdWallet(mnemonic, lib.NewClient(graphUrl, nil))
+}
+
+func TestHdWalletDerive(t *testing.T) {
+	hdWallet := getTestHdWallet(t, "")
+	receiveAddr, err := hdWallet.GetReceiveAddress()
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting receive address; %w", err))
+	}
+	if receiveAddr.String() != testReceiveAddress {
+		t.Errorf("unexpected receive address: %s, expected: %s", receiveAddr, testReceiveAddress)
+	}
+	changeAddr, err := hdWallet.GetChangeAddress()
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting change address; %w", err))
+	}
+	changeKey, err := hdWallet.Mnemonic.GetPath(wallet.GetBip44Path(wallet.Bip44CoinTypeBCH, 0, true))
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting change key from mnemonic path; %w", err))
+	}
+	if changeAddr != changeKey.GetAddr() {
+		t.Errorf("unexpected change address: %s, expected: %s", changeAddr, changeKey.GetAddr())
+	}
+	if len(hdWallet.Receive) != 1 || len(hdWallet.Change) != 1 || len(hdWallet.GetKeyRing().Keys) != 2 {
+		t.Errorf("unexpected derived key count, receive: %d, change: %d", len(hdWallet.Receive), len(hdWallet.Change))
+	}
+}
+
+func TestHdWalletSendMarkUsed(t *testing.T) {
+	var broadcastStatus = http.StatusInternalServerError
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(broadcastStatus)
+	}))
+	defer server.Close()
+	hdWallet := getTestHdWallet(t, server.URL)
+	changeAddr, err := hdWallet.GetChangeAddress()
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting change address; %w", err))
+	}
+	pkScript, err := script.P2pkh{PkHash: changeAddr.GetPkHash()}.Get()
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting change pk script; %w", err))
+	}
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxOut(wire.NewTxOut(1000, pkScript))
+	memoTxs := []*memo.Tx{{MsgTx: msgTx}}
+	if err := hdWallet.Send(memoTxs); err == nil {
+		t.Fatal("expected error sending tx with failed broadcast")
+	}
+	if nextAddr, err := hdWallet.GetChangeAddress(); err != nil {
+		t.Fatal(fmt.Errorf("error getting change address after failed broadcast; %w", err))
+	} else if nextAddr != changeAddr {
+		t.Errorf("change address marked used after failed broadcast: %s", nextAddr)
+	}
+	broadcastStatus = http.StatusOK
+	if err := hdWallet.Send(memoTxs); err != nil {
+		t.Fatal(fmt.Errorf("error sending tx; %w", err))
+	}
+	nextAddr, err := hdWallet.GetChangeAddress()
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting change address after broadcast; %w", err))
+	}
+	if nextAddr == changeAddr || len(hdWallet.Change) != 2 || !hdWallet.Change[0].Used {
+		t.Errorf("change address not marked used after broadcast: %s", nextAddr)
+	}
+}
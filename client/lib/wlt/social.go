@@ -0,0 +1,287 @@
+package wlt
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"github.com/memocash/index/client/lib"
+	"github.com/memocash/index/client/lib/gql"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/tx/build"
+	"github.com/memocash/index/ref/bitcoin/tx/hs"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"time"
+)
+
+const (
+	DefaultTxWaitInterval = 500 * time.Millisecond
+	DefaultTxWaitTimeout  = 30 * time.Second
+)
+
+// TxWaiter waits until a broadcast tx has been processed by the index.
+type TxWaiter interface {
+	WaitTx(txHash []byte) error
+}
+
+// GraphTxWaiter waits by polling the client's GraphQL endpoint until the index returns the tx.
+type GraphTxWaiter struct {
+	GraphUrl string
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+func (w *GraphTxWaiter) WaitTx(txHash []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), w.Timeout)
+	defer cancel()
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+	gqlClient := gql.NewClient(w.GraphUrl)
+	for {
+		tx, err := gqlClient.Tx(ctx, hs.GetTxString(txHash), "hash")
+		if err == nil && tx != nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			if err == nil {
+				err = ctx.Err()
+			}
+			return fmt.Errorf("error waiting for tx in index: %s; %w", hs.GetTxString(txHash), err)
+		case <-ticker.C:
+		}
+	}
+}
+
+func NewGraphTxWaiter(client *lib.Client) *GraphTxWaiter {
+	return &GraphTxWaiter{
+		GraphUrl: client.GraphUrl,
+		Interval: DefaultTxWaitInterval,
+		Timeout:  DefaultTxWaitTimeout,
+	}
+}
+
+// Send broadcasts txs in order, waiting for each to be processed if the wallet has a Waiter. Actions can be chained
+// without waiting since the getter keeps change outputs from previous txs to use as inputs.
+func (w *Wallet) Send(memoTxs []*memo.Tx) error {
+	for _, memoTx := range memoTxs {
+		if err := w.Getter.Client.Broadcast(hex.EncodeToString(memo.GetRaw(memoTx.MsgTx))); err != nil {
+			return fmt.Errorf("error broadcasting wallet tx: %s; %w", memoTx.MsgTx.TxHash(), err)
+		}
+		if w.Waiter == nil {
+			continue
+		}
+		if err := w.Waiter.WaitTx(memoTx.GetHash()); err != nil {
+			return fmt.Errorf("error waiting for wallet tx: %s; %w", memoTx.MsgTx.TxHash(), err)
+		}
+	}
+	return nil
+}
+
+func (w *Wallet) sendBuilt(memoTxs []*memo.Tx, err error) ([]*memo.Tx, error) {
+	if err != nil {
+		return nil, fmt.Errorf("error building wallet txs; %w", err)
+	}
+	if err := w.Send(memoTxs); err != nil {
+		return nil, fmt.Errorf("error sending wallet txs; %w", err)
+	}
+	return memoTxs, nil
+}
+
+func (w *Wallet) sendBuiltSingle(memoTx *memo.Tx, err error) (*memo.Tx, error) {
+	if err != nil {
+		return nil, fmt.Errorf("error building wallet tx; %w", err)
+	}
+	if err := w.Send([]*memo.Tx{memoTx}); err != nil {
+		return nil, fmt.Errorf("error sending wallet tx; %w", err)
+	}
+	return memoTx, nil
+}
+
+func (w *Wallet) SetName(name string) ([]*memo.Tx, error) {
+	return w.sendBuilt(build.SetName(build.SetNameRequest{Wallet: w.getBuildWallet(), Name: name}))
+}
+
+func (w *Wallet) SetProfile(text string) ([]*memo.Tx, error) {
+	return w.sendBuilt(build.Profile(build.ProfileRequest{Wallet: w.getBuildWallet(), Text: text}))
+}
+
+func (w *Wallet) SetProfilePic(url string) ([]*memo.Tx, error) {
+	return w.sendBuilt(build.ProfilePic(build.ProfilePicRequest{Wallet: w.getBuildWallet(), Url: url}))
+}
+
+func (w *Wallet) Post(message string) ([]*memo.Tx, error) {
+	return w.sendBuilt(build.Post(build.PostRequest{Wallet: w.getBuildWallet(), Message: message}))
+}
+
+func (w *Wallet) Reply(txHash []byte, message string) ([]*memo.Tx, error) {
+	return w.sendBuilt(build.Reply(build.ReplyRequest{
+		Wallet:  w.getBuildWallet(),
+		TxHash:  txHash,
+		Message: message,
+	}))
+}
+
+// Repost reposts a post, the message is an optional quote.
+func (w *Wallet) Repost(txHash []byte, message string) ([]*memo.Tx, error) {
+	return w.sendBuilt(build.Repost(build.RepostRequest{
+		Wallet:  w.getBuildWallet(),
+		TxHash:  txHash,
+		Message: message,
+	}))
+}
+
+// Like likes a post, a non-zero tip is sent to the post's address.
+func (w *Wallet) Like(txHash []byte, tip int64) ([]*memo.Tx, error) {
+	var request = build.LikeRequest{
+		Wallet: w.getBuildWallet(),
+		TxHash: txHash,
+		Tip:    tip,
+	}
+	if tip != 0 {
+		tipAddress, err := w.getPostAddress(txHash)
+		if err != nil {
+			return nil, fmt.Errorf("error getting post address for like tip; %w", err)
+		}
+		request.TipAddress = tipAddress.OldAddress()
+	}
+	return w.sendBuilt(build.Like(request))
+}
+
+func (w *Wallet) getPostAddress(txHash []byte) (*wallet.Addr, error) {
+	posts, err := gql.NewClient(w.Getter.Client.GraphUrl).Posts(context.Background(),
+		[]string{hs.GetTxString(txHash)}, "address")
+	if err != nil {
+		return nil, fmt.Errorf("error getting post from graph; %w", err)
+	}
+	if len(posts) == 0 || posts[0] == nil || posts[0].Address == "" {
+		return nil, fmt.Errorf("error post not found: %s", hs.GetTxString(txHash))
+	}
+	addr, err := wallet.GetAddrFromString(posts[0].Address)
+	if err != nil {
+		return nil, fmt.Errorf("error getting post address from string; %w", err)
+	}
+	return addr, nil
+}
+
+func (w *Wallet) Follow(address wallet.Addr) ([]*memo.Tx, error) {
+	return w.follow(address, false)
+}
+
+func (w *Wallet) Unfollow(address wallet.Addr) ([]*memo.Tx, error) {
+	return w.follow(address, true)
+}
+
+func (w *Wallet) follow(address wallet.Addr, unfollow bool) ([]*memo.Tx, error) {
+	return w.sendBuilt(build.FollowUser(build.FollowUserRequest{
+		Wallet:     w.getBuildWallet(),
+		UserPkHash: address.GetPkHash(),
+		Unfollow:   unfollow,
+	}))
+}
+
+func (w *Wallet) Mute(address wallet.Addr) ([]*memo.Tx, error) {
+	return w.mute(address, false)
+}
+
+func (w *Wallet) Unmute(address wallet.Addr) ([]*memo.Tx, error) {
+	return w.mute(address, true)
+}
+
+func (w *Wallet) mute(address wallet.Addr, unmute bool) ([]*memo.Tx, error) {
+	return w.sendBuilt(build.MuteUser(build.MuteUserRequest{
+		Wallet:     w.getBuildWallet(),
+		MutePkHash: address.GetPkHash(),
+		Unmute:     unmute,
+	}))
+}
+
+func (w *Wallet) TopicPost(topic, message string) ([]*memo.Tx, error) {
+	return w.sendBuilt(build.TopicMessage(build.TopicMessageRequest{
+		Wallet:    w.getBuildWallet(),
+		TopicName: topic,
+		Message:   message,
+	}))
+}
+
+func (w *Wallet) TopicFollow(topic string) ([]*memo.Tx, error) {
+	return w.topicFollow(topic, false)
+}
+
+func (w *Wallet) TopicUnfollow(topic string) ([]*memo.Tx, error) {
+	return w.topicFollow(topic, true)
+}
+
+func (w *Wallet) topicFollow(topic string, unfollow bool) ([]*memo.Tx, error) {
+	return w.sendBuilt(build.TopicFollow(build.TopicFollowRequest{
+		Wallet:    w.getBuildWallet(),
+		TopicName: topic,
+		Unfollow:  unfollow,
+	}))
+}
+
+// Poll creates a poll followed by a tx for each option, the poll tx is first in the returned txs.
+func (w *Wallet) Poll(pollType memo.PollType, question string, options []string) ([]*memo.Tx, error) {
+	pollTx, err := w.sendBuiltSingle(build.PollCreate(build.PollCreateRequest{
+		Wallet:      w.getBuildWallet(),
+		PollType:    pollType,
+		Question:    question,
+		OptionCount: len(options),
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("error creating poll; %w", err)
+	}
+	var memoTxs = []*memo.Tx{pollTx}
+	for _, option := range options {
+		optionTx, err := w.sendBuiltSingle(build.PollOption(build.PollOptionRequest{
+			Wallet:     w.getBuildWallet(),
+			PollTxHash: pollTx.GetHash(),
+			Option:     option,
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("error creating poll option; %w", err)
+		}
+		memoTxs = append(memoTxs, optionTx)
+	}
+	return memoTxs, nil
+}
+
+// PollVote votes for a poll option, a non-zero tip is sent to the poll option's address.
+func (w *Wallet) PollVote(optionTxHash []byte, message string, tip int64) ([]*memo.Tx, error) {
+	var request = build.PollVoteRequest{
+		Wallet:           w.getBuildWallet(),
+		PollOptionTxHash: optionTxHash,
+		Message:          message,
+		Tip:              tip,
+	}
+	if tip != 0 {
+		tipAddress, err := w.getTxAddress(optionTxHash)
+		if err != nil {
+			return nil, fmt.Errorf("error getting poll option address for vote tip; %w", err)
+		}
+		request.TipAddress = tipAddress.OldAddress()
+	}
+	return w.sendBuilt(build.PollVote(request))
+}
+
+// getTxAddress returns the address of the first input of a tx.
+func (w *Wallet) getTxAddress(txHash []byte) (*wallet.Addr, error) {
+	tx, err := gql.NewClient(w.Getter.Client.GraphUrl).Tx(context.Background(), hs.GetTxString(txHash),
+		gql.Field("inputs", gql.Field("output", gql.Field("lock", "address"))))
+	if err != nil {
+		return nil, fmt.Errorf("error getting tx from graph; %w", err)
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("error tx not found: %s", hs.GetTxString(txHash))
+	}
+	for _, input := range tx.Inputs {
+		if input == nil || input.Output == nil || input.Output.Lock == nil || input.Output.Lock.Address == nil {
+			continue
+		}
+		addr, err := wallet.GetAddrFromString(*input.Output.Lock.Address)
+		if err != nil {
+			return nil, fmt.Errorf("error getting tx input address from string; %w", err)
+		}
+		return addr, nil
+	}
+	return nil, fmt.Errorf("error no input address found for tx: %s", hs.GetTxString(txHash))
+}
@@ -0,0 +1,218 @@
+package wlt
+
+import (
+	"fmt"
+	"github.com/memocash/index/client/lib/graph"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/tx/build"
+	"github.com/memocash/index/ref/bitcoin/tx/gen"
+	"github.com/memocash/index/ref/bitcoin/tx/hs"
+	"github.com/memocash/index/ref/bitcoin/tx/script"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"sort"
+)
+
+// tokenBatonIndex is the output index of the mint baton in token create and mint txs.
+const tokenBatonIndex = 2
+
+type TokenBalance struct {
+	TokenHash string
+	TokenType uint8
+	Decimals  uint8
+	Ticker    string
+	Name      string
+	Quantity  uint64
+	UtxoCount int
+	Batons    int
+}
+
+// GetTokenBalances totals token quantities and batons in unspent outputs by token, sorted by token hash.
+func GetTokenBalances(outputs []graph.Output) []*TokenBalance {
+	var balances = make(map[string]*TokenBalance)
+	var getBalance = func(tokenHash string, genesis *graph.SlpGenesis) *TokenBalance {
+		balance, ok := balances[tokenHash]
+		if !ok {
+			balance = &TokenBalance{TokenHash: tokenHash}
+			balances[tokenHash] = balance
+		}
+		if genesis != nil {
+			balance.TokenType = genesis.TokenType
+			balance.Decimals = genesis.Decimals
+			balance.Ticker = genesis.Ticker
+			balance.Name = genesis.Name
+		}
+		return balance
+	}
+	for _, output := range outputs {
+		if output.Slp != nil {
+			balance := getBalance(output.Slp.TokenHash, output.Slp.Genesis)
+			balance.Quantity += output.Slp.Amount
+			balance.UtxoCount++
+		}
+		if output.SlpBaton != nil {
+			getBalance(output.SlpBaton.TokenHash, output.SlpBaton.Genesis).Batons++
+		}
+	}
+	var tokenBalances = make([]*TokenBalance, 0, len(balances))
+	for _, balance := range balances {
+		tokenBalances = append(tokenBalances, balance)
+	}
+	sort.Slice(tokenBalances, func(i, j int) bool {
+		return tokenBalances[i].TokenHash < tokenBalances[j].TokenHash
+	})
+	return tokenBalances
+}
+
+func (w *Wallet) getBuildWallet() build.Wallet {
+	return build.Wallet{
+		Getter:  w.Getter,
+		KeyRing: wallet.GetSingleKeyRing(w.Key),
+		Address: w.Address.OldAddress(),
+	}
+}
+
+func (w *Wallet) GetTokenBalances() ([]*TokenBalance, error) {
+	outputs, err := w.Getter.Client.GetUtxos([]wallet.Addr{w.Address})
+	if err != nil {
+		return nil, fmt.Errorf("error getting utxos for wallet token balances; %w", err)
+	}
+	return GetTokenBalances(outputs), nil
+}
+
+// getTokenType returns the token type from the genesis of any unspent output for the token, or the default type.
+func (w *Wallet) getTokenType(tokenHash []byte) (byte, error) {
+	outputs, err := w.Getter.Client.GetUtxos([]wallet.Addr{w.Address})
+	if err != nil {
+		return 0, fmt.Errorf("error getting utxos for wallet token type; %w", err)
+	}
+	for _, balance := range GetTokenBalances(outputs) {
+		if balance.TokenHash == hs.GetTxString(tokenHash) && balance.TokenType != 0 {
+			return balance.TokenType, nil
+		}
+	}
+	return memo.SlpDefaultTokenType, nil
+}
+
+// TokenCreate creates a new token with the initial quantity and mint baton sent to the wallet.
+func (w *Wallet) TokenCreate(ticker, name, docUrl string, decimals int, quantity uint64) (*memo.Tx, error) {
+	memoTx, err := build.TokenCreate(build.TokenCreateRequest{
+		Wallet:   w.getBuildWallet(),
+		Ticker:   ticker,
+		Name:     name,
+		Decimals: decimals,
+		DocUrl:   docUrl,
+		SlpType:  memo.SlpDefaultTokenType,
+		Quantity: quantity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error building wallet token create tx; %w", err)
+	}
+	w.Getter.setBaton(memoTx.GetHash(), tokenBatonIndex, memoTx.GetHash())
+	return memoTx, nil
+}
+
+// TokenSend sends a quantity of a token to the recipient, with any token change back to the wallet.
+func (w *Wallet) TokenSend(tokenHash []byte, recipient wallet.Addr, quantity uint64) (*memo.Tx, error) {
+	tokenType, err := w.getTokenType(tokenHash)
+	if err != nil {
+		return nil, fmt.Errorf("error getting token type for wallet token send; %w", err)
+	}
+	memoTx, err := build.TokenSend(build.TokenSendRequest{
+		Wallet:    w.getBuildWallet(),
+		TokenHash: tokenHash,
+		Recipient: recipient.OldAddress(),
+		Quantity:  quantity,
+		TokenType: tokenType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error building wallet token send tx; %w", err)
+	}
+	return memoTx, nil
+}
+
+// TokenMint mints a quantity of a token using a baton held by the wallet, the baton is sent back to the wallet.
+func (w *Wallet) TokenMint(tokenHash []byte, quantity uint64) (*memo.Tx, error) {
+	w.Getter.NewTx()
+	batons, err := w.Getter.GetUTXOs(&memo.UTXORequest{TokenHash: tokenHash, Baton: true})
+	if err != nil {
+		return nil, fmt.Errorf("error getting baton utxos for wallet token mint; %w", err)
+	}
+	if len(batons) == 0 {
+		return nil, fmt.Errorf("error no baton found for token mint: %s", hs.GetTxString(tokenHash))
+	}
+	tokenType, err := w.getTokenType(tokenHash)
+	if err != nil {
+		return nil, fmt.Errorf("error getting token type for wallet token mint; %w", err)
+	}
+	memoTx, err := build.TokenMint(build.TokenMintRequest{
+		Wallet:       w.getBuildWallet(),
+		Baton:        batons[0],
+		BatonAddress: w.Address.OldAddress(),
+		TokenAddress: w.Address.OldAddress(),
+		TokenHash:    tokenHash,
+		TokenType:    tokenType,
+		Quantity:     quantity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error building wallet token mint tx; %w", err)
+	}
+	w.Getter.setBaton(memoTx.GetHash(), tokenBatonIndex, tokenHash)
+	return memoTx, nil
+}
+
+// TokenBurn destroys a quantity of a token by spending token outputs with a send that returns less than the input
+// quantity to the wallet.
+func (w *Wallet) TokenBurn(tokenHash []byte, quantity uint64) (*memo.Tx, error) {
+	w.Getter.NewTx()
+	tokenUtxos, err := w.Getter.GetUTXOs(&memo.UTXORequest{TokenHash: tokenHash})
+	if err != nil {
+		return nil, fmt.Errorf("error getting token utxos for wallet token burn; %w", err)
+	}
+	sort.Slice(tokenUtxos, func(i, j int) bool {
+		return tokenUtxos[i].SlpQuantity > tokenUtxos[j].SlpQuantity
+	})
+	var inputs []memo.UTXO
+	var inputQuantity uint64
+	for i := 0; i < len(tokenUtxos) && inputQuantity < quantity; i++ {
+		inputs = append(inputs, tokenUtxos[i])
+		inputQuantity += tokenUtxos[i].SlpQuantity
+	}
+	if inputQuantity < quantity {
+		return nil, fmt.Errorf("error not enough tokens to burn (have: %d, burn: %d); %w",
+			inputQuantity, quantity, gen.NotEnoughTokenValueError)
+	}
+	// The generator requires token inputs to match the send quantities and adds any excess back as token change, so
+	// the burned quantity is removed from the input quantities it sees. The SLP send then spends less than the inputs
+	// hold and the difference is burned.
+	var burnRemaining = quantity
+	for i := 0; i < len(inputs) && burnRemaining > 0; i++ {
+		var burn = inputs[i].SlpQuantity
+		if burn > burnRemaining {
+			burn = burnRemaining
+		}
+		inputs[i].SlpQuantity -= burn
+		burnRemaining -= burn
+	}
+	tokenType, err := w.getTokenType(tokenHash)
+	if err != nil {
+		return nil, fmt.Errorf("error getting token type for wallet token burn; %w", err)
+	}
+	memoTx, err := gen.Tx(gen.TxRequest{
+		Getter: w.Getter,
+		Outputs: []*memo.Output{{
+			Script: &script.TokenSend{
+				TokenHash:  tokenHash,
+				SlpType:    tokenType,
+				Quantities: []uint64{inputQuantity - quantity},
+			}},
+			gen.GetAddressOutput(w.Address.OldAddress(), memo.DustMinimumOutput),
+		},
+		Change:      wallet.Change{Main: w.Address.OldAddress()},
+		InputsToUse: inputs,
+		KeyRing:     wallet.GetSingleKeyRing(w.Key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error building wallet token burn tx; %w", err)
+	}
+	return memoTx, nil
+}
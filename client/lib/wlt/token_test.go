@@ -0,0 +1,164 @@
+package wlt_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/jchavannes/btcd/txscript"
+	"github.com/memocash/index/client/lib"
+	"github.com/memocash/index/client/lib/graph"
+	"github.com/memocash/index/client/lib/wlt"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/tx/gen"
+	"github.com/memocash/index/ref/bitcoin/tx/hs"
+	"github.com/memocash/index/ref/bitcoin/util/testing/test_tx"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"testing"
+)
+
+// testDatabase returns fixed utxos and no address updates, so the client does not sync from the index.
+type testDatabase struct {
+	Outputs []graph.Output
+}
+
+func (d *testDatabase) GetAddressBalance([]wallet.Addr) (*lib.Balance, error) { return nil, nil }
+func (d *testDatabase) GetAddressLastUpdate([]wallet.Addr) ([]graph.AddressUpdate, error) {
+	return nil, nil
+}
+func (d *testDatabase) GetBlocks(int) ([]graph.Block, error)             { return nil, nil }
+func (d *testDatabase) GetUtxos([]wallet.Addr) ([]graph.Output, error)   { return d.Outputs, nil }
+func (d *testDatabase) RemoveTxs([]string) error                         { return nil }
+func (d *testDatabase) ResetAddresses([]wallet.Addr) error               { return nil }
+func (d *testDatabase) RollbackBlocks([]string) error                    { return nil }
+func (d *testDatabase) SaveTxs([]graph.Tx) error                         { return nil }
+func (d *testDatabase) SetAddressLastUpdate([]graph.AddressUpdate) error { return nil }
+
+const testTokenHash = test_tx.GenericTxHashString0
+
+func getTestTokenOutputs(address wallet.Addr) []graph.Output {
+	var genesis = &graph.SlpGenesis{Hash: testTokenHash, TokenType: memo.SlpDefaultTokenType, Ticker: "TEST"}
+	var lock = graph.Lock{Address: address.String()}
+	return []graph.Output{{
+		Hash:   test_tx.GenericTxHashString1,
+		Index:  0,
+		Amount: 100000,
+		Lock:   lock,
+	}, {
+		Hash:   test_tx.GenericTxHashString2,
+		Index:  1,
+		Amount: memo.DustMinimumOutput,
+		Lock:   lock,
+		Slp:    &graph.Slp{TokenHash: testTokenHash, Amount: 600, Genesis: genesis},
+	}, {
+		Hash:   test_tx.GenericTxHashString3,
+		Index:  1,
+		Amount: memo.DustMinimumOutput,
+		Lock:   lock,
+		Slp:    &graph.Slp{TokenHash: testTokenHash, Amount: 500, Genesis: genesis},
+	}}
+}
+
+// getSlpSendQuantities returns the output quantities of an SLP send OP_RETURN script.
+func getSlpSendQuantities(pkScript []byte) ([]uint64, error) {
+	pushData, err := txscript.PushedData(pkScript)
+	if err != nil {
+		return nil, fmt.Errorf("error getting pushed data from script; %w", err)
+	}
+	if len(pushData) < 5 || !bytes.Equal(pushData[2], []byte(memo.SlpTxTypeSend)) {
+		return nil, fmt.Errorf("error script is not an slp send")
+	}
+	var quantities []uint64
+	for _, data := range pushData[4:] {
+		quantities = append(quantities, binary.BigEndian.Uint64(data))
+	}
+	return quantities, nil
+}
+
+func TestTokenGetter(t *testing.T) {
+	key := test_tx.GetPrivateKey(test_tx.Key1String)
+	getter := wlt.NewInputGetter(key.GetAddr(), lib.NewClient("", &testDatabase{
+		Outputs: getTestTokenOutputs(key.GetAddr()),
+	}))
+	getter.NewTx()
+	bchUtxos, err := getter.GetUTXOs(nil)
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting bch utxos; %w", err))
+	}
+	if len(bchUtxos) != 1 || bchUtxos[0].IsSlp() {
+		t.Errorf("unexpected bch utxos: %d", len(bchUtxos))
+	}
+	tokenUtxos, err := getter.GetUTXOs(&memo.UTXORequest{TokenHash: hs.GetTxHash(testTokenHash)})
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting token utxos; %w", err))
+	}
+	var quantity uint64
+	for _, utxo := range tokenUtxos {
+		quantity += utxo.SlpQuantity
+	}
+	if len(tokenUtxos) != 2 || quantity != 1100 {
+		t.Errorf("unexpected token utxos: %d, quantity: %d", len(tokenUtxos), quantity)
+	}
+	batons, err := getter.GetUTXOs(&memo.UTXORequest{TokenHash: hs.GetTxHash(testTokenHash), Baton: true})
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting token batons; %w", err))
+	}
+	if len(batons) != 0 {
+		t.Errorf("unexpected token batons: %d", len(batons))
+	}
+}
+
+func TestTokenBurn(t *testing.T) {
+	key := test_tx.GetPrivateKey(test_tx.Key1String)
+	outputs := getTestTokenOutputs(key.GetAddr())
+	tokenWallet := wlt.NewWallet(key, lib.NewClient("", &testDatabase{Outputs: outputs}))
+	if _, err := tokenWallet.TokenBurn(hs.GetTxHash(testTokenHash), 1200); !errors.Is(err, gen.NotEnoughTokenValueError) {
+		t.Errorf("unexpected error burning more tokens than held: %v", err)
+	}
+	memoTx, err := tokenWallet.TokenBurn(hs.GetTxHash(testTokenHash), 700)
+	if err != nil {
+		t.Fatal(fmt.Errorf("error burning tokens; %w", err))
+	}
+	var spent = make(map[string]bool)
+	for _, txIn := range memoTx.MsgTx.TxIn {
+		spent[fmt.Sprintf("%s:%d", txIn.PreviousOutPoint.Hash, txIn.PreviousOutPoint.Index)] = true
+	}
+	var remaining []graph.Output
+	for _, output := range outputs {
+		if spent[fmt.Sprintf("%s:%d", output.Hash, output.Index)] {
+			continue
+		}
+		if output.Slp != nil {
+			t.Errorf("token output not spent by burn: %s:%d", output.Hash, output.Index)
+		}
+		remaining = append(remaining, output)
+	}
+	if len(memoTx.MsgTx.TxOut) < 2 {
+		t.Fatalf("unexpected burn tx output count: %d", len(memoTx.MsgTx.TxOut))
+	}
+	quantities, err := getSlpSendQuantities(memoTx.MsgTx.TxOut[0].PkScript)
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting burn tx slp send quantities; %w", err))
+	}
+	for i, quantity := range quantities {
+		txOut := memoTx.MsgTx.TxOut[i+1]
+		addr, err := wallet.GetAddrFromLockScript(txOut.PkScript)
+		if err != nil || *addr != key.GetAddr() {
+			t.Errorf("burn tx token output not sent to wallet: %d", i+1)
+			continue
+		}
+		remaining = append(remaining, graph.Output{
+			Hash:   memoTx.MsgTx.TxHash().String(),
+			Index:  i + 1,
+			Amount: txOut.Value,
+			Slp:    &graph.Slp{TokenHash: testTokenHash, Amount: quantity},
+		})
+	}
+	balances := wlt.GetTokenBalances(remaining)
+	if len(balances) != 1 || balances[0].TokenHash != testTokenHash || balances[0].Quantity != 400 {
+		t.Errorf("unexpected token balances after burn: %d", len(balances))
+		for _, balance := range balances {
+			t.Errorf("token balance: %s, quantity: %d", balance.TokenHash, balance.Quantity)
+		}
+	}
+}
@@ -12,6 +12,8 @@ type Wallet struct {
 	Key     wallet.PrivateKey
 	Address wallet.Addr
 	Getter  *InputGetter
+	// Waiter is used by Send to wait for each broadcast tx to be processed, nil to not wait.
+	Waiter TxWaiter
 }
 
 func NewWallet(key wallet.PrivateKey, client *lib.Client) *Wallet {
@@ -6,6 +6,7 @@ import (
 	"github.com/memocash/index/cmd/peer"
 	"github.com/memocash/index/cmd/serve"
 	"github.com/memocash/index/db/store"
+	"github.com/memocash/index/ref/bitcoin/wallet"
 	"github.com/memocash/index/ref/broadcast/broadcast_client"
 	"github.com/memocash/index/ref/config"
 	"github.com/pkg/profile"
@@ -32,6 +33,9 @@ var indexCmd = &cobra.Command{
 			log.Fatalf("fatal error initializing config; %v", err)
 		}
 		broadcast_client.SetConfig(config.GetBroadcastRpc())
+		if err := wallet.SetNetwork(config.GetNetwork()); err != nil {
+			log.Fatalf("fatal error setting wallet network; %v", err)
+		}
 		profileExecution, _ := cmd.Flags().GetBool(config.FlagProfile)
 		if profileExecution {
 			pf = profile.Start()
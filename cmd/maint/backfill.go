@@ -0,0 +1,22 @@
+package maint
+
+import (
+	"github.com/memocash/index/admin/client/peer"
+	"github.com/spf13/cobra"
+	"log"
+)
+
+var backfillCmd = &cobra.Command{
+	Use:   "backfill",
+	Short: "Process a block height range again from the running lead processor's block source",
+	Run: func(c *cobra.Command, args []string) {
+		from, _ := c.Flags().GetInt64(FlagFrom)
+		to, _ := c.Flags().GetInt64(FlagTo)
+		backfill := peer.NewBackfill()
+		log.Printf("Starting backfill from %d to %d\n", from, to)
+		if err := backfill.Backfill(from, to); err != nil {
+			log.Fatalf("fatal error running backfill; %v", err)
+		}
+		log.Printf("Backfill result: %s\n", backfill.Message)
+	},
+}
@@ -0,0 +1,26 @@
+package maint
+
+import (
+	"github.com/jchavannes/jgo/jfmt"
+	"github.com/memocash/index/ref/cluster/lead"
+	"github.com/spf13/cobra"
+	"log"
+)
+
+var importBlocksCmd = &cobra.Command{
+	Use:   "import-blocks [dir]",
+	Short: "Import blocks from node blk*.dat files or a directory of raw blocks",
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		verbose, _ := c.Flags().GetBool(FlagVerbose)
+		restart, _ := c.Flags().GetBool(FlagRestart)
+		parallel, _ := c.Flags().GetInt(FlagParallel)
+		blockImport := lead.NewImport(args[0], parallel, restart, verbose)
+		log.Printf("Starting block import from: %s\n", args[0])
+		if err := blockImport.Run(); err != nil {
+			log.Fatalf("fatal error importing blocks; %v", err)
+		}
+		log.Printf("Block import complete. Blocks: %s, txs: %s.\n",
+			jfmt.AddCommasInt(blockImport.Blocks), jfmt.AddCommasInt(blockImport.Txs))
+	},
+}
@@ -1,13 +1,22 @@
 package maint
 
 import (
+	"github.com/memocash/index/node/obj/saver"
+	"github.com/memocash/index/ref/cluster/lead"
 	"github.com/spf13/cobra"
+	"strings"
 )
 
 const (
 	FlagVerbose = "verbose"
 	FlagDelete  = "delete"
 	FlagRestart = "restart"
+
+	FlagParallel = "parallel"
+
+	FlagFrom   = "from"
+	FlagTo     = "to"
+	FlagSavers = "savers"
 )
 
 var maintCommand = &cobra.Command{
@@ -19,6 +28,19 @@ func GetCommand() *cobra.Command {
 	populateP2shDirectCmd.Flags().BoolP(FlagRestart, "", false, "Restart from beginning")
 	populateAddrOutputsCmd.Flags().BoolP(FlagRestart, "", false, "Restart from beginning")
 	populateAddrInputsCmd.Flags().BoolP(FlagRestart, "", false, "Restart from beginning")
+	importBlocksCmd.Flags().BoolP(FlagRestart, "", false, "Restart from beginning")
+	importBlocksCmd.Flags().BoolP(FlagVerbose, "v", false, "Additional logging")
+	importBlocksCmd.Flags().IntP(FlagParallel, "p", lead.ImportDefaultParallel, "Blocks read in parallel")
+	backfillCmd.Flags().Int64P(FlagFrom, "", 0, "First block height")
+	backfillCmd.Flags().Int64P(FlagTo, "", 0, "Last block height")
+	reindexCmd.Flags().Int64P(FlagFrom, "", 0, "First block height")
+	reindexCmd.Flags().Int64P(FlagTo, "", 0, "Last block height")
+	reindexCmd.Flags().StringP(FlagSavers, "", strings.Join(saver.Names, ","),
+		"Comma separated savers to replay: "+strings.Join(saver.Names, ", "))
+	reindexCmd.Flags().BoolP(FlagDelete, "", false,
+		"Delete derived items for range before replaying (address and processed savers only)")
+	reindexCmd.Flags().BoolP(FlagRestart, "", false, "Restart from beginning of range")
+	reindexCmd.Flags().BoolP(FlagVerbose, "v", false, "Additional logging")
 	maintCommand.AddCommand(
 		queueProfileCmd,
 		checkFollowsCmd,
@@ -27,6 +49,9 @@ func GetCommand() *cobra.Command {
 		populateAddrOutputsCmd,
 		populateAddrInputsCmd,
 		populateSeenPostsCmd,
+		importBlocksCmd,
+		reindexCmd,
+		backfillCmd,
 	)
 	return maintCommand
 }
@@ -0,0 +1,29 @@
+package maint
+
+import (
+	"github.com/jchavannes/jgo/jfmt"
+	"github.com/memocash/index/node/act/maint"
+	"github.com/spf13/cobra"
+	"log"
+	"strings"
+)
+
+var reindexCmd = &cobra.Command{
+	Use:   "reindex",
+	Short: "Replay savers for a block height range from stored txs",
+	Run: func(c *cobra.Command, args []string) {
+		verbose, _ := c.Flags().GetBool(FlagVerbose)
+		restart, _ := c.Flags().GetBool(FlagRestart)
+		deleteDerived, _ := c.Flags().GetBool(FlagDelete)
+		from, _ := c.Flags().GetInt64(FlagFrom)
+		to, _ := c.Flags().GetInt64(FlagTo)
+		savers, _ := c.Flags().GetString(FlagSavers)
+		reindex := maint.NewReindex(from, to, strings.Split(savers, ","), deleteDerived, verbose)
+		log.Printf("Starting reindex from %d to %d with savers: %s\n", from, to, savers)
+		if err := reindex.Run(restart); err != nil {
+			log.Fatalf("fatal error reindexing; %v", err)
+		}
+		log.Printf("Reindex complete. Block shards: %s, txs: %s, removed: %s.\n", jfmt.AddCommasInt(int(reindex.Blocks)),
+			jfmt.AddCommasInt(int(reindex.Txs)), jfmt.AddCommasInt(int(reindex.Removed)))
+	},
+}
@@ -0,0 +1,53 @@
+package peer
+
+import (
+	"fmt"
+	"github.com/memocash/index/admin/client/peer"
+	"github.com/spf13/cobra"
+	"log"
+)
+
+var crawlerStartCmd = &cobra.Command{
+	Use: "crawler-start",
+	Run: func(cmd *cobra.Command, args []string) {
+		crawlerToggle := peer.NewCrawlerToggle()
+		if err := crawlerToggle.Start(); err != nil {
+			log.Fatalf("fatal error starting crawler; %v", err)
+		}
+		log.Printf("crawlerToggle.Message: %s\n", crawlerToggle.Message)
+	},
+}
+
+var crawlerStopCmd = &cobra.Command{
+	Use: "crawler-stop",
+	Run: func(cmd *cobra.Command, args []string) {
+		crawlerToggle := peer.NewCrawlerToggle()
+		if err := crawlerToggle.Stop(); err != nil {
+			log.Fatalf("fatal error stopping crawler; %v", err)
+		}
+		log.Printf("crawlerToggle.Message: %s\n", crawlerToggle.Message)
+	},
+}
+
+var networkMapCmd = &cobra.Command{
+	Use: "network-map",
+	Run: func(cmd *cobra.Command, args []string) {
+		networkMap := peer.NewNetworkMap()
+		if err := networkMap.Get(); err != nil {
+			log.Fatalf("fatal error getting network map; %v", err)
+		}
+		response := networkMap.Response
+		fmt.Printf("Crawler running: %t (crawled: %d, failed: %d)\n", response.CrawlerRunning, response.Crawled,
+			response.Failed)
+		fmt.Printf("Peers: %d, reachable: %d\n", response.TotalPeers, response.Reachable)
+		for _, userAgent := range response.UserAgents {
+			fmt.Printf("User agent: %s - %d\n", userAgent.Name, userAgent.Count)
+		}
+		for _, version := range response.Versions {
+			fmt.Printf("Protocol version: %s - %d\n", version.Name, version.Count)
+		}
+		for _, height := range response.Heights {
+			fmt.Printf("Height: %s - %d\n", height.Name, height.Count)
+		}
+	},
+}
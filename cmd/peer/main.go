@@ -24,6 +24,9 @@ func GetCommand() *cobra.Command {
 		loopingEnableCmd,
 		loopingDisableCmd,
 		foundPeersCmd,
+		crawlerStartCmd,
+		crawlerStopCmd,
+		networkMapCmd,
 	)
 	return peerCmd
 }
@@ -0,0 +1,147 @@
+package peer
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item"
+	"github.com/memocash/index/ref/config"
+	"sort"
+	"time"
+)
+
+const (
+	scoreHistory = 20
+	scoreDecay   = 0.9
+)
+
+// NetworkPeer is a peer that has reported its version, scored by its recent connection history.
+type NetworkPeer struct {
+	Info        *item.PeerInfo
+	Attempts    int
+	Successes   int
+	Uptime      float64 // Share of recent connection attempts that succeeded
+	Reliability float64 // Uptime weighted towards the most recent attempts
+	LastSuccess time.Time
+	Reachable   bool // Most recent connection attempt succeeded
+}
+
+type NetworkCount struct {
+	Name  string
+	Count int
+}
+
+// Network is a map of peers found by the node group and crawler.
+type Network struct {
+	Peers []*NetworkPeer
+}
+
+func (n *Network) Load() error {
+	for shard := uint32(0); shard < config.GetTotalShards(); shard++ {
+		for startId := []byte{}; ; {
+			peerInfos, err := item.GetPeerInfos(shard, startId)
+			if err != nil {
+				return fmt.Errorf("error getting peer infos for network; %w", err)
+			}
+			for i, peerInfo := range peerInfos {
+				if i == 0 && bytes.Equal(peerInfo.GetUid(), startId) {
+					continue
+				}
+				networkPeer, err := GetNetworkPeer(peerInfo)
+				if err != nil {
+					return fmt.Errorf("error getting network peer; %w", err)
+				}
+				n.Peers = append(n.Peers, networkPeer)
+			}
+			if len(peerInfos) < client.LargeLimit {
+				break
+			}
+			startId = peerInfos[len(peerInfos)-1].GetUid()
+		}
+	}
+	n.Sort()
+	return nil
+}
+
+// Sort orders peers by reliability, most reliable first. Peers with the same reliability are ordered by most recent
+// success.
+func (n *Network) Sort() {
+	sort.SliceStable(n.Peers, func(i, j int) bool {
+		if n.Peers[i].Reliability == n.Peers[j].Reliability {
+			return n.Peers[i].LastSuccess.After(n.Peers[j].LastSuccess)
+		}
+		return n.Peers[i].Reliability > n.Peers[j].Reliability
+	})
+}
+
+func (n *Network) GetReachable() []*NetworkPeer {
+	var reachable []*NetworkPeer
+	for _, networkPeer := range n.Peers {
+		if networkPeer.Reachable {
+			reachable = append(reachable, networkPeer)
+		}
+	}
+	return reachable
+}
+
+// GetCounts returns the number of reachable peers for each value of a field, most common first.
+func (n *Network) GetCounts(field func(*item.PeerInfo) string) []NetworkCount {
+	var counts = make(map[string]int)
+	for _, networkPeer := range n.GetReachable() {
+		counts[field(networkPeer.Info)]++
+	}
+	var networkCounts = make([]NetworkCount, 0, len(counts))
+	for name, count := range counts {
+		networkCounts = append(networkCounts, NetworkCount{Name: name, Count: count})
+	}
+	sort.Slice(networkCounts, func(i, j int) bool {
+		if networkCounts[i].Count == networkCounts[j].Count {
+			return networkCounts[i].Name < networkCounts[j].Name
+		}
+		return networkCounts[i].Count > networkCounts[j].Count
+	})
+	return networkCounts
+}
+
+// GetNetworkPeer scores a peer from its most recent connection attempts, newest first.
+func GetNetworkPeer(peerInfo *item.PeerInfo) (*NetworkPeer, error) {
+	peerConnections, err := item.GetPeerConnectionsRecent(peerInfo.Ip, peerInfo.Port, scoreHistory)
+	if err != nil {
+		return nil, fmt.Errorf("error getting recent peer connections for network peer; %w", err)
+	}
+	return ScoreNetworkPeer(peerInfo, peerConnections), nil
+}
+
+// ScoreNetworkPeer scores a peer from connection attempts ordered newest first. Reliability weights each attempt by
+// scoreDecay relative to the attempt after it.
+func ScoreNetworkPeer(peerInfo *item.PeerInfo, peerConnections []*item.PeerConnection) *NetworkPeer {
+	var networkPeer = &NetworkPeer{
+		Info:     peerInfo,
+		Attempts: len(peerConnections),
+	}
+	var weight, weightTotal, weightSuccess = 1.0, 0.0, 0.0
+	for i, peerConnection := range peerConnections {
+		success := peerConnection.Status == item.PeerConnectionStatusSuccess
+		if success {
+			networkPeer.Successes++
+			weightSuccess += weight
+			if networkPeer.LastSuccess.IsZero() {
+				networkPeer.LastSuccess = peerConnection.Time
+			}
+		}
+		if i == 0 {
+			networkPeer.Reachable = success
+		}
+		weightTotal += weight
+		weight *= scoreDecay
+	}
+	if networkPeer.Attempts > 0 {
+		networkPeer.Uptime = float64(networkPeer.Successes) / float64(networkPeer.Attempts)
+		networkPeer.Reliability = weightSuccess / weightTotal
+	}
+	return networkPeer
+}
+
+func NewNetwork() *Network {
+	return &Network{}
+}
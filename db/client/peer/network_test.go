@@ -0,0 +1,106 @@
+package peer_test
+
+import (
+	"github.com/memocash/index/db/client/peer"
+	"github.com/memocash/index/db/item"
+	"math"
+	"testing"
+	"time"
+)
+
+const (
+	S = item.PeerConnectionStatusSuccess
+	F = item.PeerConnectionStatusFail
+)
+
+var testNow = time.Unix(1700000000, 0)
+
+func getTestConnections(statuses ...item.PeerConnectionStatus) []*item.PeerConnection {
+	var peerConnections = make([]*item.PeerConnection, len(statuses))
+	for i, status := range statuses {
+		peerConnections[i] = &item.PeerConnection{
+			Time:   testNow.Add(-time.Duration(i) * time.Hour),
+			Status: status,
+		}
+	}
+	return peerConnections
+}
+
+type scoreTest struct {
+	Name        string
+	Statuses    []item.PeerConnectionStatus
+	Reachable   bool
+	Uptime      float64
+	Reliability float64
+	LastSuccess time.Time
+}
+
+var scoreTests = []scoreTest{{
+	Name: "no attempts",
+}, {
+	Name:        "all success",
+	Statuses:    []item.PeerConnectionStatus{S, S, S},
+	Reachable:   true,
+	Uptime:      1,
+	Reliability: 1,
+	LastSuccess: testNow,
+}, {
+	Name:     "all fail",
+	Statuses: []item.PeerConnectionStatus{F, F},
+}, {
+	Name:        "recent success",
+	Statuses:    []item.PeerConnectionStatus{S, F},
+	Reachable:   true,
+	Uptime:      0.5,
+	Reliability: 1 / 1.9,
+	LastSuccess: testNow,
+}, {
+	Name:        "old success",
+	Statuses:    []item.PeerConnectionStatus{F, S},
+	Uptime:      0.5,
+	Reliability: 0.9 / 1.9,
+	LastSuccess: testNow.Add(-time.Hour),
+}}
+
+func TestScoreNetworkPeer(t *testing.T) {
+	for _, tst := range scoreTests {
+		t.Run(tst.Name, func(t *testing.T) {
+			networkPeer := peer.ScoreNetworkPeer(&item.PeerInfo{}, getTestConnections(tst.Statuses...))
+			if networkPeer.Attempts != len(tst.Statuses) {
+				t.Errorf("unexpected attempts: %d, expected: %d", networkPeer.Attempts, len(tst.Statuses))
+			}
+			if networkPeer.Reachable != tst.Reachable {
+				t.Errorf("unexpected reachable: %t, expected: %t", networkPeer.Reachable, tst.Reachable)
+			}
+			if math.Abs(networkPeer.Uptime-tst.Uptime) > 1e-9 {
+				t.Errorf("unexpected uptime: %f, expected: %f", networkPeer.Uptime, tst.Uptime)
+			}
+			if math.Abs(networkPeer.Reliability-tst.Reliability) > 1e-9 {
+				t.Errorf("unexpected reliability: %f, expected: %f", networkPeer.Reliability, tst.Reliability)
+			}
+			if !networkPeer.LastSuccess.Equal(tst.LastSuccess) {
+				t.Errorf("unexpected last success: %s, expected: %s", networkPeer.LastSuccess, tst.LastSuccess)
+			}
+		})
+	}
+}
+
+func TestNetworkSort(t *testing.T) {
+	var network = peer.NewNetwork()
+	for i, statuses := range [][]item.PeerConnectionStatus{{F, S}, {S, S}, {F, F}, {S, F}, {F, F, S}} {
+		network.Peers = append(network.Peers, peer.ScoreNetworkPeer(&item.PeerInfo{Port: uint16(i)},
+			getTestConnections(statuses...)))
+	}
+	network.Sort()
+	var expectedPorts = []uint16{1, 3, 0, 4, 2}
+	for i, networkPeer := range network.Peers {
+		if networkPeer.Info.Port != expectedPorts[i] {
+			t.Errorf("unexpected peer at position %d: %d, expected: %d (reliability: %f)",
+				i, networkPeer.Info.Port, expectedPorts[i], networkPeer.Reliability)
+		}
+	}
+	reachable := network.GetReachable()
+	if len(reachable) != 2 || reachable[0].Info.Port != 1 || reachable[1].Info.Port != 3 {
+		t.Errorf("unexpected reachable peers: %d", len(reachable))
+	}
+}
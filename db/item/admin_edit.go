@@ -0,0 +1,96 @@
+package item
+
+import (
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/db"
+	"time"
+)
+
+const (
+	AdminEditActionDelete = "delete"
+	AdminEditActionSave   = "save"
+)
+
+// AdminEdit is an audit record of an existing item deleted or re-saved from the admin server, saved before the edit is
+// applied. The new message is empty for deletes.
+type AdminEdit struct {
+	Time       time.Time
+	Uid        []byte
+	Topic      string
+	Shard      uint32
+	Action     string
+	Remote     string
+	OldMessage []byte
+	NewMessage []byte
+}
+
+func (e *AdminEdit) GetTopic() string {
+	return db.TopicAdminEdit
+}
+
+func (e *AdminEdit) GetShardSource() uint {
+	return client.GenShardSource(jutil.GetTimeByteNanoBig(e.Time))
+}
+
+func (e *AdminEdit) GetUid() []byte {
+	return jutil.CombineBytes(
+		jutil.GetTimeByteNanoBig(e.Time),
+		e.Uid,
+	)
+}
+
+func (e *AdminEdit) SetUid(uid []byte) {
+	if len(uid) < 8 {
+		return
+	}
+	e.Time = jutil.GetByteTimeNanoBig(uid[:8])
+	e.Uid = uid[8:]
+}
+
+func (e *AdminEdit) Serialize() []byte {
+	return jutil.CombineBytes(
+		jutil.GetUint32Data(e.Shard),
+		jutil.GetIntData(len(e.Topic)),
+		[]byte(e.Topic),
+		jutil.GetIntData(len(e.Action)),
+		[]byte(e.Action),
+		jutil.GetIntData(len(e.Remote)),
+		[]byte(e.Remote),
+		jutil.GetIntData(len(e.OldMessage)),
+		e.OldMessage,
+		e.NewMessage,
+	)
+}
+
+func (e *AdminEdit) Deserialize(data []byte) {
+	if len(data) < 4 {
+		return
+	}
+	e.Shard = jutil.GetUint32(data[:4])
+	data = data[4:]
+	var fields = make([][]byte, 4)
+	for i := range fields {
+		if len(data) < 4 {
+			return
+		}
+		fieldLen := jutil.GetInt(data[:4])
+		if len(data) < 4+fieldLen {
+			return
+		}
+		fields[i], data = data[4:4+fieldLen], data[4+fieldLen:]
+	}
+	e.Topic = string(fields[0])
+	e.Action = string(fields[1])
+	e.Remote = string(fields[2])
+	e.OldMessage = fields[3]
+	e.NewMessage = data
+}
+
+func SaveAdminEdit(adminEdit *AdminEdit) error {
+	if err := db.Save([]db.Object{adminEdit}); err != nil {
+		return fmt.Errorf("error saving admin edit; %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package item
+
+import (
+	"context"
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/ref/config"
+	"time"
+)
+
+type BroadcastResultStatus int
+
+func (s BroadcastResultStatus) String() string {
+	switch s {
+	case BroadcastResultStatusAccepted:
+		return "accepted"
+	case BroadcastResultStatusRejected:
+		return "rejected"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	BroadcastResultStatusAccepted BroadcastResultStatus = 1
+	BroadcastResultStatusRejected BroadcastResultStatus = 2
+)
+
+// BroadcastResult is the latest outcome of broadcasting a tx to upstream peers. Accepted means no peer rejected the
+// tx within the broadcast wait window, a later reject from a node replaces it.
+type BroadcastResult struct {
+	TxHash [32]byte
+	Status BroadcastResultStatus
+	Code   uint8
+	Reason string
+	Host   string
+	Time   time.Time
+}
+
+func (r *BroadcastResult) GetUid() []byte {
+	return jutil.ByteReverse(r.TxHash[:])
+}
+
+func (r *BroadcastResult) GetShardSource() uint {
+	return client.GenShardSource(r.TxHash[:])
+}
+
+func (r *BroadcastResult) GetTopic() string {
+	return db.TopicBroadcastResult
+}
+
+func (r *BroadcastResult) Serialize() []byte {
+	return jutil.CombineBytes(
+		jutil.GetIntData(int(r.Status)),
+		[]byte{r.Code},
+		jutil.GetTimeByteNanoBig(r.Time),
+		jutil.GetIntData(len(r.Host)),
+		[]byte(r.Host),
+		[]byte(r.Reason),
+	)
+}
+
+func (r *BroadcastResult) SetUid(uid []byte) {
+	if len(uid) != 32 {
+		return
+	}
+	copy(r.TxHash[:], jutil.ByteReverse(uid))
+}
+
+func (r *BroadcastResult) Deserialize(data []byte) {
+	if len(data) < 17 {
+		return
+	}
+	r.Status = BroadcastResultStatus(jutil.GetInt(data[:4]))
+	r.Code = data[4]
+	r.Time = jutil.GetByteTimeNanoBig(data[5:13])
+	hostLen := jutil.GetInt(data[13:17])
+	if len(data) < 17+hostLen {
+		return
+	}
+	r.Host = string(data[17 : 17+hostLen])
+	r.Reason = string(data[17+hostLen:])
+}
+
+func GetBroadcastResult(txHash [32]byte) (*BroadcastResult, error) {
+	var broadcastResult = &BroadcastResult{TxHash: txHash}
+	if err := db.GetItem(broadcastResult); err != nil {
+		return nil, fmt.Errorf("error getting item broadcast result; %w", err)
+	}
+	return broadcastResult, nil
+}
+
+func ListenBroadcastResults(ctx context.Context, txHashes [][32]byte) (chan *BroadcastResult, error) {
+	if len(txHashes) == 0 {
+		return nil, nil
+	}
+	var shardPrefixes = make(map[uint32][][]byte)
+	for i := range txHashes {
+		shard := client.GenShardSource32(txHashes[i][:])
+		shardPrefixes[shard] = append(shardPrefixes[shard], jutil.ByteReverse(txHashes[i][:]))
+	}
+	shardConfigs := config.GetQueueShards()
+	var broadcastResultChan = make(chan *BroadcastResult)
+	cancelCtx := db.NewCancelContext(ctx, func() {
+		close(broadcastResultChan)
+	})
+	for shard, prefixes := range shardPrefixes {
+		dbClient := client.NewClient(config.GetShardConfig(shard, shardConfigs).GetHost())
+		chanMessage, err := dbClient.Listen(cancelCtx.Context, db.TopicBroadcastResult, prefixes)
+		if err != nil {
+			return nil, fmt.Errorf("error listening to db broadcast results by prefix; %w", err)
+		}
+		go func() {
+			for msg := range chanMessage {
+				var broadcastResult = new(BroadcastResult)
+				db.Set(broadcastResult, *msg)
+				broadcastResultChan <- broadcastResult
+			}
+			cancelCtx.Cancel()
+		}()
+	}
+	return broadcastResultChan, nil
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/jchavannes/jgo/jutil"
 	"github.com/memocash/index/db/client"
 	"github.com/memocash/index/db/item/db"
-	"github.com/memocash/index/ref/config"
 )
 
 type Block struct {
@@ -40,16 +39,10 @@ func (b *Block) Deserialize(data []byte) {
 }
 
 func GetBlock(blockHash [32]byte) (*Block, error) {
-	shardConfig := config.GetShardConfig(client.GenShardSource32(blockHash[:]), config.GetQueueShards())
-	dbClient := client.NewClient(shardConfig.GetHost())
-	if err := dbClient.GetSingle(db.TopicChainBlock, jutil.ByteReverse(blockHash[:])); err != nil {
+	var block = &Block{Hash: blockHash}
+	if err := db.GetItem(block); err != nil {
 		return nil, fmt.Errorf("error getting client message block; %w", err)
 	}
-	if len(dbClient.Messages) != 1 {
-		return nil, fmt.Errorf("error unexpected number of messages: %d", len(dbClient.Messages))
-	}
-	var block = new(Block)
-	db.Set(block, dbClient.Messages[0])
 	return block, nil
 }
 
@@ -0,0 +1,53 @@
+package chain
+
+import (
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/db"
+	"math/big"
+)
+
+// BlockWork is the cumulative chainwork of a block since INIT_BLOCK_PARENT.
+type BlockWork struct {
+	BlockHash [32]byte
+	Work      *big.Int
+}
+
+func (b *BlockWork) GetTopic() string {
+	return db.TopicChainBlockWork
+}
+
+func (b *BlockWork) GetShardSource() uint {
+	return client.GenShardSource(b.BlockHash[:])
+}
+
+func (b *BlockWork) GetUid() []byte {
+	return jutil.ByteReverse(b.BlockHash[:])
+}
+
+func (b *BlockWork) SetUid(uid []byte) {
+	if len(uid) != 32 {
+		return
+	}
+	copy(b.BlockHash[:], jutil.ByteReverse(uid))
+}
+
+func (b *BlockWork) Serialize() []byte {
+	if b.Work == nil {
+		return nil
+	}
+	return b.Work.Bytes()
+}
+
+func (b *BlockWork) Deserialize(data []byte) {
+	b.Work = new(big.Int).SetBytes(data)
+}
+
+func GetBlockWork(blockHash [32]byte) (*BlockWork, error) {
+	var blockWork = &BlockWork{BlockHash: blockHash}
+	if err := db.GetItem(blockWork); err != nil {
+		return nil, fmt.Errorf("error getting client message block work; %w", err)
+	}
+	return blockWork, nil
+}
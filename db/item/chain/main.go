@@ -8,6 +8,7 @@ func GetTopics() []db.Object {
 		&BlockHeight{},
 		&BlockInfo{},
 		&BlockTx{},
+		&BlockWork{},
 		&HeightBlock{},
 		&HeightDuplicate{},
 		&OutputInput{},
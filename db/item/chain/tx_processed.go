@@ -45,6 +45,25 @@ func GetTxProcessedUid(txHash []byte, timestamp time.Time) []byte {
 	return jutil.CombineBytes(jutil.ByteReverse(txHash), jutil.GetTimeByteNanoBig(timestamp))
 }
 
+func GetTxProcesseds(ctx context.Context, txHashes [][32]byte) ([]*TxProcessed, error) {
+	var shardPrefixes = make(map[uint32][][]byte)
+	for i := range txHashes {
+		shard := db.GetShardIdFromByte32(txHashes[i][:])
+		shardPrefixes[shard] = append(shardPrefixes[shard], jutil.ByteReverse(txHashes[i][:]))
+	}
+	messages, err := db.GetByPrefixes(ctx, db.TopicChainTxProcessed, shardPrefixes)
+	if err != nil {
+		return nil, fmt.Errorf("error getting client message chain tx processed; %w", err)
+	}
+	var txProcesseds []*TxProcessed
+	for _, msg := range messages {
+		var txProcessed = new(TxProcessed)
+		db.Set(txProcessed, msg)
+		txProcesseds = append(txProcesseds, txProcessed)
+	}
+	return txProcesseds, nil
+}
+
 func WaitForTxProcessed(ctx context.Context, txHash []byte) (*TxProcessed, error) {
 	shardConfig := config.GetShardConfig(db.GetShardIdFromByte32(txHash), config.GetQueueShards())
 	dbClient := client.NewClient(shardConfig.GetHost())
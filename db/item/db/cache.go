@@ -0,0 +1,373 @@
+package db
+
+import (
+	"container/list"
+	"context"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/metric"
+	"github.com/memocash/index/ref/config"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	cacheEntryOverhead  = 96
+	cacheListenRetry    = 5 * time.Second
+	cacheMetricInterval = 10 * time.Second
+	cacheKindUid        = 'u'
+	cacheKindPrefix     = 'p'
+	cacheKindSeparator  = 0x00
+)
+
+// immutableTopics are topics whose items never change once written, so lookups can be served from memory.
+var immutableTopics = map[string]bool{
+	TopicChainBlock:     true,
+	TopicChainBlockWork: true,
+	TopicChainTx:        true,
+	TopicChainTxInput:   true,
+	TopicChainTxOutput:  true,
+	TopicMemoPost:       true,
+}
+
+func IsImmutableTopic(topic string) bool {
+	return immutableTopics[topic]
+}
+
+type cacheEntry struct {
+	key      string
+	messages []client.Message
+	size     int
+	notFound time.Time
+}
+
+type Cache struct {
+	MaxSize     int
+	NegativeTtl time.Duration
+	size        int
+	entries     map[string]*list.Element
+	order       *list.List
+	shards      int
+	listening   map[string]int
+	generation  map[string]uint64
+	stats       metric.Cache
+	mutex       sync.Mutex
+}
+
+func (c *Cache) getKey(topic string, kind byte, uid []byte) string {
+	key := make([]byte, 0, len(topic)+2+len(uid))
+	key = append(key, topic...)
+	key = append(key, cacheKindSeparator, kind)
+	return string(append(key, uid...))
+}
+
+// get returns the cached messages for a key and whether the key was found, a found key with no messages is a
+// negative entry for an item recently looked up and not found.
+func (c *Cache) get(key string) ([]client.Message, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	entry := element.Value.(*cacheEntry)
+	if kindFromKey(key) == cacheKindPrefix && !c.isListening(topicFromKey(key)) {
+		c.removeElement(element)
+		c.stats.Misses++
+		return nil, false
+	}
+	if !entry.notFound.IsZero() {
+		if time.Since(entry.notFound) > c.NegativeTtl || !c.isListening(topicFromKey(key)) {
+			c.removeElement(element)
+			c.stats.Misses++
+			return nil, false
+		}
+		c.stats.NegativeHits++
+		return nil, true
+	}
+	c.order.MoveToFront(element)
+	c.stats.Hits++
+	return entry.messages, true
+}
+
+func getEntrySize(key string, messages []client.Message) int {
+	var size = cacheEntryOverhead + len(key)
+	for _, message := range messages {
+		size += len(message.Topic) + len(message.Uid) + len(message.Message)
+	}
+	return size
+}
+
+func (c *Cache) put(key string, messages []client.Message) {
+	size := getEntrySize(key, messages)
+	if size > c.MaxSize {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.setEntry(&cacheEntry{key: key, messages: messages, size: size})
+}
+
+// putListening caches a lookup result that new items can change, e.g. a prefix lookup. The result is only cached
+// while the topic is listened to so it can be invalidated when a new item is saved.
+func (c *Cache) putListening(key string, messages []client.Message, generation uint64) {
+	size := getEntrySize(key, messages)
+	if size > c.MaxSize {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	topic := topicFromKey(key)
+	if !c.isListening(topic) || c.generation[topic] != generation {
+		return
+	}
+	c.setEntry(&cacheEntry{key: key, messages: messages, size: size})
+}
+
+// getGeneration returns a counter incremented on every new item for a topic, used to avoid caching a not found or
+// prefix result for a lookup that raced with a save.
+func (c *Cache) getGeneration(topic string) uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.generation[topic]
+}
+
+func (c *Cache) putNotFound(key string, generation uint64) {
+	if c.NegativeTtl <= 0 {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	topic := topicFromKey(key)
+	if !c.isListening(topic) || c.generation[topic] != generation {
+		return
+	}
+	c.setEntry(&cacheEntry{key: key, size: cacheEntryOverhead + len(key), notFound: time.Now()})
+}
+
+func (c *Cache) setEntry(entry *cacheEntry) {
+	if element, ok := c.entries[entry.key]; ok {
+		c.removeElement(element)
+	}
+	c.entries[entry.key] = c.order.PushFront(entry)
+	c.size += entry.size
+	for c.size > c.MaxSize {
+		c.removeElement(c.order.Back())
+		c.stats.Evictions++
+	}
+}
+
+func (c *Cache) removeElement(element *list.Element) {
+	entry := element.Value.(*cacheEntry)
+	c.order.Remove(element)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+}
+
+// removeStale drops a negative entry for a newly saved uid and any prefix lookups the new item would be included in.
+func (c *Cache) removeStale(topic string, uid []byte) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation[topic]++
+	for i := 0; i <= len(uid); i++ {
+		for _, kind := range []byte{cacheKindUid, cacheKindPrefix} {
+			if kind == cacheKindUid && i != len(uid) {
+				continue
+			}
+			element, ok := c.entries[c.getKey(topic, kind, uid[:i])]
+			if ok && (kind == cacheKindPrefix || !element.Value.(*cacheEntry).notFound.IsZero()) {
+				c.removeElement(element)
+			}
+		}
+	}
+}
+
+func (c *Cache) isListening(topic string) bool {
+	return c.listening[topic] == c.shards
+}
+
+func (c *Cache) setListening(topic string, listening bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation[topic]++
+	if listening {
+		c.listening[topic]++
+		return
+	}
+	c.listening[topic]--
+	for element := c.order.Front(); element != nil; {
+		next := element.Next()
+		entry := element.Value.(*cacheEntry)
+		if topicFromKey(entry.key) == topic && (!entry.notFound.IsZero() || kindFromKey(entry.key) == cacheKindPrefix) {
+			c.removeElement(element)
+		}
+		element = next
+	}
+}
+
+// listenTopic keeps a stream open to a shard for a topic so negative and prefix entries are invalidated when the
+// queue publishes a new item, caching them for the topic is disabled while any shard stream is down.
+func (c *Cache) listenTopic(topic string, shardConfig config.Shard) {
+	for {
+		ctx, cancel := context.WithCancel(context.Background())
+		msgChan, err := client.NewClient(shardConfig.GetHost()).Listen(ctx, topic, nil)
+		if err != nil {
+			log.Printf("error listening to topic for cache invalidation: %s (shard %d); %v",
+				topic, shardConfig.Shard, err)
+		} else {
+			c.setListening(topic, true)
+			for msg := range msgChan {
+				c.removeStale(topic, msg.Uid)
+			}
+			c.setListening(topic, false)
+		}
+		cancel()
+		time.Sleep(cacheListenRetry)
+	}
+}
+
+func (c *Cache) startMetrics() {
+	for range time.NewTicker(cacheMetricInterval).C {
+		c.mutex.Lock()
+		stats := c.stats
+		stats.Items = len(c.entries)
+		stats.Size = c.size
+		c.stats = metric.Cache{}
+		c.mutex.Unlock()
+		metric.AddCache(stats)
+	}
+}
+
+func topicFromKey(key string) string {
+	if i := strings.IndexByte(key, cacheKindSeparator); i != -1 {
+		return key[:i]
+	}
+	return key
+}
+
+func kindFromKey(key string) byte {
+	if i := strings.IndexByte(key, cacheKindSeparator); i != -1 && i+1 < len(key) {
+		return key[i+1]
+	}
+	return 0
+}
+
+var _cache *Cache
+var _cacheOnce sync.Once
+
+// InitCache enables the process-wide cache for immutable topics using the configured memory budget.
+func InitCache() {
+	_cacheOnce.Do(func() {
+		cacheConfig := config.GetCacheConfig()
+		if cacheConfig.Size <= 0 {
+			return
+		}
+		_cache = NewCache(cacheConfig.GetSizeBytes(), cacheConfig.GetNegativeTtl())
+		for topic := range immutableTopics {
+			for _, shardConfig := range config.GetQueueShards() {
+				go _cache.listenTopic(topic, shardConfig)
+			}
+		}
+		go _cache.startMetrics()
+		log.Printf("Cache enabled for immutable topics (size: %d MB, negative ttl: %s)\n",
+			cacheConfig.Size, _cache.NegativeTtl)
+	})
+}
+
+func NewCache(maxSize int, negativeTtl time.Duration) *Cache {
+	return &Cache{
+		MaxSize:     maxSize,
+		NegativeTtl: negativeTtl,
+		entries:     make(map[string]*list.Element),
+		order:       list.New(),
+		shards:      len(config.GetQueueShards()),
+		listening:   make(map[string]int),
+		generation:  make(map[string]uint64),
+	}
+}
+
+func getCache(topic string) *Cache {
+	if _cache == nil || !IsImmutableTopic(topic) {
+		return nil
+	}
+	return _cache
+}
+
+// getSpecific splits requested uids into cached messages and uids still needing a lookup.
+func (c *Cache) getSpecific(topic string, shardUids map[uint32][][]byte) ([]client.Message, map[uint32][][]byte) {
+	var messages []client.Message
+	var missing = make(map[uint32][][]byte)
+	for shard, uids := range shardUids {
+		for _, uid := range uids {
+			if cached, ok := c.get(c.getKey(topic, cacheKindUid, uid)); ok {
+				messages = append(messages, cached...)
+			} else {
+				missing[shard] = append(missing[shard], uid)
+			}
+		}
+	}
+	return messages, missing
+}
+
+func (c *Cache) setSpecific(topic string, shardUids map[uint32][][]byte, messages []client.Message, generation uint64) {
+	var found = make(map[string]bool)
+	for _, message := range messages {
+		found[string(message.Uid)] = true
+		c.put(c.getKey(topic, cacheKindUid, message.Uid), []client.Message{message})
+	}
+	for _, uids := range shardUids {
+		for _, uid := range uids {
+			if !found[string(uid)] {
+				c.putNotFound(c.getKey(topic, cacheKindUid, uid), generation)
+			}
+		}
+	}
+}
+
+// getPrefixes splits requested prefixes into cached messages and prefixes still needing a lookup.
+func (c *Cache) getPrefixes(topic string, shardPrefixes map[uint32][][]byte) ([]client.Message, map[uint32][][]byte) {
+	var messages []client.Message
+	var missing = make(map[uint32][][]byte)
+	for shard, prefixes := range shardPrefixes {
+		for _, prefix := range prefixes {
+			if cached, ok := c.get(c.getKey(topic, cacheKindPrefix, prefix)); ok {
+				messages = append(messages, cached...)
+			} else {
+				missing[shard] = append(missing[shard], prefix)
+			}
+		}
+	}
+	return messages, missing
+}
+
+func (c *Cache) setPrefixes(topic string, shardPrefixes map[uint32][][]byte, messages []client.Message, generation uint64) {
+	var prefixMessages = make(map[string][]client.Message)
+	var prefixLengths = make(map[int]bool)
+	for _, prefixes := range shardPrefixes {
+		for _, prefix := range jutil.RemoveDupesAndEmpties(prefixes) {
+			prefixMessages[string(prefix)] = nil
+			prefixLengths[len(prefix)] = true
+		}
+	}
+	for _, message := range messages {
+		for length := range prefixLengths {
+			if len(message.Uid) < length {
+				continue
+			}
+			prefix := string(message.Uid[:length])
+			if _, ok := prefixMessages[prefix]; ok {
+				prefixMessages[prefix] = append(prefixMessages[prefix], message)
+			}
+		}
+	}
+	for prefix, matches := range prefixMessages {
+		if len(matches) > 0 {
+			c.putListening(c.getKey(topic, cacheKindPrefix, []byte(prefix)), matches, generation)
+		} else {
+			c.putNotFound(c.getKey(topic, cacheKindPrefix, []byte(prefix)), generation)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package db
+
+import (
+	"github.com/memocash/index/db/client"
+	"testing"
+	"time"
+)
+
+const testCacheTopic = TopicChainTx
+
+func getTestCache(listening bool) *Cache {
+	cache := NewCache(1<<20, time.Minute)
+	for i := 0; listening && i < cache.shards; i++ {
+		cache.setListening(testCacheTopic, true)
+	}
+	return cache
+}
+
+func getTestCacheMessage(uid string) client.Message {
+	return client.Message{Topic: testCacheTopic, Uid: []byte(uid), Message: []byte("message-" + uid)}
+}
+
+func TestCacheUidHitMiss(t *testing.T) {
+	cache := getTestCache(false)
+	var shardUids = map[uint32][][]byte{0: {[]byte("uid-1"), []byte("uid-2")}}
+	if messages, missing := cache.getSpecific(testCacheTopic, shardUids); len(messages) != 0 || len(missing[0]) != 2 {
+		t.Errorf("unexpected empty cache get, messages: %d, missing: %d", len(messages), len(missing[0]))
+	}
+	if cache.stats.Misses != 2 || cache.stats.Hits != 0 {
+		t.Errorf("unexpected stats after misses, hits: %d, misses: %d", cache.stats.Hits, cache.stats.Misses)
+	}
+	cache.setSpecific(testCacheTopic, shardUids, []client.Message{getTestCacheMessage("uid-1")},
+		cache.getGeneration(testCacheTopic))
+	messages, missing := cache.getSpecific(testCacheTopic, shardUids)
+	if len(messages) != 1 || string(messages[0].Uid) != "uid-1" {
+		t.Errorf("unexpected cached messages: %d", len(messages))
+	}
+	if len(missing[0]) != 1 || string(missing[0][0]) != "uid-2" {
+		t.Errorf("unexpected missing uids, not found not cached without listening: %d", len(missing[0]))
+	}
+	if cache.stats.Hits != 1 || cache.stats.Misses != 3 {
+		t.Errorf("unexpected stats after hit, hits: %d, misses: %d", cache.stats.Hits, cache.stats.Misses)
+	}
+}
+
+func TestCacheUidNotFound(t *testing.T) {
+	cache := getTestCache(true)
+	var shardUids = map[uint32][][]byte{0: {[]byte("uid-1")}}
+	cache.setSpecific(testCacheTopic, shardUids, nil, cache.getGeneration(testCacheTopic))
+	if messages, missing := cache.getSpecific(testCacheTopic, shardUids); len(messages) != 0 || len(missing) != 0 {
+		t.Errorf("expected negative hit, messages: %d, missing: %d", len(messages), len(missing))
+	}
+	cache.removeStale(testCacheTopic, []byte("uid-1"))
+	if _, missing := cache.getSpecific(testCacheTopic, shardUids); len(missing[0]) != 1 {
+		t.Errorf("expected miss after save of not found uid")
+	}
+}
+
+func TestCachePrefixStale(t *testing.T) {
+	var shardPrefixes = map[uint32][][]byte{0: {[]byte("prefix-")}}
+	var messages = []client.Message{getTestCacheMessage("prefix-1"), getTestCacheMessage("other-1")}
+	notListening := getTestCache(false)
+	notListening.setPrefixes(testCacheTopic, shardPrefixes, messages, notListening.getGeneration(testCacheTopic))
+	if _, missing := notListening.getPrefixes(testCacheTopic, shardPrefixes); len(missing[0]) != 1 {
+		t.Errorf("expected prefix not cached without listening")
+	}
+	cache := getTestCache(true)
+	cache.setPrefixes(testCacheTopic, shardPrefixes, messages, cache.getGeneration(testCacheTopic))
+	cached, missing := cache.getPrefixes(testCacheTopic, shardPrefixes)
+	if len(cached) != 1 || string(cached[0].Uid) != "prefix-1" || len(missing) != 0 {
+		t.Errorf("unexpected cached prefix messages: %d, missing: %d", len(cached), len(missing))
+	}
+	cache.removeStale(testCacheTopic, []byte("other-2"))
+	if _, missing := cache.getPrefixes(testCacheTopic, shardPrefixes); len(missing) != 0 {
+		t.Errorf("expected prefix still cached after save with other prefix")
+	}
+	cache.removeStale(testCacheTopic, []byte("prefix-2"))
+	if _, missing := cache.getPrefixes(testCacheTopic, shardPrefixes); len(missing[0]) != 1 {
+		t.Errorf("expected prefix stale after save with prefix")
+	}
+	generation := cache.getGeneration(testCacheTopic)
+	cache.removeStale(testCacheTopic, []byte("prefix-3"))
+	cache.setPrefixes(testCacheTopic, shardPrefixes, messages, generation)
+	if _, missing := cache.getPrefixes(testCacheTopic, shardPrefixes); len(missing[0]) != 1 {
+		t.Errorf("expected prefix not cached for lookup racing with save")
+	}
+	cache.setPrefixes(testCacheTopic, shardPrefixes, messages, cache.getGeneration(testCacheTopic))
+	cache.setListening(testCacheTopic, false)
+	if _, missing := cache.getPrefixes(testCacheTopic, shardPrefixes); len(missing[0]) != 1 {
+		t.Errorf("expected prefix removed after listener stopped")
+	}
+}
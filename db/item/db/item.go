@@ -11,19 +11,46 @@ import (
 )
 
 func GetItem(obj Object) error {
+	var cacheKey string
+	var generation uint64
+	cache := getCache(obj.GetTopic())
+	if cache != nil {
+		cacheKey = cache.getKey(obj.GetTopic(), cacheKindUid, obj.GetUid())
+		if messages, ok := cache.get(cacheKey); ok {
+			if len(messages) != 1 {
+				return fmt.Errorf("error item not found (cached); %w", client.EntryNotFoundError)
+			}
+			obj.Deserialize(messages[0].Message)
+			return nil
+		}
+		generation = cache.getGeneration(obj.GetTopic())
+	}
 	shardConfig := config.GetShardConfig(uint32(obj.GetShardSource()), config.GetQueueShards())
 	dbClient := client.NewClient(shardConfig.GetHost())
 	if err := dbClient.GetSingle(obj.GetTopic(), obj.GetUid()); err != nil && !client.IsMessageNotSetError(err) {
 		return fmt.Errorf("error getting db item single; %w", err)
 	}
 	if len(dbClient.Messages) != 1 {
+		if cache != nil {
+			cache.putNotFound(cacheKey, generation)
+		}
 		return fmt.Errorf("error item not found; %w", client.EntryNotFoundError)
 	}
+	if cache != nil {
+		cache.put(cacheKey, dbClient.Messages)
+	}
 	obj.Deserialize(dbClient.Messages[0].Message)
 	return nil
 }
 
 func GetSpecific(ctx context.Context, topic string, shardUids map[uint32][][]byte) ([]client.Message, error) {
+	var cachedMessages []client.Message
+	var generation uint64
+	cache := getCache(topic)
+	if cache != nil {
+		generation = cache.getGeneration(topic)
+		cachedMessages, shardUids = cache.getSpecific(topic, shardUids)
+	}
 	wait := NewWait(len(shardUids))
 	var messages []client.Message
 	for shardT, uidsT := range shardUids {
@@ -57,10 +84,20 @@ func GetSpecific(ctx context.Context, topic string, shardUids map[uint32][][]byt
 	if len(wait.Errs) > 0 {
 		return nil, fmt.Errorf("error getting specific messages; %w", jerr.Combine(wait.Errs...))
 	}
-	return messages, nil
+	if cache != nil {
+		cache.setSpecific(topic, shardUids, messages, generation)
+	}
+	return append(cachedMessages, messages...), nil
 }
 
 func GetByPrefixes(ctx context.Context, topic string, shardPrefixes map[uint32][][]byte) ([]client.Message, error) {
+	var cachedMessages []client.Message
+	var generation uint64
+	cache := getCache(topic)
+	if cache != nil {
+		generation = cache.getGeneration(topic)
+		cachedMessages, shardPrefixes = cache.getPrefixes(topic, shardPrefixes)
+	}
 	wait := NewWait(len(shardPrefixes))
 	var messages []client.Message
 	for shardT, prefixesT := range shardPrefixes {
@@ -94,7 +131,10 @@ func GetByPrefixes(ctx context.Context, topic string, shardPrefixes map[uint32][
 	if len(wait.Errs) > 0 {
 		return nil, fmt.Errorf("error getting prefix messages; %w", jerr.Combine(wait.Errs...))
 	}
-	return messages, nil
+	if cache != nil {
+		cache.setPrefixes(topic, shardPrefixes, messages, generation)
+	}
+	return append(cachedMessages, messages...), nil
 }
 
 func ListenPrefixes(ctx context.Context, topic string, shardPrefixes map[uint32][][]byte) (chan *client.Message, error) {
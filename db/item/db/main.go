@@ -14,29 +14,39 @@ import (
 )
 
 const (
-	TopicFoundPeer      = "found_peer"
-	TopicMessage        = "message"
-	TopicPeer           = "peer"
-	TopicPeerConnection = "peer_connection"
-	TopicPeerFound      = "peer_found"
-	TopicProcessError   = "process_error"
-	TopicProcessStatus  = "process_status"
-	TopicSyncStatus     = "sync_status"
+	TopicAdminEdit       = "admin_edit"
+	TopicBroadcastResult = "broadcast_result"
+	TopicFoundPeer       = "found_peer"
+	TopicMessage         = "message"
+	TopicPeer            = "peer"
+	TopicPeerConnection  = "peer_connection"
+	TopicPeerFound       = "peer_found"
+	TopicPeerInfo        = "peer_info"
+	TopicPersistedQuery  = "persisted_query"
+	TopicProcessError    = "process_error"
+	TopicProcessStatus   = "process_status"
+	TopicSyncProgress    = "sync_progress"
+	TopicSyncStatus      = "sync_status"
 
 	TopicMemoAddrFollow     = "memo_addr_follow"
 	TopicMemoAddrFollowed   = "memo_addr_followed"
+	TopicMemoAddrImageBase  = "memo_addr_image_base"
 	TopicMemoAddrLike       = "memo_addr_like"
 	TopicMemoAddrName       = "memo_addr_name"
 	TopicMemoAddrPost       = "memo_addr_post"
 	TopicMemoAddrProfile    = "memo_addr_profile"
 	TopicMemoAddrProfilePic = "memo_addr_profile_pic"
+	TopicMemoAddrRepost     = "memo_addr_repost"
 	TopicMemoAddrRoomFollow = "memo_addr_room_follow"
 	TopicMemoLikeTip        = "memo_like_tip"
 	TopicMemoPost           = "memo_post"
 	TopicMemoPostChild      = "memo_post_child"
 	TopicMemoPostLike       = "memo_post_like"
 	TopicMemoPostParent     = "memo_post_parent"
+	TopicMemoPostPicture    = "memo_post_picture"
+	TopicMemoPostRepost     = "memo_post_repost"
 	TopicMemoPostRoom       = "memo_post_room"
+	TopicMemoRepost         = "memo_repost"
 	TopicMemoRoomFollow     = "memo_room_follow"
 	TopicMemoRoomPost       = "memo_room_post"
 	TopicMemoSeenPost       = "memo_seen_post"
@@ -47,6 +57,7 @@ const (
 	TopicChainHeightDuplicate = "chain_height_duplicate"
 	TopicChainBlockInfo       = "chain_block_info"
 	TopicChainBlockTx         = "chain_block_tx"
+	TopicChainBlockWork       = "chain_block_work"
 	TopicChainOutputInput     = "chain_output_input"
 	TopicChainTx              = "chain_tx"
 	TopicChainTxBlock         = "chain_tx_block"
@@ -149,6 +160,13 @@ func Save(objects []Object) error {
 		}(shardT, messagesT)
 	}
 	wg.Wait()
+	for _, messages := range shardMessages {
+		for _, message := range messages {
+			if cache := getCache(message.Topic); cache != nil {
+				cache.removeStale(message.Topic, message.Uid)
+			}
+		}
+	}
 	if len(errs) > 0 {
 		return fmt.Errorf("error saving messages; %w", errors.Join(errs...))
 	}
@@ -11,13 +11,18 @@ import (
 
 func GetTopics() []db.Object {
 	return db.CombineObjects([]db.Object{
+		&AdminEdit{},
+		&BroadcastResult{},
 		&FoundPeer{},
 		&Message{},
 		&Peer{},
 		&PeerConnection{},
 		&PeerFound{},
+		&PeerInfo{},
+		&PersistedQuery{},
 		&ProcessError{},
 		&ProcessStatus{},
+		&SyncProgress{},
 	},
 		addr.GetTopics(),
 		chain.GetTopics(),
@@ -0,0 +1,104 @@
+package memo
+
+import (
+	"context"
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/config"
+	"time"
+)
+
+// AddrImageBase is an image base url set by an address, pictures attached to posts are relative to it.
+type AddrImageBase struct {
+	Addr    [25]byte
+	Seen    time.Time
+	TxHash  [32]byte
+	BaseUrl string
+}
+
+func (b *AddrImageBase) GetTopic() string {
+	return db.TopicMemoAddrImageBase
+}
+
+func (b *AddrImageBase) GetShardSource() uint {
+	return client.GenShardSource(b.Addr[:])
+}
+
+func (b *AddrImageBase) GetUid() []byte {
+	return jutil.CombineBytes(
+		b.Addr[:],
+		jutil.GetTimeByteNanoBig(b.Seen),
+		jutil.ByteReverse(b.TxHash[:]),
+	)
+}
+
+func (b *AddrImageBase) SetUid(uid []byte) {
+	if len(uid) != memo.AddressLength+memo.Int8Size+memo.TxHashLength {
+		return
+	}
+	copy(b.Addr[:], uid[:25])
+	b.Seen = jutil.GetByteTimeNanoBig(uid[25:33])
+	copy(b.TxHash[:], jutil.ByteReverse(uid[33:65]))
+}
+
+func (b *AddrImageBase) Serialize() []byte {
+	return []byte(b.BaseUrl)
+}
+
+func (b *AddrImageBase) Deserialize(data []byte) {
+	b.BaseUrl = string(data)
+}
+
+// GetAddrImageBases returns the newest image base url for each address.
+func GetAddrImageBases(ctx context.Context, addrs [][25]byte) ([]*AddrImageBase, error) {
+	var shardPrefixes = make(map[uint32][][]byte)
+	for i := range addrs {
+		shard := db.GetShardIdFromByte32(addrs[i][:])
+		shardPrefixes[shard] = append(shardPrefixes[shard], addrs[i][:])
+	}
+	shardConfigs := config.GetQueueShards()
+	var addrImageBases []*AddrImageBase
+	for shard, prefixes := range shardPrefixes {
+		shardConfig := config.GetShardConfig(shard, shardConfigs)
+		dbClient := client.NewClient(shardConfig.GetHost())
+		if err := dbClient.GetWOpts(client.Opts{
+			Topic:    db.TopicMemoAddrImageBase,
+			Prefixes: prefixes,
+			Max:      1,
+			Newest:   true,
+			Context:  ctx,
+		}); err != nil {
+			return nil, fmt.Errorf("error getting db addr memo image bases by prefix; %w", err)
+		}
+		for _, msg := range dbClient.Messages {
+			var addrImageBase = new(AddrImageBase)
+			db.Set(addrImageBase, msg)
+			addrImageBases = append(addrImageBases, addrImageBase)
+		}
+	}
+	return addrImageBases, nil
+}
+
+// GetAddrImageBaseAt returns the image base url an address had set at a point in time, nil if none was set yet.
+func GetAddrImageBaseAt(ctx context.Context, addr [25]byte, seen time.Time) (*AddrImageBase, error) {
+	dbClient := client.NewClient(config.GetShardConfig(client.GenShardSource32(addr[:]), config.GetQueueShards()).GetHost())
+	if err := dbClient.GetWOpts(client.Opts{
+		Topic:    db.TopicMemoAddrImageBase,
+		Prefixes: [][]byte{addr[:]},
+		Start:    jutil.CombineBytes(addr[:], jutil.GetTimeByteNanoBig(seen.Add(time.Nanosecond))),
+		Max:      1,
+		Newest:   true,
+		Context:  ctx,
+	}); err != nil {
+		return nil, fmt.Errorf("error getting db addr memo image base at time; %w", err)
+	}
+	if len(dbClient.Messages) == 0 {
+		return nil, nil
+	}
+	var addrImageBase = new(AddrImageBase)
+	db.Set(addrImageBase, dbClient.Messages[0])
+	return addrImageBase, nil
+}
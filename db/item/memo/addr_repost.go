@@ -0,0 +1,82 @@
+package memo
+
+import (
+	"context"
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/config"
+	"time"
+)
+
+type AddrRepost struct {
+	Addr         [25]byte
+	Seen         time.Time
+	RepostTxHash [32]byte
+	PostTxHash   [32]byte
+}
+
+func (r *AddrRepost) GetTopic() string {
+	return db.TopicMemoAddrRepost
+}
+
+func (r *AddrRepost) GetShardSource() uint {
+	return client.GenShardSource(r.Addr[:])
+}
+
+func (r *AddrRepost) GetUid() []byte {
+	return jutil.CombineBytes(
+		r.Addr[:],
+		jutil.GetTimeByteNanoBig(r.Seen),
+		jutil.ByteReverse(r.RepostTxHash[:]),
+	)
+}
+
+func (r *AddrRepost) SetUid(uid []byte) {
+	if len(uid) != memo.AddressLength+memo.Int8Size+memo.TxHashLength {
+		return
+	}
+	copy(r.Addr[:], uid[:25])
+	r.Seen = jutil.GetByteTimeNanoBig(uid[25:33])
+	copy(r.RepostTxHash[:], jutil.ByteReverse(uid[33:65]))
+}
+
+func (r *AddrRepost) Serialize() []byte {
+	return jutil.ByteReverse(r.PostTxHash[:])
+}
+
+func (r *AddrRepost) Deserialize(data []byte) {
+	if len(data) != memo.TxHashLength {
+		return
+	}
+	copy(r.PostTxHash[:], jutil.ByteReverse(data))
+}
+
+func GetSingleAddrReposts(ctx context.Context, addr [25]byte, newest bool, start time.Time) ([]*AddrRepost, error) {
+	var startByte []byte
+	if !jutil.IsTimeZero(start) {
+		startByte = jutil.CombineBytes(addr[:], jutil.GetTimeByteNanoBig(start))
+	} else {
+		startByte = addr[:]
+	}
+	dbClient := client.NewClient(config.GetShardConfig(client.GenShardSource32(addr[:]), config.GetQueueShards()).GetHost())
+	if err := dbClient.GetWOpts(client.Opts{
+		Topic:    db.TopicMemoAddrRepost,
+		Prefixes: [][]byte{addr[:]},
+		Max:      client.ExLargeLimit,
+		Start:    startByte,
+		Newest:   newest,
+		Context:  ctx,
+	}); err != nil {
+		return nil, fmt.Errorf("error getting db addr memo reposts by prefix; %w", err)
+	}
+	var addrReposts []*AddrRepost
+	for _, msg := range dbClient.Messages {
+		var addrRepost = new(AddrRepost)
+		db.Set(addrRepost, msg)
+		addrReposts = append(addrReposts, addrRepost)
+	}
+	return addrReposts, nil
+}
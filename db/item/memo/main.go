@@ -8,16 +8,21 @@ func GetTopics() []db.Object {
 		&LikeTip{},
 		&AddrFollow{},
 		&AddrFollowed{},
+		&AddrImageBase{},
 		&AddrLike{},
 		&AddrName{},
 		&AddrPost{},
 		&AddrProfile{},
 		&AddrProfilePic{},
+		&AddrRepost{},
 		&AddrRoomFollow{},
 		&Post{},
 		&PostChild{},
 		&PostParent{},
+		&PostPicture{},
+		&PostRepost{},
 		&PostRoom{},
+		&Repost{},
 		&RoomFollow{},
 		&RoomPost{},
 	}
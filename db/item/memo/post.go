@@ -67,22 +67,14 @@ func GetPosts(ctx context.Context, txHashes [][32]byte) ([]*Post, error) {
 		shard := db.GetShardIdFromByte32(txHashes[i][:])
 		shardUids[shard] = append(shardUids[shard], jutil.ByteReverse(txHashes[i][:]))
 	}
-	var posts []*Post
-	for shard, uids := range shardUids {
-		shardConfig := config.GetShardConfig(shard, config.GetQueueShards())
-		dbClient := client.NewClient(shardConfig.GetHost())
-		if err := dbClient.GetWOpts(client.Opts{
-			Context: ctx,
-			Topic:   db.TopicMemoPost,
-			Uids:    uids,
-		}); err != nil {
-			return nil, fmt.Errorf("error getting client message memo posts; %w", err)
-		}
-		for _, msg := range dbClient.Messages {
-			var post = new(Post)
-			db.Set(post, msg)
-			posts = append(posts, post)
-		}
+	messages, err := db.GetSpecific(ctx, db.TopicMemoPost, shardUids)
+	if err != nil {
+		return nil, fmt.Errorf("error getting client message memo posts; %w", err)
+	}
+	var posts = make([]*Post, len(messages))
+	for i := range messages {
+		posts[i] = new(Post)
+		db.Set(posts[i], messages[i])
 	}
 	return posts, nil
 }
@@ -101,3 +101,26 @@ func ListenPostChildren(ctx context.Context, postTxHashes [][32]byte) (chan *Pos
 	}
 	return postChildChan, nil
 }
+
+func ListenAllPostChildren(ctx context.Context) (chan *PostChild, error) {
+	var postChildChan = make(chan *PostChild)
+	cancelCtx := db.NewCancelContext(ctx, func() {
+		close(postChildChan)
+	})
+	for _, shardConfig := range config.GetQueueShards() {
+		dbClient := client.NewClient(shardConfig.GetHost())
+		chanMessage, err := dbClient.Listen(cancelCtx.Context, db.TopicMemoPostChild, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error listening to db memo post children (all); %w", err)
+		}
+		go func() {
+			for msg := range chanMessage {
+				var postChild = new(PostChild)
+				db.Set(postChild, *msg)
+				postChildChan <- postChild
+			}
+			cancelCtx.Cancel()
+		}()
+	}
+	return postChildChan, nil
+}
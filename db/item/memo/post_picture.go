@@ -0,0 +1,83 @@
+package memo
+
+import (
+	"context"
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/config"
+	"time"
+)
+
+type PostPicture struct {
+	PostTxHash [32]byte
+	Seen       time.Time
+	TxHash     [32]byte
+	Addr       [25]byte
+	Picture    string
+}
+
+func (p *PostPicture) GetTopic() string {
+	return db.TopicMemoPostPicture
+}
+
+func (p *PostPicture) GetShardSource() uint {
+	return client.GenShardSource(p.PostTxHash[:])
+}
+
+func (p *PostPicture) GetUid() []byte {
+	return jutil.CombineBytes(
+		jutil.ByteReverse(p.PostTxHash[:]),
+		jutil.GetTimeByteNanoBig(p.Seen),
+		jutil.ByteReverse(p.TxHash[:]),
+	)
+}
+
+func (p *PostPicture) SetUid(uid []byte) {
+	if len(uid) != memo.TxHashLength*2+memo.Int8Size {
+		return
+	}
+	copy(p.PostTxHash[:], jutil.ByteReverse(uid[:32]))
+	p.Seen = jutil.GetByteTimeNanoBig(uid[32:40])
+	copy(p.TxHash[:], jutil.ByteReverse(uid[40:72]))
+}
+
+func (p *PostPicture) Serialize() []byte {
+	return jutil.CombineBytes(p.Addr[:], []byte(p.Picture))
+}
+
+func (p *PostPicture) Deserialize(data []byte) {
+	if len(data) < memo.AddressLength {
+		return
+	}
+	copy(p.Addr[:], data[:25])
+	p.Picture = string(data[25:])
+}
+
+func GetPostPictures(ctx context.Context, postTxHashes [][32]byte) ([]*PostPicture, error) {
+	var shardPrefixes = make(map[uint32][][]byte)
+	for i := range postTxHashes {
+		shard := db.GetShardIdFromByte32(postTxHashes[i][:])
+		shardPrefixes[shard] = append(shardPrefixes[shard], jutil.ByteReverse(postTxHashes[i][:]))
+	}
+	var postPictures []*PostPicture
+	for shard, prefixes := range shardPrefixes {
+		shardConfig := config.GetShardConfig(shard, config.GetQueueShards())
+		dbClient := client.NewClient(shardConfig.GetHost())
+		if err := dbClient.GetWOpts(client.Opts{
+			Context:  ctx,
+			Topic:    db.TopicMemoPostPicture,
+			Prefixes: prefixes,
+		}); err != nil {
+			return nil, fmt.Errorf("error getting client message memo post pictures; %w", err)
+		}
+		for _, msg := range dbClient.Messages {
+			var postPicture = new(PostPicture)
+			db.Set(postPicture, msg)
+			postPictures = append(postPictures, postPicture)
+		}
+	}
+	return postPictures, nil
+}
@@ -0,0 +1,71 @@
+package memo
+
+import (
+	"context"
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/config"
+)
+
+type PostRepost struct {
+	PostTxHash   [32]byte
+	RepostTxHash [32]byte
+}
+
+func (r *PostRepost) GetTopic() string {
+	return db.TopicMemoPostRepost
+}
+
+func (r *PostRepost) GetShardSource() uint {
+	return client.GenShardSource(r.PostTxHash[:])
+}
+
+func (r *PostRepost) GetUid() []byte {
+	return jutil.CombineBytes(
+		jutil.ByteReverse(r.PostTxHash[:]),
+		jutil.ByteReverse(r.RepostTxHash[:]),
+	)
+}
+
+func (r *PostRepost) SetUid(uid []byte) {
+	if len(uid) != memo.TxHashLength*2 {
+		return
+	}
+	copy(r.PostTxHash[:], jutil.ByteReverse(uid[:32]))
+	copy(r.RepostTxHash[:], jutil.ByteReverse(uid[32:]))
+}
+
+func (r *PostRepost) Serialize() []byte {
+	return nil
+}
+
+func (r *PostRepost) Deserialize([]byte) {}
+
+func GetPostReposts(ctx context.Context, postTxHashes [][32]byte) ([]*PostRepost, error) {
+	var shardPrefixes = make(map[uint32][][]byte)
+	for i := range postTxHashes {
+		shard := db.GetShardIdFromByte32(postTxHashes[i][:])
+		shardPrefixes[shard] = append(shardPrefixes[shard], jutil.ByteReverse(postTxHashes[i][:]))
+	}
+	var postReposts []*PostRepost
+	for shard, prefixes := range shardPrefixes {
+		shardConfig := config.GetShardConfig(shard, config.GetQueueShards())
+		dbClient := client.NewClient(shardConfig.GetHost())
+		if err := dbClient.GetWOpts(client.Opts{
+			Context:  ctx,
+			Topic:    db.TopicMemoPostRepost,
+			Prefixes: prefixes,
+		}); err != nil {
+			return nil, fmt.Errorf("error getting client message memo post reposts; %w", err)
+		}
+		for _, msg := range dbClient.Messages {
+			var postRepost = new(PostRepost)
+			db.Set(postRepost, msg)
+			postReposts = append(postReposts, postRepost)
+		}
+	}
+	return postReposts, nil
+}
@@ -0,0 +1,72 @@
+package memo
+
+import (
+	"context"
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/config"
+)
+
+type Repost struct {
+	RepostTxHash [32]byte
+	PostTxHash   [32]byte
+}
+
+func (r *Repost) GetTopic() string {
+	return db.TopicMemoRepost
+}
+
+func (r *Repost) GetShardSource() uint {
+	return client.GenShardSource(r.RepostTxHash[:])
+}
+
+func (r *Repost) GetUid() []byte {
+	return jutil.ByteReverse(r.RepostTxHash[:])
+}
+
+func (r *Repost) SetUid(uid []byte) {
+	if len(uid) != memo.TxHashLength {
+		return
+	}
+	copy(r.RepostTxHash[:], jutil.ByteReverse(uid))
+}
+
+func (r *Repost) Serialize() []byte {
+	return jutil.ByteReverse(r.PostTxHash[:])
+}
+
+func (r *Repost) Deserialize(data []byte) {
+	if len(data) != memo.TxHashLength {
+		return
+	}
+	copy(r.PostTxHash[:], jutil.ByteReverse(data))
+}
+
+func GetReposts(ctx context.Context, repostTxHashes [][32]byte) ([]*Repost, error) {
+	var shardUids = make(map[uint32][][]byte)
+	for i := range repostTxHashes {
+		shard := db.GetShardIdFromByte32(repostTxHashes[i][:])
+		shardUids[shard] = append(shardUids[shard], jutil.ByteReverse(repostTxHashes[i][:]))
+	}
+	var reposts []*Repost
+	for shard, uids := range shardUids {
+		shardConfig := config.GetShardConfig(shard, config.GetQueueShards())
+		dbClient := client.NewClient(shardConfig.GetHost())
+		if err := dbClient.GetWOpts(client.Opts{
+			Context: ctx,
+			Topic:   db.TopicMemoRepost,
+			Uids:    uids,
+		}); err != nil {
+			return nil, fmt.Errorf("error getting client message memo reposts; %w", err)
+		}
+		for _, msg := range dbClient.Messages {
+			var repost = new(Repost)
+			db.Set(repost, msg)
+			reposts = append(reposts, repost)
+		}
+	}
+	return reposts, nil
+}
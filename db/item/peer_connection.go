@@ -17,6 +17,8 @@ func (s PeerConnectionStatus) String() string {
 		return "fail"
 	case PeerConnectionStatusSuccess:
 		return "success"
+	case PeerConnectionStatusStall:
+		return "stall"
 	default:
 		return "unknown"
 	}
@@ -25,6 +27,7 @@ func (s PeerConnectionStatus) String() string {
 const (
 	PeerConnectionStatusFail    PeerConnectionStatus = 0
 	PeerConnectionStatusSuccess PeerConnectionStatus = 1
+	PeerConnectionStatusStall   PeerConnectionStatus = 2
 )
 
 type PeerConnection struct {
@@ -162,6 +165,29 @@ func GetPeerConnectionLasts(ipPorts []IpPort) ([]*PeerConnection, error) {
 	return peerConnections, nil
 }
 
+// GetPeerConnectionsRecent returns the most recent connection results for a peer, newest first.
+func GetPeerConnectionsRecent(ip []byte, port uint16, max uint32) ([]*PeerConnection, error) {
+	shardConfig := config.GetShardConfig(client.GenShardSource32(ip), config.GetQueueShards())
+	dbClient := client.NewClient(shardConfig.GetHost())
+	if err := dbClient.GetWOpts(client.Opts{
+		Topic: db.TopicPeerConnection,
+		Prefixes: [][]byte{jutil.CombineBytes(
+			jutil.BytePadPrefix(ip, IpBytePadSize),
+			jutil.GetUintData(uint(port)),
+		)},
+		Max:    max,
+		Newest: true,
+	}); err != nil {
+		return nil, fmt.Errorf("error getting recent peer connections from queue client; %w", err)
+	}
+	var peerConnections = make([]*PeerConnection, len(dbClient.Messages))
+	for i := range dbClient.Messages {
+		peerConnections[i] = new(PeerConnection)
+		db.Set(peerConnections[i], dbClient.Messages[i])
+	}
+	return peerConnections, nil
+}
+
 func GetCountPeerConnections() (uint64, error) {
 	var totalCount uint64
 	for _, shardConfig := range config.GetQueueShards() {
@@ -0,0 +1,79 @@
+package item
+
+import (
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/ref/config"
+	"time"
+)
+
+// PeerInfo is the version a peer reported on its most recent successful connection.
+type PeerInfo struct {
+	Ip              []byte
+	Port            uint16
+	UserAgent       string
+	ProtocolVersion int32
+	Services        uint64
+	Height          int32
+	Time            time.Time
+}
+
+func (p *PeerInfo) GetUid() []byte {
+	return jutil.CombineBytes(
+		jutil.BytePadPrefix(p.Ip, IpBytePadSize),
+		jutil.GetUintData(uint(p.Port)),
+	)
+}
+
+func (p *PeerInfo) GetShardSource() uint {
+	return client.GenShardSource(p.Ip)
+}
+
+func (p *PeerInfo) GetTopic() string {
+	return db.TopicPeerInfo
+}
+
+func (p *PeerInfo) Serialize() []byte {
+	return jutil.CombineBytes(
+		jutil.GetInt32Data(p.ProtocolVersion),
+		jutil.GetUint64Data(p.Services),
+		jutil.GetInt32Data(p.Height),
+		jutil.GetTimeByteNanoBig(p.Time),
+		[]byte(p.UserAgent),
+	)
+}
+
+func (p *PeerInfo) SetUid(uid []byte) {
+	if len(uid) != IpBytePadSize+4 {
+		return
+	}
+	p.Ip = jutil.ByteUnPad(uid[:IpBytePadSize])
+	p.Port = uint16(jutil.GetUint(uid[IpBytePadSize:]))
+}
+
+func (p *PeerInfo) Deserialize(data []byte) {
+	if len(data) < 24 {
+		return
+	}
+	p.ProtocolVersion = jutil.GetInt32(data[:4])
+	p.Services = jutil.GetUint64(data[4:12])
+	p.Height = jutil.GetInt32(data[12:16])
+	p.Time = jutil.GetByteTimeNanoBig(data[16:24])
+	p.UserAgent = string(data[24:])
+}
+
+func GetPeerInfos(shard uint32, startId []byte) ([]*PeerInfo, error) {
+	shardConfig := config.GetShardConfig(shard, config.GetQueueShards())
+	dbClient := client.NewClient(shardConfig.GetHost())
+	if err := dbClient.GetLarge(db.TopicPeerInfo, startId, false, false); err != nil {
+		return nil, fmt.Errorf("error getting peer infos from queue client; %w", err)
+	}
+	var peerInfos = make([]*PeerInfo, len(dbClient.Messages))
+	for i := range dbClient.Messages {
+		peerInfos[i] = new(PeerInfo)
+		db.Set(peerInfos[i], dbClient.Messages[i])
+	}
+	return peerInfos, nil
+}
@@ -0,0 +1,119 @@
+package item
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/ref/config"
+)
+
+type PersistedQuery struct {
+	Name  string
+	Hash  [32]byte
+	Query string
+}
+
+func (q *PersistedQuery) GetTopic() string {
+	return db.TopicPersistedQuery
+}
+
+func (q *PersistedQuery) GetShardSource() uint {
+	return client.GenShardSource([]byte(q.Name))
+}
+
+func (q *PersistedQuery) GetUid() []byte {
+	return []byte(q.Name)
+}
+
+func (q *PersistedQuery) SetUid(uid []byte) {
+	q.Name = string(uid)
+}
+
+func (q *PersistedQuery) Serialize() []byte {
+	return jutil.CombineBytes(
+		q.Hash[:],
+		[]byte(q.Query),
+	)
+}
+
+func (q *PersistedQuery) Deserialize(data []byte) {
+	if len(data) < 32 {
+		return
+	}
+	copy(q.Hash[:], data[:32])
+	q.Query = string(data[32:])
+}
+
+// HashString matches the sha256Hash format used by automatic persisted query clients.
+func (q *PersistedQuery) HashString() string {
+	return hex.EncodeToString(q.Hash[:])
+}
+
+func NewPersistedQuery(name, query string) *PersistedQuery {
+	return &PersistedQuery{
+		Name:  name,
+		Hash:  sha256.Sum256([]byte(query)),
+		Query: query,
+	}
+}
+
+func GetPersistedQuery(name string) (*PersistedQuery, error) {
+	var persistedQuery = &PersistedQuery{Name: name}
+	if err := db.GetItem(persistedQuery); err != nil {
+		return nil, fmt.Errorf("error getting persisted query; %w", err)
+	}
+	return persistedQuery, nil
+}
+
+func GetPersistedQueries(ctx context.Context) ([]*PersistedQuery, error) {
+	var persistedQueries []*PersistedQuery
+	for _, shardConfig := range config.GetQueueShards() {
+		dbClient := client.NewClient(shardConfig.GetHost())
+		var start []byte
+		for {
+			if err := dbClient.GetWOpts(client.Opts{
+				Context: ctx,
+				Topic:   db.TopicPersistedQuery,
+				Start:   start,
+				Max:     client.LargeLimit,
+			}); err != nil {
+				return nil, fmt.Errorf("error getting persisted queries for shard: %d; %w", shardConfig.Shard, err)
+			}
+			for _, msg := range dbClient.Messages {
+				var persistedQuery = new(PersistedQuery)
+				db.Set(persistedQuery, msg)
+				persistedQueries = append(persistedQueries, persistedQuery)
+			}
+			if len(dbClient.Messages) < client.LargeLimit {
+				break
+			}
+			start = jutil.CombineBytes(dbClient.Messages[len(dbClient.Messages)-1].Uid, []byte{0x0})
+		}
+	}
+	return persistedQueries, nil
+}
+
+func ListenPersistedQueries(ctx context.Context) (chan *PersistedQuery, error) {
+	var shardPrefixes = make(map[uint32][][]byte)
+	for _, shardConfig := range config.GetQueueShards() {
+		shardPrefixes[shardConfig.Shard] = nil
+	}
+	msgChan, err := db.ListenPrefixes(ctx, db.TopicPersistedQuery, shardPrefixes)
+	if err != nil {
+		return nil, fmt.Errorf("error listening to db persisted queries; %w", err)
+	}
+	var persistedQueryChan = make(chan *PersistedQuery)
+	go func() {
+		defer close(persistedQueryChan)
+		for msg := range msgChan {
+			var persistedQuery = new(PersistedQuery)
+			db.Set(persistedQuery, *msg)
+			persistedQueryChan <- persistedQuery
+		}
+	}()
+	return persistedQueryChan, nil
+}
@@ -11,6 +11,7 @@ const (
 	ProcessStatusPopulateP2sh       = "populate-p2sh"
 	ProcessStatusPopulateAddr       = "populate-addr"
 	ProcessStatusPopulateAddrInputs = "populate-addr-inputs"
+	ProcessStatusReindex            = "reindex"
 )
 
 type ProcessStatus struct {
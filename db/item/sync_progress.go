@@ -0,0 +1,89 @@
+package item
+
+import (
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/db"
+	"time"
+)
+
+const (
+	SyncProgressBlock = "block"
+)
+
+// SyncProgress is the state of a running initial sync, saved periodically by the lead processor.
+type SyncProgress struct {
+	Name        string
+	StartHeight int64
+	Height      int64
+	Target      int64
+	InFlight    int
+	Started     time.Time
+	Updated     time.Time
+}
+
+func (s *SyncProgress) GetUid() []byte {
+	return []byte(s.Name)
+}
+
+func (s *SyncProgress) GetShardSource() uint {
+	return client.GenShardSource([]byte(s.Name))
+}
+
+func (s *SyncProgress) GetTopic() string {
+	return db.TopicSyncProgress
+}
+
+func (s *SyncProgress) Serialize() []byte {
+	return jutil.CombineBytes(
+		jutil.GetInt64DataBig(s.StartHeight),
+		jutil.GetInt64DataBig(s.Height),
+		jutil.GetInt64DataBig(s.Target),
+		jutil.GetUintData(uint(s.InFlight)),
+		jutil.GetTimeByteNanoBig(s.Started),
+		jutil.GetTimeByteNanoBig(s.Updated),
+	)
+}
+
+func (s *SyncProgress) SetUid(uid []byte) {
+	s.Name = string(uid)
+}
+
+func (s *SyncProgress) Deserialize(data []byte) {
+	if len(data) != 44 {
+		return
+	}
+	s.StartHeight = jutil.GetInt64Big(data[:8])
+	s.Height = jutil.GetInt64Big(data[8:16])
+	s.Target = jutil.GetInt64Big(data[16:24])
+	s.InFlight = int(jutil.GetUint(data[24:28]))
+	s.Started = jutil.GetByteTimeNanoBig(data[28:36])
+	s.Updated = jutil.GetByteTimeNanoBig(data[36:44])
+}
+
+// GetRate returns the average blocks saved per second since the sync started.
+func (s *SyncProgress) GetRate() float64 {
+	duration := s.Updated.Sub(s.Started)
+	if duration <= 0 {
+		return 0
+	}
+	return float64(s.Height-s.StartHeight) / duration.Seconds()
+}
+
+// GetEta returns the estimated time remaining to reach the target height at the average rate, 0 if unknown.
+func (s *SyncProgress) GetEta() time.Duration {
+	rate := s.GetRate()
+	if rate <= 0 || s.Target <= s.Height {
+		return 0
+	}
+	return time.Duration(float64(s.Target-s.Height) / rate * float64(time.Second))
+}
+
+func GetSyncProgress(name string) (*SyncProgress, error) {
+	var syncProgress = &SyncProgress{Name: name}
+	if err := db.GetItem(syncProgress); err != nil {
+		return nil, fmt.Errorf("error getting item sync progress; %w", err)
+	}
+	return syncProgress, nil
+}
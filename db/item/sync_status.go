@@ -9,6 +9,7 @@ import (
 
 const (
 	SyncStatusComplete = "complete"
+	SyncStatusImport   = "import"
 )
 
 type SyncStatus struct {
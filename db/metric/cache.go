@@ -0,0 +1,32 @@
+package metric
+
+type Cache struct {
+	Hits         int
+	Misses       int
+	NegativeHits int
+	Evictions    int
+	Items        int
+	Size         int
+}
+
+func (c Cache) GetFields() map[string]interface{} {
+	return map[string]interface{}{
+		FieldHits:         c.Hits,
+		FieldMisses:       c.Misses,
+		FieldNegativeHits: c.NegativeHits,
+		FieldEvictions:    c.Evictions,
+		FieldItems:        c.Items,
+		FieldSize:         c.Size,
+	}
+}
+
+func AddCache(request Cache) {
+	writer := getInfluxWriter()
+	if writer == nil {
+		return
+	}
+	writer.Write(Point{
+		Measurement: NameCache,
+		Fields:      request.GetFields(),
+	})
+}
@@ -1,16 +1,18 @@
 package metric
 
 const (
-	EndPointAddress     = "address"
-	EndPointAddresses   = "addresses"
-	EndPointBlock       = "block"
-	EndPointBlocks      = "blocks"
-	EndPointBlockNewest = "block_newest"
-	EndPointPosts       = "posts"
-	EndPointPostsNewest = "posts_newest"
-	EndPointProfiles    = "profiles"
-	EndPointRoom        = "room"
-	EndPointTx          = "tx"
+	EndPointAddress         = "address"
+	EndPointAddresses       = "addresses"
+	EndPointBlock           = "block"
+	EndPointBroadcastResult = "broadcast_result"
+	EndPointBlocks          = "blocks"
+	EndPointBlockNewest     = "block_newest"
+	EndPointPosts           = "posts"
+	EndPointPostsNewest     = "posts_newest"
+	EndPointProfiles        = "profiles"
+	EndPointRoom            = "room"
+	EndPointThread          = "thread"
+	EndPointTx              = "tx"
 )
 
 func AddGraphQuery(endpoint string) {
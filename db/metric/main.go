@@ -14,11 +14,25 @@ const (
 	NameGraphQuery  = "graph_query"
 	NameTopicListen = "topic_listen"
 	NameListenCount = "listen_count"
+	NameCache       = "cache"
+	NameSync        = "sync"
 )
 
 const (
 	FieldQuantity = "quantity"
 
+	FieldHits         = "hits"
+	FieldMisses       = "misses"
+	FieldNegativeHits = "negative_hits"
+	FieldEvictions    = "evictions"
+	FieldItems        = "items"
+	FieldSize         = "size"
+
+	FieldHeight   = "height"
+	FieldTarget   = "target"
+	FieldInFlight = "in_flight"
+	FieldRate     = "rate"
+
 	TagTopic    = "topic"
 	TagSource   = "source"
 	TagEndpoint = "endpoint"
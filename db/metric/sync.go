@@ -0,0 +1,28 @@
+package metric
+
+type Sync struct {
+	Height   int64
+	Target   int64
+	InFlight int
+	Rate     float64
+}
+
+func (s Sync) GetFields() map[string]interface{} {
+	return map[string]interface{}{
+		FieldHeight:   s.Height,
+		FieldTarget:   s.Target,
+		FieldInFlight: s.InFlight,
+		FieldRate:     s.Rate,
+	}
+}
+
+func AddSync(request Sync) {
+	writer := getInfluxWriter()
+	if writer == nil {
+		return
+	}
+	writer.Write(Point{
+		Measurement: NameSync,
+		Fields:      request.GetFields(),
+	})
+}
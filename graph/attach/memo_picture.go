@@ -0,0 +1,80 @@
+package attach
+
+import (
+	"context"
+	"fmt"
+	"github.com/memocash/index/graph/model"
+	"strings"
+)
+
+type MemoPicture struct {
+	base
+	Pictures []*model.Picture
+}
+
+func ToMemoPictures(ctx context.Context, fields []Field, pictures []*model.Picture) error {
+	if len(pictures) == 0 {
+		return nil
+	}
+	o := MemoPicture{
+		base:     base{Ctx: ctx, Fields: fields},
+		Pictures: pictures,
+	}
+	o.Wait.Add(2)
+	go o.AttachLocks()
+	go o.AttachTxs()
+	o.Wait.Wait()
+	if len(o.Errors) > 0 {
+		return fmt.Errorf("error attaching to memo pictures; %w", o.Errors[0])
+	}
+	return nil
+}
+
+// GetPictureUrl resolves an attached picture against an image base url, pictures that are already absolute urls
+// are returned as is and nil is returned if there is no base url to resolve against.
+func GetPictureUrl(baseUrl, picture string) *string {
+	if strings.HasPrefix(picture, "http://") || strings.HasPrefix(picture, "https://") {
+		return &picture
+	}
+	if baseUrl == "" {
+		return nil
+	}
+	url := strings.TrimRight(baseUrl, "/") + "/" + strings.TrimLeft(picture, "/")
+	return &url
+}
+
+func (a *MemoPicture) AttachLocks() {
+	defer a.Wait.Done()
+	var allLocks []*model.Lock
+	if !a.HasField([]string{"lock"}) {
+		return
+	}
+	a.Mutex.Lock()
+	for _, picture := range a.Pictures {
+		picture.Lock = &model.Lock{Address: picture.Address}
+		allLocks = append(allLocks, picture.Lock)
+	}
+	a.Mutex.Unlock()
+	if err := ToLocks(a.Ctx, GetPrefixFields(a.Fields, "lock."), allLocks); err != nil {
+		a.AddError(fmt.Errorf("error attaching to locks for memo pictures; %w", err))
+		return
+	}
+}
+
+func (a *MemoPicture) AttachTxs() {
+	defer a.Wait.Done()
+	if !a.HasField([]string{"tx"}) {
+		return
+	}
+	var allTxs []*model.Tx
+	a.Mutex.Lock()
+	for _, picture := range a.Pictures {
+		picture.Tx = &model.Tx{Hash: picture.TxHash}
+		allTxs = append(allTxs, picture.Tx)
+	}
+	a.Mutex.Unlock()
+	if err := ToTxs(a.Ctx, GetPrefixFields(a.Fields, "tx."), allTxs); err != nil {
+		a.AddError(fmt.Errorf("error attaching to txs for memo pictures; %w", err))
+		return
+	}
+}
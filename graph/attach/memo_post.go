@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"github.com/jchavannes/jgo/jutil"
 	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/chain"
 	"github.com/memocash/index/db/item/memo"
 	"github.com/memocash/index/graph/model"
 	"sync"
+	"time"
 )
 
 type MemoPost struct {
@@ -26,12 +28,15 @@ func ToMemoPosts(ctx context.Context, fields []Field, posts []*model.Post) error
 	}
 	o.DetailsWait.Add(1)
 	go o.AttachInfo()
-	o.Wait.Add(6)
+	o.Wait.Add(9)
 	go o.AttachTxs()
 	go o.AttachParents()
 	go o.AttachLikes()
 	go o.AttachReplies()
 	go o.AttachRooms()
+	go o.AttachRepostOfs()
+	go o.AttachReposts()
+	go o.AttachPictures()
 	o.DetailsWait.Wait()
 	go o.AttachLocks()
 	o.Wait.Wait()
@@ -248,3 +253,141 @@ func (a *MemoPost) AttachRooms() {
 		return
 	}
 }
+
+func (a *MemoPost) AttachRepostOfs() {
+	defer a.Wait.Done()
+	if !a.HasField([]string{"repost_of"}) {
+		return
+	}
+	reposts, err := memo.GetReposts(a.Ctx, a.getTxHashes(false))
+	if err != nil && !client.IsEntryNotFoundError(err) {
+		a.AddError(fmt.Errorf("error getting memo reposts for post attach; %w", err))
+		return
+	}
+	var allPosts []*model.Post
+	a.Mutex.Lock()
+	for _, repost := range reposts {
+		for i := range a.Posts {
+			if a.Posts[i].TxHash == repost.RepostTxHash {
+				a.Posts[i].RepostOf = &model.Post{TxHash: repost.PostTxHash}
+				allPosts = append(allPosts, a.Posts[i].RepostOf)
+			}
+		}
+	}
+	a.Mutex.Unlock()
+	if err := ToMemoPosts(a.Ctx, GetPrefixFields(a.Fields, "repost_of."), allPosts); err != nil {
+		a.AddError(fmt.Errorf("error attaching to repost ofs for memo posts; %w", err))
+		return
+	}
+}
+
+func (a *MemoPost) AttachReposts() {
+	defer a.Wait.Done()
+	if !a.HasField([]string{"reposts", "repost_count"}) {
+		return
+	}
+	postReposts, err := memo.GetPostReposts(a.Ctx, a.getTxHashes(false))
+	if err != nil && !client.IsEntryNotFoundError(err) {
+		a.AddError(fmt.Errorf("error getting memo post reposts for post attach; %w", err))
+		return
+	}
+	var attachReposts = a.HasField([]string{"reposts"})
+	var allReposts []*model.Post
+	a.Mutex.Lock()
+	for _, postRepost := range postReposts {
+		for _, post := range a.Posts {
+			if post.TxHash == postRepost.PostTxHash {
+				post.RepostCount++
+				if !attachReposts {
+					continue
+				}
+				repost := &model.Post{
+					TxHash:   postRepost.RepostTxHash,
+					RepostOf: post,
+				}
+				post.Reposts = append(post.Reposts, repost)
+				allReposts = append(allReposts, repost)
+			}
+		}
+	}
+	a.Mutex.Unlock()
+	if err := ToMemoPosts(a.Ctx, GetPrefixFields(a.Fields, "reposts."), allReposts); err != nil {
+		a.AddError(fmt.Errorf("error attaching to reposts for memo posts; %w", err))
+		return
+	}
+}
+
+// AttachPictures only includes pictures attached by the post author, urls are resolved against the image base url
+// the author had set when the post was seen.
+func (a *MemoPost) AttachPictures() {
+	defer a.Wait.Done()
+	if !a.HasField([]string{"pictures"}) {
+		return
+	}
+	txHashes := a.getTxHashes(false)
+	postPictures, err := memo.GetPostPictures(a.Ctx, txHashes)
+	if err != nil && !client.IsEntryNotFoundError(err) {
+		a.AddError(fmt.Errorf("error getting memo post pictures for post attach; %w", err))
+		return
+	} else if len(postPictures) == 0 {
+		return
+	}
+	memoPosts, err := memo.GetPosts(a.Ctx, txHashes)
+	if err != nil {
+		a.AddError(fmt.Errorf("error getting memo posts for post pictures attach; %w", err))
+		return
+	}
+	txSeens, err := chain.GetTxSeens(a.Ctx, txHashes)
+	if err != nil {
+		a.AddError(fmt.Errorf("error getting tx seens for post pictures attach; %w", err))
+		return
+	}
+	var postAddrs = make(map[[32]byte][25]byte)
+	for _, memoPost := range memoPosts {
+		postAddrs[memoPost.TxHash] = memoPost.Addr
+	}
+	var postSeens = make(map[[32]byte]time.Time)
+	for _, txSeen := range txSeens {
+		if seen, ok := postSeens[txSeen.TxHash]; !ok || txSeen.Timestamp.Before(seen) {
+			postSeens[txSeen.TxHash] = txSeen.Timestamp
+		}
+	}
+	var allPictures []*model.Picture
+	for _, postPicture := range postPictures {
+		if addr, ok := postAddrs[postPicture.PostTxHash]; !ok || addr != postPicture.Addr {
+			continue
+		}
+		seen, ok := postSeens[postPicture.PostTxHash]
+		if !ok {
+			seen = postPicture.Seen
+		}
+		addrImageBase, err := memo.GetAddrImageBaseAt(a.Ctx, postPicture.Addr, seen)
+		if err != nil {
+			a.AddError(fmt.Errorf("error getting addr image base for post pictures attach; %w", err))
+			return
+		}
+		var baseUrl string
+		if addrImageBase != nil {
+			baseUrl = addrImageBase.BaseUrl
+		}
+		picture := &model.Picture{
+			TxHash:     postPicture.TxHash,
+			Address:    postPicture.Addr,
+			PostTxHash: postPicture.PostTxHash,
+			Picture:    postPicture.Picture,
+			Url:        GetPictureUrl(baseUrl, postPicture.Picture),
+		}
+		a.Mutex.Lock()
+		for _, post := range a.Posts {
+			if post.TxHash == postPicture.PostTxHash {
+				post.Pictures = append(post.Pictures, picture)
+			}
+		}
+		a.Mutex.Unlock()
+		allPictures = append(allPictures, picture)
+	}
+	if err := ToMemoPictures(a.Ctx, GetPrefixFields(a.Fields, "pictures."), allPictures); err != nil {
+		a.AddError(fmt.Errorf("error attaching to pictures for memo posts; %w", err))
+		return
+	}
+}
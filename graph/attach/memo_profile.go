@@ -23,15 +23,17 @@ func ToMemoProfiles(ctx context.Context, fields []Field, profiles []*model.Profi
 		base:     base{Ctx: ctx, Fields: fields},
 		Profiles: profiles,
 	}
-	o.Wait.Add(8)
+	o.Wait.Add(10)
 	go o.AttachLocks()
 	go o.AttachPosts()
+	go o.AttachReposts()
 	go o.AttachFollowing()
 	go o.AttachFollowers()
 	go o.AttachRooms()
 	go o.AttachNames()
 	go o.AttachProfiles()
 	go o.AttachPics()
+	go o.AttachImageBaseUrls()
 	o.Wait.Wait()
 	if len(o.Errors) > 0 {
 		return fmt.Errorf("error attaching to memo profiles; %w", o.Errors[0])
@@ -102,6 +104,41 @@ func (a *MemoProfile) AttachPosts() {
 	}
 }
 
+func (a *MemoProfile) AttachReposts() {
+	defer a.Wait.Done()
+	if !a.HasField([]string{"reposts"}) {
+		return
+	}
+	repostsField := a.Fields.GetField("reposts")
+	startDate, _ := model.UnmarshalDate(repostsField.Arguments["start"])
+	newest, _ := graphql.UnmarshalBoolean(repostsField.Arguments["newest"])
+	var allProfileReposts []*model.Post
+	for _, addr := range a.getAddresses() {
+		addrReposts, err := memo.GetSingleAddrReposts(a.Ctx, addr, newest, time.Time(startDate))
+		if err != nil && !client.IsEntryNotFoundError(err) {
+			a.AddError(fmt.Errorf("error getting memo profile reposts for profile attach; %w", err))
+			return
+		}
+		a.Mutex.Lock()
+		for _, profile := range a.Profiles {
+			if profile.Address == addr {
+				for _, addrRepost := range addrReposts {
+					repost := &model.Post{
+						TxHash: addrRepost.RepostTxHash,
+					}
+					profile.Reposts = append(profile.Reposts, repost)
+					allProfileReposts = append(allProfileReposts, repost)
+				}
+			}
+		}
+		a.Mutex.Unlock()
+	}
+	if err := ToMemoPosts(a.Ctx, repostsField.Fields, allProfileReposts); err != nil {
+		a.AddError(fmt.Errorf("error attaching to reposts for memo profiles; %w", err))
+		return
+	}
+}
+
 func (a *MemoProfile) AttachFollowing() {
 	defer a.Wait.Done()
 	if !a.HasField([]string{"following"}) {
@@ -292,3 +329,25 @@ func (a *MemoProfile) AttachPics() {
 		}
 	}
 }
+
+func (a *MemoProfile) AttachImageBaseUrls() {
+	defer a.Wait.Done()
+	if !a.HasField([]string{"image_base_url"}) {
+		return
+	}
+	addrImageBases, err := memo.GetAddrImageBases(a.Ctx, a.getAddresses())
+	if err != nil && !client.IsEntryNotFoundError(err) {
+		a.AddError(fmt.Errorf("error getting addr image bases for profile attach; %w", err))
+		return
+	}
+	a.Mutex.Lock()
+	defer a.Mutex.Unlock()
+	for _, addrImageBase := range addrImageBases {
+		for _, profile := range a.Profiles {
+			if profile.Address == addrImageBase.Addr {
+				baseUrl := addrImageBase.BaseUrl
+				profile.ImageBaseUrl = &baseUrl
+			}
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package attach
+
+import (
+	"context"
+	"fmt"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/chain"
+	"github.com/memocash/index/db/item/memo"
+	"github.com/memocash/index/graph/model"
+	"sort"
+	"time"
+)
+
+const (
+	ThreadMaxDepth = 100
+	ThreadMaxNodes = 2000
+)
+
+var PostMissingError = fmt.Errorf("error post missing")
+
+type MemoThread struct {
+	base
+	Root     [32]byte
+	MaxDepth int
+	Sort     model.ThreadSort
+	Nodes    []*model.ThreadNode
+	nodes    map[[32]byte]*model.ThreadNode
+	children map[[32]byte][][32]byte
+	seens    map[[32]byte]time.Time
+}
+
+// ToMemoThread walks memo_post_child breadth first from the root and returns the tree flattened depth first, with
+// siblings ordered by the requested sort.
+func ToMemoThread(ctx context.Context, fields []Field, root [32]byte, maxDepth int, threadSort model.ThreadSort) (
+	[]*model.ThreadNode, error) {
+	if maxDepth <= 0 || maxDepth > ThreadMaxDepth {
+		maxDepth = ThreadMaxDepth
+	}
+	if !threadSort.IsValid() {
+		threadSort = model.ThreadSortOldest
+	}
+	t := MemoThread{
+		base:     base{Ctx: ctx, Fields: fields},
+		Root:     root,
+		MaxDepth: maxDepth,
+		Sort:     threadSort,
+		nodes:    make(map[[32]byte]*model.ThreadNode),
+		children: make(map[[32]byte][][32]byte),
+		seens:    make(map[[32]byte]time.Time),
+	}
+	rootPost, err := memo.GetPost(ctx, root)
+	if err != nil {
+		return nil, fmt.Errorf("error getting root post for thread; %w", err)
+	} else if rootPost == nil {
+		return nil, fmt.Errorf("error thread root not found: %s; %w", model.Hash(root), PostMissingError)
+	}
+	if err := t.walk(); err != nil {
+		return nil, fmt.Errorf("error walking memo thread; %w", err)
+	}
+	t.Wait.Add(2)
+	go t.AttachLikeCounts()
+	go t.AttachSeens()
+	t.Wait.Wait()
+	if len(t.Errors) > 0 {
+		return nil, fmt.Errorf("error attaching to memo thread; %w", t.Errors[0])
+	}
+	t.flatten(root)
+	if err := t.AttachPosts(); err != nil {
+		return nil, fmt.Errorf("error attaching posts to memo thread; %w", err)
+	}
+	return t.Nodes, nil
+}
+
+func (t *MemoThread) walk() error {
+	t.nodes[t.Root] = &model.ThreadNode{TxHash: t.Root, Path: []model.Hash{}}
+	var level = [][32]byte{t.Root}
+	for depth := 0; len(level) > 0 && depth <= t.MaxDepth; depth++ {
+		postChildren, err := memo.GetPostsChildren(t.Ctx, level)
+		if err != nil && !client.IsEntryNotFoundError(err) {
+			return fmt.Errorf("error getting memo post children for thread depth: %d; %w", depth, err)
+		}
+		level = nil
+		for _, postChild := range postChildren {
+			parent, ok := t.nodes[postChild.PostTxHash]
+			if !ok {
+				continue
+			}
+			parent.ReplyCount++
+			if _, ok := t.nodes[postChild.ChildTxHash]; ok || depth == t.MaxDepth || len(t.nodes) >= ThreadMaxNodes {
+				continue
+			}
+			parentTxHash := parent.TxHash
+			t.nodes[postChild.ChildTxHash] = &model.ThreadNode{
+				TxHash:       postChild.ChildTxHash,
+				ParentTxHash: &parentTxHash,
+				Path:         append(append([]model.Hash{}, parent.Path...), parent.TxHash),
+				Depth:        parent.Depth + 1,
+			}
+			t.children[postChild.PostTxHash] = append(t.children[postChild.PostTxHash], postChild.ChildTxHash)
+			level = append(level, postChild.ChildTxHash)
+		}
+	}
+	return nil
+}
+
+func (t *MemoThread) getTxHashes() [][32]byte {
+	var txHashes = make([][32]byte, 0, len(t.nodes))
+	for txHash := range t.nodes {
+		txHashes = append(txHashes, txHash)
+	}
+	return txHashes
+}
+
+func (t *MemoThread) AttachLikeCounts() {
+	defer t.Wait.Done()
+	if !t.HasField([]string{"like_count"}) && t.Sort != model.ThreadSortLikes {
+		return
+	}
+	postLikes, err := memo.GetPostLikes(t.Ctx, t.getTxHashes())
+	if err != nil && !client.IsEntryNotFoundError(err) {
+		t.AddError(fmt.Errorf("error getting memo post likes for thread; %w", err))
+		return
+	}
+	t.Mutex.Lock()
+	for _, postLike := range postLikes {
+		if node, ok := t.nodes[postLike.PostTxHash]; ok {
+			node.LikeCount++
+		}
+	}
+	t.Mutex.Unlock()
+}
+
+func (t *MemoThread) AttachSeens() {
+	defer t.Wait.Done()
+	if t.Sort == model.ThreadSortLikes && len(t.nodes) == 1 {
+		return
+	}
+	txSeens, err := chain.GetTxSeens(t.Ctx, t.getTxHashes())
+	if err != nil {
+		t.AddError(fmt.Errorf("error getting tx seens for thread; %w", err))
+		return
+	}
+	t.Mutex.Lock()
+	for _, txSeen := range txSeens {
+		if seen, ok := t.seens[txSeen.TxHash]; !ok || txSeen.Timestamp.Before(seen) {
+			t.seens[txSeen.TxHash] = txSeen.Timestamp
+		}
+	}
+	t.Mutex.Unlock()
+}
+
+func (t *MemoThread) flatten(txHash [32]byte) {
+	t.Nodes = append(t.Nodes, t.nodes[txHash])
+	children := t.children[txHash]
+	sort.Slice(children, func(i, j int) bool {
+		a, b := children[i], children[j]
+		switch t.Sort {
+		case model.ThreadSortLikes:
+			if t.nodes[a].LikeCount != t.nodes[b].LikeCount {
+				return t.nodes[a].LikeCount > t.nodes[b].LikeCount
+			}
+		case model.ThreadSortNewest:
+			return t.seens[a].After(t.seens[b])
+		}
+		return t.seens[a].Before(t.seens[b])
+	})
+	for _, child := range children {
+		t.flatten(child)
+	}
+}
+
+func (t *MemoThread) AttachPosts() error {
+	if !t.HasField([]string{"post"}) {
+		return nil
+	}
+	var posts = make([]*model.Post, len(t.Nodes))
+	for i := range t.Nodes {
+		t.Nodes[i].Post = &model.Post{TxHash: t.Nodes[i].TxHash}
+		posts[i] = t.Nodes[i].Post
+	}
+	if err := ToMemoPosts(t.Ctx, GetPrefixFields(t.Fields, "post."), posts); err != nil {
+		return fmt.Errorf("error attaching to posts for memo thread; %w", err)
+	}
+	return nil
+}
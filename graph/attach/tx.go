@@ -26,7 +26,7 @@ func ToTxs(ctx context.Context, fields []Field, txs []*model.Tx) error {
 		Txs:  txs,
 	}
 	t.DetailsWait.Add(3)
-	t.Wait.Add(4)
+	t.Wait.Add(5)
 	go t.AttachInputs()
 	go t.AttachOutputs()
 	go t.AttachInfo()
@@ -34,6 +34,7 @@ func ToTxs(ctx context.Context, fields []Field, txs []*model.Tx) error {
 	go t.AttachBlocks()
 	t.DetailsWait.Wait()
 	go t.AttachRaws()
+	go t.AttachConflicts()
 	t.Wait.Wait()
 	if len(t.Errors) > 0 {
 		return fmt.Errorf("error attaching details to txs; %w", t.Errors[0])
@@ -58,7 +59,7 @@ func (t *Tx) GetTxHashes(checkVersion, checkSeen bool) [][32]byte {
 
 func (t *Tx) AttachInputs() {
 	defer t.DetailsWait.Done()
-	if !t.HasField([]string{"inputs", "raw"}) {
+	if !t.HasField([]string{"inputs", "raw", "conflicted"}) {
 		return
 	}
 	txHashes := t.GetTxHashes(false, false)
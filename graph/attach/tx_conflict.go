@@ -0,0 +1,100 @@
+package attach
+
+import (
+	"fmt"
+	"github.com/memocash/index/db/item/chain"
+	"github.com/memocash/index/graph/model"
+	"github.com/memocash/index/ref/bitcoin/memo"
+)
+
+// AttachConflicts marks txs not in a block that have an input also spent by a different tx in a block, such as a
+// mempool tx double spent by a mined tx or a tx from an orphaned block replaced in the new chain.
+func (t *Tx) AttachConflicts() {
+	defer t.Wait.Done()
+	if !t.HasField([]string{"conflicted"}) {
+		return
+	}
+	var prevOuts []memo.Out
+	t.Mutex.Lock()
+	for _, tx := range t.Txs {
+		for _, input := range tx.Inputs {
+			prevOuts = append(prevOuts, memo.Out{TxHash: input.PrevHash[:], Index: input.PrevIndex})
+		}
+	}
+	t.Mutex.Unlock()
+	if len(prevOuts) == 0 {
+		return
+	}
+	outputInputs, err := chain.GetOutputInputs(t.Ctx, prevOuts)
+	if err != nil {
+		t.AddError(fmt.Errorf("error getting output inputs for tx conflicts; %w", err))
+		return
+	}
+	var spenders = make(map[[32]byte]bool)
+	for _, outputInput := range outputInputs {
+		spenders[outputInput.Hash] = true
+	}
+	if len(spenders) == 0 {
+		return
+	}
+	var txHashes = make([][32]byte, 0, len(spenders))
+	for txHash := range spenders {
+		txHashes = append(txHashes, txHash)
+	}
+	mined, err := t.getMinedTxHashes(txHashes)
+	if err != nil {
+		t.AddError(fmt.Errorf("error getting mined txs for tx conflicts; %w", err))
+		return
+	}
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+	MarkConflicted(t.Txs, outputInputs, mined)
+}
+
+// MarkConflicted sets conflicted on txs not in a block that spend an output also spent by a different mined tx.
+func MarkConflicted(txs []*model.Tx, outputInputs []*chain.OutputInput, mined map[[32]byte]bool) {
+	for _, tx := range txs {
+		if mined[tx.Hash] {
+			continue
+		}
+	InputLoop:
+		for _, input := range tx.Inputs {
+			for _, outputInput := range outputInputs {
+				if outputInput.PrevHash == input.PrevHash && outputInput.PrevIndex == input.PrevIndex &&
+					outputInput.Hash != tx.Hash && mined[outputInput.Hash] {
+					tx.Conflicted = true
+					break InputLoop
+				}
+			}
+		}
+	}
+}
+
+// getMinedTxHashes returns the txs in a block with a height, blocks without a height are orphans.
+func (t *Tx) getMinedTxHashes(txHashes [][32]byte) (map[[32]byte]bool, error) {
+	txBlocks, err := chain.GetTxBlocks(t.Ctx, txHashes)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tx blocks; %w", err)
+	}
+	if len(txBlocks) == 0 {
+		return nil, nil
+	}
+	var blockHashes = make([][32]byte, len(txBlocks))
+	for i := range txBlocks {
+		blockHashes[i] = txBlocks[i].BlockHash
+	}
+	blockHeights, err := chain.GetBlockHeights(t.Ctx, blockHashes)
+	if err != nil {
+		return nil, fmt.Errorf("error getting block heights; %w", err)
+	}
+	var mined = make(map[[32]byte]bool)
+	for _, txBlock := range txBlocks {
+		for _, blockHeight := range blockHeights {
+			if blockHeight.BlockHash == txBlock.BlockHash {
+				mined[txBlock.TxHash] = true
+				break
+			}
+		}
+	}
+	return mined, nil
+}
@@ -0,0 +1,45 @@
+package attach_test
+
+import (
+	"github.com/memocash/index/db/item/chain"
+	"github.com/memocash/index/graph/attach"
+	"github.com/memocash/index/graph/model"
+	"testing"
+)
+
+var (
+	testPrevHash    = [32]byte{0x01}
+	testMinedHash   = [32]byte{0x02}
+	testMempoolHash = [32]byte{0x03}
+	testOrphanHash  = [32]byte{0x04}
+)
+
+func getTestConflictTx(hash [32]byte, prevIndex uint32) *model.Tx {
+	return &model.Tx{
+		Hash:   hash,
+		Inputs: []*model.TxInput{{Hash: hash, PrevHash: testPrevHash, PrevIndex: prevIndex}},
+	}
+}
+
+func TestMarkConflicted(t *testing.T) {
+	var outputInputs = []*chain.OutputInput{
+		{PrevHash: testPrevHash, PrevIndex: 0, Hash: testMinedHash},
+		{PrevHash: testPrevHash, PrevIndex: 0, Hash: testMempoolHash},
+		{PrevHash: testPrevHash, PrevIndex: 1, Hash: testOrphanHash},
+		{PrevHash: testPrevHash, PrevIndex: 1, Hash: testMempoolHash},
+	}
+	minedTx := getTestConflictTx(testMinedHash, 0)
+	mempoolTx := getTestConflictTx(testMempoolHash, 0)
+	orphanTx := getTestConflictTx(testOrphanHash, 1)
+	attach.MarkConflicted([]*model.Tx{minedTx, mempoolTx, orphanTx}, outputInputs,
+		map[[32]byte]bool{testMinedHash: true})
+	if minedTx.Conflicted {
+		t.Error("mined tx unexpectedly conflicted")
+	}
+	if !mempoolTx.Conflicted {
+		t.Error("mempool tx double spent by mined tx not conflicted")
+	}
+	if orphanTx.Conflicted {
+		t.Error("tx double spent by tx not mined unexpectedly conflicted")
+	}
+}
@@ -56,6 +56,15 @@ type ComplexityRoot struct {
 		Txs       func(childComplexity int, start *uint32) int
 	}
 
+	BroadcastResult struct {
+		Code      func(childComplexity int) int
+		Host      func(childComplexity int) int
+		Reason    func(childComplexity int) int
+		Status    func(childComplexity int) int
+		Timestamp func(childComplexity int) int
+		TxHash    func(childComplexity int) int
+	}
+
 	Follow struct {
 		Address       func(childComplexity int) int
 		FollowAddress func(childComplexity int) int
@@ -86,42 +95,60 @@ type ComplexityRoot struct {
 		Broadcast func(childComplexity int, raw string) int
 	}
 
+	Picture struct {
+		Address    func(childComplexity int) int
+		Lock       func(childComplexity int) int
+		Picture    func(childComplexity int) int
+		PostTxHash func(childComplexity int) int
+		Tx         func(childComplexity int) int
+		TxHash     func(childComplexity int) int
+		Url        func(childComplexity int) int
+	}
+
 	Post struct {
-		Address func(childComplexity int) int
-		Likes   func(childComplexity int) int
-		Lock    func(childComplexity int) int
-		Parent  func(childComplexity int) int
-		Replies func(childComplexity int) int
-		Room    func(childComplexity int) int
-		Text    func(childComplexity int) int
-		Tx      func(childComplexity int) int
-		TxHash  func(childComplexity int) int
+		Address     func(childComplexity int) int
+		Likes       func(childComplexity int) int
+		Lock        func(childComplexity int) int
+		Parent      func(childComplexity int) int
+		Pictures    func(childComplexity int) int
+		Replies     func(childComplexity int) int
+		RepostCount func(childComplexity int) int
+		RepostOf    func(childComplexity int) int
+		Reposts     func(childComplexity int) int
+		Room        func(childComplexity int) int
+		Text        func(childComplexity int) int
+		Tx          func(childComplexity int) int
+		TxHash      func(childComplexity int) int
 	}
 
 	Profile struct {
-		Address   func(childComplexity int) int
-		Followers func(childComplexity int, start *model.Date) int
-		Following func(childComplexity int, start *model.Date) int
-		Lock      func(childComplexity int) int
-		Name      func(childComplexity int) int
-		Pic       func(childComplexity int) int
-		Posts     func(childComplexity int, start *model.Date, newest *bool) int
-		Profile   func(childComplexity int) int
-		Rooms     func(childComplexity int, start *model.Date) int
+		Address      func(childComplexity int) int
+		Followers    func(childComplexity int, start *model.Date) int
+		Following    func(childComplexity int, start *model.Date) int
+		ImageBaseUrl func(childComplexity int) int
+		Lock         func(childComplexity int) int
+		Name         func(childComplexity int) int
+		Pic          func(childComplexity int) int
+		Posts        func(childComplexity int, start *model.Date, newest *bool) int
+		Profile      func(childComplexity int) int
+		Reposts      func(childComplexity int, start *model.Date, newest *bool) int
+		Rooms        func(childComplexity int, start *model.Date) int
 	}
 
 	Query struct {
-		Address     func(childComplexity int, address model.Address) int
-		Addresses   func(childComplexity int, addresses []model.Address) int
-		Block       func(childComplexity int, hash model.Hash) int
-		BlockNewest func(childComplexity int) int
-		Blocks      func(childComplexity int, newest *bool, start *uint32) int
-		Posts       func(childComplexity int, txHashes []model.Hash) int
-		PostsNewest func(childComplexity int, start *model.Date, tx *model.Hash, limit *uint32) int
-		Profiles    func(childComplexity int, addresses []model.Address) int
-		Room        func(childComplexity int, name string) int
-		Tx          func(childComplexity int, hash model.Hash) int
-		Txs         func(childComplexity int, hashes []model.Hash) int
+		Address         func(childComplexity int, address model.Address) int
+		Addresses       func(childComplexity int, addresses []model.Address) int
+		Block           func(childComplexity int, hash model.Hash) int
+		BlockNewest     func(childComplexity int) int
+		Blocks          func(childComplexity int, newest *bool, start *uint32) int
+		BroadcastResult func(childComplexity int, hash model.Hash) int
+		Posts           func(childComplexity int, txHashes []model.Hash) int
+		PostsNewest     func(childComplexity int, start *model.Date, tx *model.Hash, limit *uint32) int
+		Profiles        func(childComplexity int, addresses []model.Address) int
+		Room            func(childComplexity int, name string) int
+		Thread          func(childComplexity int, root model.Hash, maxDepth *int, sort *model.ThreadSort) int
+		Tx              func(childComplexity int, hash model.Hash) int
+		Txs             func(childComplexity int, hashes []model.Hash) int
 	}
 
 	Room struct {
@@ -196,24 +223,37 @@ type ComplexityRoot struct {
 	}
 
 	Subscription struct {
-		Address     func(childComplexity int, address model.Address) int
-		Addresses   func(childComplexity int, addresses []model.Address) int
-		Blocks      func(childComplexity int) int
-		Posts       func(childComplexity int, hashes []model.Hash) int
-		Profiles    func(childComplexity int, addresses []model.Address) int
-		RoomFollows func(childComplexity int, addresses []model.Address) int
-		Rooms       func(childComplexity int, names []string) int
+		Address          func(childComplexity int, address model.Address) int
+		Addresses        func(childComplexity int, addresses []model.Address) int
+		Blocks           func(childComplexity int) int
+		BroadcastResults func(childComplexity int, hashes []model.Hash) int
+		Posts            func(childComplexity int, hashes []model.Hash) int
+		Profiles         func(childComplexity int, addresses []model.Address) int
+		RoomFollows      func(childComplexity int, addresses []model.Address) int
+		Rooms            func(childComplexity int, names []string) int
+		Thread           func(childComplexity int, root model.Hash) int
+	}
+
+	ThreadNode struct {
+		Depth        func(childComplexity int) int
+		LikeCount    func(childComplexity int) int
+		ParentTxHash func(childComplexity int) int
+		Path         func(childComplexity int) int
+		Post         func(childComplexity int) int
+		ReplyCount   func(childComplexity int) int
+		TxHash       func(childComplexity int) int
 	}
 
 	Tx struct {
-		Blocks   func(childComplexity int) int
-		Hash     func(childComplexity int) int
-		Inputs   func(childComplexity int) int
-		LockTime func(childComplexity int) int
-		Outputs  func(childComplexity int) int
-		Raw      func(childComplexity int) int
-		Seen     func(childComplexity int) int
-		Version  func(childComplexity int) int
+		Blocks     func(childComplexity int) int
+		Conflicted func(childComplexity int) int
+		Hash       func(childComplexity int) int
+		Inputs     func(childComplexity int) int
+		LockTime   func(childComplexity int) int
+		Outputs    func(childComplexity int) int
+		Raw        func(childComplexity int) int
+		Seen       func(childComplexity int) int
+		Version    func(childComplexity int) int
 	}
 
 	TxBlock struct {
@@ -263,6 +303,8 @@ type QueryResolver interface {
 	Posts(ctx context.Context, txHashes []model.Hash) ([]*model.Post, error)
 	PostsNewest(ctx context.Context, start *model.Date, tx *model.Hash, limit *uint32) ([]*model.Post, error)
 	Room(ctx context.Context, name string) (*model.Room, error)
+	Thread(ctx context.Context, root model.Hash, maxDepth *int, sort *model.ThreadSort) ([]*model.ThreadNode, error)
+	BroadcastResult(ctx context.Context, hash model.Hash) (*model.BroadcastResult, error)
 }
 type SubscriptionResolver interface {
 	Address(ctx context.Context, address model.Address) (<-chan *model.Tx, error)
@@ -272,6 +314,8 @@ type SubscriptionResolver interface {
 	Profiles(ctx context.Context, addresses []model.Address) (<-chan *model.Profile, error)
 	Rooms(ctx context.Context, names []string) (<-chan *model.Post, error)
 	RoomFollows(ctx context.Context, addresses []model.Address) (<-chan *model.RoomFollow, error)
+	Thread(ctx context.Context, root model.Hash) (<-chan *model.ThreadNode, error)
+	BroadcastResults(ctx context.Context, hashes []model.Hash) (<-chan *model.BroadcastResult, error)
 }
 
 type executableSchema struct {
@@ -343,6 +387,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Block.Txs(childComplexity, args["start"].(*uint32)), true
 
+	case "BroadcastResult.code":
+		if e.complexity.BroadcastResult.Code == nil {
+			break
+		}
+
+		return e.complexity.BroadcastResult.Code(childComplexity), true
+
+	case "BroadcastResult.host":
+		if e.complexity.BroadcastResult.Host == nil {
+			break
+		}
+
+		return e.complexity.BroadcastResult.Host(childComplexity), true
+
+	case "BroadcastResult.reason":
+		if e.complexity.BroadcastResult.Reason == nil {
+			break
+		}
+
+		return e.complexity.BroadcastResult.Reason(childComplexity), true
+
+	case "BroadcastResult.status":
+		if e.complexity.BroadcastResult.Status == nil {
+			break
+		}
+
+		return e.complexity.BroadcastResult.Status(childComplexity), true
+
+	case "BroadcastResult.timestamp":
+		if e.complexity.BroadcastResult.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.BroadcastResult.Timestamp(childComplexity), true
+
+	case "BroadcastResult.tx_hash":
+		if e.complexity.BroadcastResult.TxHash == nil {
+			break
+		}
+
+		return e.complexity.BroadcastResult.TxHash(childComplexity), true
+
 	case "Follow.address":
 		if e.complexity.Follow.Address == nil {
 			break
@@ -479,6 +565,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.Broadcast(childComplexity, args["raw"].(string)), true
 
+	case "Picture.address":
+		if e.complexity.Picture.Address == nil {
+			break
+		}
+
+		return e.complexity.Picture.Address(childComplexity), true
+
+	case "Picture.lock":
+		if e.complexity.Picture.Lock == nil {
+			break
+		}
+
+		return e.complexity.Picture.Lock(childComplexity), true
+
+	case "Picture.picture":
+		if e.complexity.Picture.Picture == nil {
+			break
+		}
+
+		return e.complexity.Picture.Picture(childComplexity), true
+
+	case "Picture.post_tx_hash":
+		if e.complexity.Picture.PostTxHash == nil {
+			break
+		}
+
+		return e.complexity.Picture.PostTxHash(childComplexity), true
+
+	case "Picture.tx":
+		if e.complexity.Picture.Tx == nil {
+			break
+		}
+
+		return e.complexity.Picture.Tx(childComplexity), true
+
+	case "Picture.tx_hash":
+		if e.complexity.Picture.TxHash == nil {
+			break
+		}
+
+		return e.complexity.Picture.TxHash(childComplexity), true
+
+	case "Picture.url":
+		if e.complexity.Picture.Url == nil {
+			break
+		}
+
+		return e.complexity.Picture.Url(childComplexity), true
+
 	case "Post.address":
 		if e.complexity.Post.Address == nil {
 			break
@@ -507,6 +642,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Post.Parent(childComplexity), true
 
+	case "Post.pictures":
+		if e.complexity.Post.Pictures == nil {
+			break
+		}
+
+		return e.complexity.Post.Pictures(childComplexity), true
+
 	case "Post.replies":
 		if e.complexity.Post.Replies == nil {
 			break
@@ -514,6 +656,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Post.Replies(childComplexity), true
 
+	case "Post.repost_count":
+		if e.complexity.Post.RepostCount == nil {
+			break
+		}
+
+		return e.complexity.Post.RepostCount(childComplexity), true
+
+	case "Post.repost_of":
+		if e.complexity.Post.RepostOf == nil {
+			break
+		}
+
+		return e.complexity.Post.RepostOf(childComplexity), true
+
+	case "Post.reposts":
+		if e.complexity.Post.Reposts == nil {
+			break
+		}
+
+		return e.complexity.Post.Reposts(childComplexity), true
+
 	case "Post.room":
 		if e.complexity.Post.Room == nil {
 			break
@@ -573,6 +736,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Profile.Following(childComplexity, args["start"].(*model.Date)), true
 
+	case "Profile.image_base_url":
+		if e.complexity.Profile.ImageBaseUrl == nil {
+			break
+		}
+
+		return e.complexity.Profile.ImageBaseUrl(childComplexity), true
+
 	case "Profile.lock":
 		if e.complexity.Profile.Lock == nil {
 			break
@@ -613,6 +783,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Profile.Profile(childComplexity), true
 
+	case "Profile.reposts":
+		if e.complexity.Profile.Reposts == nil {
+			break
+		}
+
+		args, err := ec.field_Profile_reposts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Profile.Reposts(childComplexity, args["start"].(*model.Date), args["newest"].(*bool)), true
+
 	case "Profile.rooms":
 		if e.complexity.Profile.Rooms == nil {
 			break
@@ -680,6 +862,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Blocks(childComplexity, args["newest"].(*bool), args["start"].(*uint32)), true
 
+	case "Query.broadcast_result":
+		if e.complexity.Query.BroadcastResult == nil {
+			break
+		}
+
+		args, err := ec.field_Query_broadcast_result_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.BroadcastResult(childComplexity, args["hash"].(model.Hash)), true
+
 	case "Query.posts":
 		if e.complexity.Query.Posts == nil {
 			break
@@ -728,6 +922,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Room(childComplexity, args["name"].(string)), true
 
+	case "Query.thread":
+		if e.complexity.Query.Thread == nil {
+			break
+		}
+
+		args, err := ec.field_Query_thread_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Thread(childComplexity, args["root"].(model.Hash), args["maxDepth"].(*int), args["sort"].(*model.ThreadSort)), true
+
 	case "Query.tx":
 		if e.complexity.Query.Tx == nil {
 			break
@@ -1122,6 +1328,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Subscription.Blocks(childComplexity), true
 
+	case "Subscription.broadcast_results":
+		if e.complexity.Subscription.BroadcastResults == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_broadcast_results_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.BroadcastResults(childComplexity, args["hashes"].([]model.Hash)), true
+
 	case "Subscription.posts":
 		if e.complexity.Subscription.Posts == nil {
 			break
@@ -1170,6 +1388,67 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Subscription.Rooms(childComplexity, args["names"].([]string)), true
 
+	case "Subscription.thread":
+		if e.complexity.Subscription.Thread == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_thread_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.Thread(childComplexity, args["root"].(model.Hash)), true
+
+	case "ThreadNode.depth":
+		if e.complexity.ThreadNode.Depth == nil {
+			break
+		}
+
+		return e.complexity.ThreadNode.Depth(childComplexity), true
+
+	case "ThreadNode.like_count":
+		if e.complexity.ThreadNode.LikeCount == nil {
+			break
+		}
+
+		return e.complexity.ThreadNode.LikeCount(childComplexity), true
+
+	case "ThreadNode.parent_tx_hash":
+		if e.complexity.ThreadNode.ParentTxHash == nil {
+			break
+		}
+
+		return e.complexity.ThreadNode.ParentTxHash(childComplexity), true
+
+	case "ThreadNode.path":
+		if e.complexity.ThreadNode.Path == nil {
+			break
+		}
+
+		return e.complexity.ThreadNode.Path(childComplexity), true
+
+	case "ThreadNode.post":
+		if e.complexity.ThreadNode.Post == nil {
+			break
+		}
+
+		return e.complexity.ThreadNode.Post(childComplexity), true
+
+	case "ThreadNode.reply_count":
+		if e.complexity.ThreadNode.ReplyCount == nil {
+			break
+		}
+
+		return e.complexity.ThreadNode.ReplyCount(childComplexity), true
+
+	case "ThreadNode.tx_hash":
+		if e.complexity.ThreadNode.TxHash == nil {
+			break
+		}
+
+		return e.complexity.ThreadNode.TxHash(childComplexity), true
+
 	case "Tx.blocks":
 		if e.complexity.Tx.Blocks == nil {
 			break
@@ -1177,6 +1456,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Tx.Blocks(childComplexity), true
 
+	case "Tx.conflicted":
+		if e.complexity.Tx.Conflicted == nil {
+			break
+		}
+
+		return e.complexity.Tx.Conflicted(childComplexity), true
+
 	case "Tx.hash":
 		if e.complexity.Tx.Hash == nil {
 			break
@@ -1473,6 +1759,15 @@ var sources = []*ast.Source{
     tx_count: Int
     txs(start: Uint32): [TxBlock!]
 }
+`, BuiltIn: false},
+	{Name: "../schema/broadcast.graphqls", Input: `type BroadcastResult {
+    tx_hash: Hash!
+    status: String!
+    code: Int!
+    reason: String!
+    host: String!
+    timestamp: Date!
+}
 `, BuiltIn: false},
 	{Name: "../schema/lock.graphqls", Input: `type Lock {
     address: Address
@@ -1490,9 +1785,11 @@ var sources = []*ast.Source{
     name: SetName
     profile: SetProfile
     pic: SetPic
+    image_base_url: String
     following(start: Date): [Follow]
     followers(start: Date): [Follow]
     posts(start: Date, newest: Boolean): [Post]
+    reposts(start: Date, newest: Boolean): [Post!]
     rooms(start: Date): [RoomFollow!]
 }
 
@@ -1541,6 +1838,20 @@ type Post {
     parent: Post
     replies: [Post!]
     room: Room
+    repost_of: Post
+    reposts: [Post!]
+    repost_count: Int!
+    pictures: [Picture!]
+}
+
+type Picture {
+    tx: Tx!
+    tx_hash: Hash!
+    lock: Lock!
+    address: Address!
+    post_tx_hash: Hash!
+    picture: String!
+    url: String
 }
 
 type Like {
@@ -1566,6 +1877,8 @@ type Like {
     # posts_newest can take a date or a tx hash to start from for pagination
     posts_newest(start: Date, tx: Hash, limit: Uint32): [Post]
     room(name: String!): Room!
+    thread(root: Hash!, maxDepth: Int, sort: ThreadSort): [ThreadNode!]
+    broadcast_result(hash: Hash!): BroadcastResult
 }
 
 type Subscription {
@@ -1576,6 +1889,8 @@ type Subscription {
     profiles(addresses: [Address!]): Profile
     rooms(names: [String!]): Post
     room_follows(addresses: [Address!]): RoomFollow
+    thread(root: Hash!): ThreadNode
+    broadcast_results(hashes: [Hash!]!): BroadcastResult
 }
 `, BuiltIn: false},
 	{Name: "../schema/room.graphqls", Input: `type Room {
@@ -1649,6 +1964,24 @@ type SlpBaton {
 #    hash: String!
 #    outputs: [SlpOutput!]!
 #}
+`, BuiltIn: false},
+	{Name: "../schema/thread.graphqls", Input: `enum ThreadSort {
+    OLDEST
+    NEWEST
+    LIKES
+}
+
+# ThreadNode is a post in a flattened reply tree, ordered depth first with siblings sorted
+type ThreadNode {
+    tx_hash: Hash!
+    parent_tx_hash: Hash
+    # path is the list of ancestor tx hashes from the root down to the parent
+    path: [Hash!]!
+    depth: Int!
+    reply_count: Int!
+    like_count: Int!
+    post: Post!
+}
 `, BuiltIn: false},
 	{Name: "../schema/tx.graphqls", Input: `type Tx {
     hash: Hash!
@@ -1659,6 +1992,8 @@ type SlpBaton {
     seen: Date
     version:  Int32!
     locktime: Uint32!
+    # conflicted is true if a different tx spending one of the same outputs is in a block and this tx is not
+    conflicted: Boolean!
 }
 `, BuiltIn: false},
 	{Name: "../schema/tx_block.graphqls", Input: `type TxBlock {
@@ -1807,6 +2142,30 @@ func (ec *executionContext) field_Profile_posts_args(ctx context.Context, rawArg
 	return args, nil
 }
 
+func (ec *executionContext) field_Profile_reposts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *model.Date
+	if tmp, ok := rawArgs["start"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("start"))
+		arg0, err = ec.unmarshalODate2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐDate(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["start"] = arg0
+	var arg1 *bool
+	if tmp, ok := rawArgs["newest"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("newest"))
+		arg1, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["newest"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Profile_rooms_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1906,6 +2265,21 @@ func (ec *executionContext) field_Query_blocks_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_broadcast_result_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.Hash
+	if tmp, ok := rawArgs["hash"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hash"))
+		arg0, err = ec.unmarshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["hash"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_posts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1984,6 +2358,39 @@ func (ec *executionContext) field_Query_room_args(ctx context.Context, rawArgs m
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_thread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.Hash
+	if tmp, ok := rawArgs["root"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("root"))
+		arg0, err = ec.unmarshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["root"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["maxDepth"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxDepth"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["maxDepth"] = arg1
+	var arg2 *model.ThreadSort
+	if tmp, ok := rawArgs["sort"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sort"))
+		arg2, err = ec.unmarshalOThreadSort2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐThreadSort(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sort"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_tx_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2074,6 +2481,21 @@ func (ec *executionContext) field_Subscription_addresses_args(ctx context.Contex
 	return args, nil
 }
 
+func (ec *executionContext) field_Subscription_broadcast_results_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []model.Hash
+	if tmp, ok := rawArgs["hashes"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hashes"))
+		arg0, err = ec.unmarshalNHash2ᚕgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHashᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["hashes"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Subscription_posts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2134,18 +2556,33 @@ func (ec *executionContext) field_Subscription_rooms_args(ctx context.Context, r
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Subscription_thread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+	var arg0 model.Hash
+	if tmp, ok := rawArgs["root"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("root"))
+		arg0, err = ec.unmarshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
+	args["root"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
 	return args, nil
 }
 
@@ -2491,6 +2928,270 @@ func (ec *executionContext) fieldContext_Block_txs(ctx context.Context, field gr
 	return fc, nil
 }
 
+func (ec *executionContext) _BroadcastResult_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.BroadcastResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BroadcastResult_tx_hash(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TxHash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.Hash)
+	fc.Result = res
+	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BroadcastResult_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BroadcastResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Hash does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BroadcastResult_status(ctx context.Context, field graphql.CollectedField, obj *model.BroadcastResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BroadcastResult_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BroadcastResult_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BroadcastResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BroadcastResult_code(ctx context.Context, field graphql.CollectedField, obj *model.BroadcastResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BroadcastResult_code(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BroadcastResult_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BroadcastResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BroadcastResult_reason(ctx context.Context, field graphql.CollectedField, obj *model.BroadcastResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BroadcastResult_reason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BroadcastResult_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BroadcastResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BroadcastResult_host(ctx context.Context, field graphql.CollectedField, obj *model.BroadcastResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BroadcastResult_host(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Host, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BroadcastResult_host(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BroadcastResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BroadcastResult_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.BroadcastResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BroadcastResult_timestamp(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timestamp, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.Date)
+	fc.Result = res
+	return ec.marshalNDate2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐDate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BroadcastResult_timestamp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BroadcastResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Follow_tx(ctx context.Context, field graphql.CollectedField, obj *model.Follow) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Follow_tx(ctx, field)
 	if err != nil {
@@ -2546,6 +3247,8 @@ func (ec *executionContext) fieldContext_Follow_tx(ctx context.Context, field gr
 				return ec.fieldContext_Tx_version(ctx, field)
 			case "locktime":
 				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
@@ -2888,6 +3591,8 @@ func (ec *executionContext) fieldContext_Like_tx(ctx context.Context, field grap
 				return ec.fieldContext_Tx_version(ctx, field)
 			case "locktime":
 				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
@@ -3133,6 +3838,14 @@ func (ec *executionContext) fieldContext_Like_post(ctx context.Context, field gr
 				return ec.fieldContext_Post_replies(ctx, field)
 			case "room":
 				return ec.fieldContext_Post_room(ctx, field)
+			case "repost_of":
+				return ec.fieldContext_Post_repost_of(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Post_reposts(ctx, field)
+			case "repost_count":
+				return ec.fieldContext_Post_repost_count(ctx, field)
+			case "pictures":
+				return ec.fieldContext_Post_pictures(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
@@ -3268,12 +3981,16 @@ func (ec *executionContext) fieldContext_Lock_profile(ctx context.Context, field
 				return ec.fieldContext_Profile_profile(ctx, field)
 			case "pic":
 				return ec.fieldContext_Profile_pic(ctx, field)
+			case "image_base_url":
+				return ec.fieldContext_Profile_image_base_url(ctx, field)
 			case "following":
 				return ec.fieldContext_Profile_following(ctx, field)
 			case "followers":
 				return ec.fieldContext_Profile_followers(ctx, field)
 			case "posts":
 				return ec.fieldContext_Profile_posts(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Profile_reposts(ctx, field)
 			case "rooms":
 				return ec.fieldContext_Profile_rooms(ctx, field)
 			}
@@ -3335,6 +4052,8 @@ func (ec *executionContext) fieldContext_Lock_txs(ctx context.Context, field gra
 				return ec.fieldContext_Tx_version(ctx, field)
 			case "locktime":
 				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
@@ -3408,8 +4127,8 @@ func (ec *executionContext) fieldContext_Mutation_broadcast(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_tx(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_tx(ctx, field)
+func (ec *executionContext) _Picture_tx(ctx context.Context, field graphql.CollectedField, obj *model.Picture) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Picture_tx(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3439,9 +4158,9 @@ func (ec *executionContext) _Post_tx(ctx context.Context, field graphql.Collecte
 	return ec.marshalNTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Picture_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Picture",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3463,6 +4182,8 @@ func (ec *executionContext) fieldContext_Post_tx(ctx context.Context, field grap
 				return ec.fieldContext_Tx_version(ctx, field)
 			case "locktime":
 				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
@@ -3470,8 +4191,8 @@ func (ec *executionContext) fieldContext_Post_tx(ctx context.Context, field grap
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_tx_hash(ctx, field)
+func (ec *executionContext) _Picture_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.Picture) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Picture_tx_hash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3501,9 +4222,9 @@ func (ec *executionContext) _Post_tx_hash(ctx context.Context, field graphql.Col
 	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Picture_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Picture",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3514,8 +4235,8 @@ func (ec *executionContext) fieldContext_Post_tx_hash(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_lock(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_lock(ctx, field)
+func (ec *executionContext) _Picture_lock(ctx context.Context, field graphql.CollectedField, obj *model.Picture) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Picture_lock(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3545,9 +4266,9 @@ func (ec *executionContext) _Post_lock(ctx context.Context, field graphql.Collec
 	return ec.marshalNLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_lock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Picture_lock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Picture",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3566,8 +4287,8 @@ func (ec *executionContext) fieldContext_Post_lock(ctx context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_address(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_address(ctx, field)
+func (ec *executionContext) _Picture_address(ctx context.Context, field graphql.CollectedField, obj *model.Picture) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Picture_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3597,9 +4318,9 @@ func (ec *executionContext) _Post_address(ctx context.Context, field graphql.Col
 	return ec.marshalNAddress2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐAddress(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Picture_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Picture",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3610,8 +4331,8 @@ func (ec *executionContext) fieldContext_Post_address(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_text(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_text(ctx, field)
+func (ec *executionContext) _Picture_post_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.Picture) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Picture_post_tx_hash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3624,7 +4345,7 @@ func (ec *executionContext) _Post_text(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Text, nil
+		return obj.PostTxHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3636,26 +4357,26 @@ func (ec *executionContext) _Post_text(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.Hash)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_text(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Picture_post_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Picture",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Hash does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_likes(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_likes(ctx, field)
+func (ec *executionContext) _Picture_picture(ctx context.Context, field graphql.CollectedField, obj *model.Picture) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Picture_picture(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3668,51 +4389,38 @@ func (ec *executionContext) _Post_likes(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Likes, nil
+		return obj.Picture, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Like)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOLike2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLikeᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_likes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Picture_picture(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Picture",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "tx":
-				return ec.fieldContext_Like_tx(ctx, field)
-			case "tx_hash":
-				return ec.fieldContext_Like_tx_hash(ctx, field)
-			case "lock":
-				return ec.fieldContext_Like_lock(ctx, field)
-			case "address":
-				return ec.fieldContext_Like_address(ctx, field)
-			case "post_tx_hash":
-				return ec.fieldContext_Like_post_tx_hash(ctx, field)
-			case "post":
-				return ec.fieldContext_Like_post(ctx, field)
-			case "tip":
-				return ec.fieldContext_Like_tip(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Like", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_parent(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_parent(ctx, field)
+func (ec *executionContext) _Picture_url(ctx context.Context, field graphql.CollectedField, obj *model.Picture) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Picture_url(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3725,7 +4433,7 @@ func (ec *executionContext) _Post_parent(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Parent, nil
+		return obj.Url, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3734,46 +4442,26 @@ func (ec *executionContext) _Post_parent(ctx context.Context, field graphql.Coll
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Post)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOPost2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_parent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Picture_url(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Picture",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "tx":
-				return ec.fieldContext_Post_tx(ctx, field)
-			case "tx_hash":
-				return ec.fieldContext_Post_tx_hash(ctx, field)
-			case "lock":
-				return ec.fieldContext_Post_lock(ctx, field)
-			case "address":
-				return ec.fieldContext_Post_address(ctx, field)
-			case "text":
-				return ec.fieldContext_Post_text(ctx, field)
-			case "likes":
-				return ec.fieldContext_Post_likes(ctx, field)
-			case "parent":
-				return ec.fieldContext_Post_parent(ctx, field)
-			case "replies":
-				return ec.fieldContext_Post_replies(ctx, field)
-			case "room":
-				return ec.fieldContext_Post_room(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_replies(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_replies(ctx, field)
+func (ec *executionContext) _Post_tx(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_tx(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3786,21 +4474,24 @@ func (ec *executionContext) _Post_replies(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Replies, nil
+		return obj.Tx, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Post)
+	res := resTmp.(*model.Tx)
 	fc.Result = res
-	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPostᚄ(ctx, field.Selections, res)
+	return ec.marshalNTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_replies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Post",
 		Field:      field,
@@ -3808,33 +4499,33 @@ func (ec *executionContext) fieldContext_Post_replies(ctx context.Context, field
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "tx":
-				return ec.fieldContext_Post_tx(ctx, field)
-			case "tx_hash":
-				return ec.fieldContext_Post_tx_hash(ctx, field)
-			case "lock":
-				return ec.fieldContext_Post_lock(ctx, field)
-			case "address":
-				return ec.fieldContext_Post_address(ctx, field)
-			case "text":
-				return ec.fieldContext_Post_text(ctx, field)
-			case "likes":
-				return ec.fieldContext_Post_likes(ctx, field)
-			case "parent":
-				return ec.fieldContext_Post_parent(ctx, field)
-			case "replies":
-				return ec.fieldContext_Post_replies(ctx, field)
-			case "room":
-				return ec.fieldContext_Post_room(ctx, field)
+			case "hash":
+				return ec.fieldContext_Tx_hash(ctx, field)
+			case "raw":
+				return ec.fieldContext_Tx_raw(ctx, field)
+			case "inputs":
+				return ec.fieldContext_Tx_inputs(ctx, field)
+			case "outputs":
+				return ec.fieldContext_Tx_outputs(ctx, field)
+			case "blocks":
+				return ec.fieldContext_Tx_blocks(ctx, field)
+			case "seen":
+				return ec.fieldContext_Tx_seen(ctx, field)
+			case "version":
+				return ec.fieldContext_Tx_version(ctx, field)
+			case "locktime":
+				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_room(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_room(ctx, field)
+func (ec *executionContext) _Post_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_tx_hash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3847,43 +4538,38 @@ func (ec *executionContext) _Post_room(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Room, nil
+		return obj.TxHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Room)
+	res := resTmp.(model.Hash)
 	fc.Result = res
-	return ec.marshalORoom2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐRoom(ctx, field.Selections, res)
+	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_room(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_Room_name(ctx, field)
-			case "posts":
-				return ec.fieldContext_Room_posts(ctx, field)
-			case "followers":
-				return ec.fieldContext_Room_followers(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Room", field.Name)
+			return nil, errors.New("field of type Hash does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Profile_lock(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Profile_lock(ctx, field)
+func (ec *executionContext) _Post_lock(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_lock(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3913,9 +4599,9 @@ func (ec *executionContext) _Profile_lock(ctx context.Context, field graphql.Col
 	return ec.marshalNLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Profile_lock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_lock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Profile",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3934,8 +4620,8 @@ func (ec *executionContext) fieldContext_Profile_lock(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Profile_address(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Profile_address(ctx, field)
+func (ec *executionContext) _Post_address(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3965,9 +4651,9 @@ func (ec *executionContext) _Profile_address(ctx context.Context, field graphql.
 	return ec.marshalNAddress2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐAddress(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Profile_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Profile",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3978,8 +4664,8 @@ func (ec *executionContext) fieldContext_Profile_address(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Profile_name(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Profile_name(ctx, field)
+func (ec *executionContext) _Post_text(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_text(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3992,47 +4678,38 @@ func (ec *executionContext) _Profile_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Text, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.SetName)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOSetName2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐSetName(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Profile_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_text(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Profile",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "tx":
-				return ec.fieldContext_SetName_tx(ctx, field)
-			case "tx_hash":
-				return ec.fieldContext_SetName_tx_hash(ctx, field)
-			case "lock":
-				return ec.fieldContext_SetName_lock(ctx, field)
-			case "address":
-				return ec.fieldContext_SetName_address(ctx, field)
-			case "name":
-				return ec.fieldContext_SetName_name(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SetName", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Profile_profile(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Profile_profile(ctx, field)
+func (ec *executionContext) _Post_likes(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_likes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4045,7 +4722,7 @@ func (ec *executionContext) _Profile_profile(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Profile, nil
+		return obj.Likes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4054,38 +4731,42 @@ func (ec *executionContext) _Profile_profile(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.SetProfile)
+	res := resTmp.([]*model.Like)
 	fc.Result = res
-	return ec.marshalOSetProfile2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐSetProfile(ctx, field.Selections, res)
+	return ec.marshalOLike2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLikeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Profile_profile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_likes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Profile",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "tx":
-				return ec.fieldContext_SetProfile_tx(ctx, field)
+				return ec.fieldContext_Like_tx(ctx, field)
 			case "tx_hash":
-				return ec.fieldContext_SetProfile_tx_hash(ctx, field)
+				return ec.fieldContext_Like_tx_hash(ctx, field)
 			case "lock":
-				return ec.fieldContext_SetProfile_lock(ctx, field)
+				return ec.fieldContext_Like_lock(ctx, field)
 			case "address":
-				return ec.fieldContext_SetProfile_address(ctx, field)
-			case "text":
-				return ec.fieldContext_SetProfile_text(ctx, field)
+				return ec.fieldContext_Like_address(ctx, field)
+			case "post_tx_hash":
+				return ec.fieldContext_Like_post_tx_hash(ctx, field)
+			case "post":
+				return ec.fieldContext_Like_post(ctx, field)
+			case "tip":
+				return ec.fieldContext_Like_tip(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SetProfile", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Like", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Profile_pic(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Profile_pic(ctx, field)
+func (ec *executionContext) _Post_parent(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_parent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4098,7 +4779,7 @@ func (ec *executionContext) _Profile_pic(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Pic, nil
+		return obj.Parent, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4107,38 +4788,54 @@ func (ec *executionContext) _Profile_pic(ctx context.Context, field graphql.Coll
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.SetPic)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalOSetPic2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐSetPic(ctx, field.Selections, res)
+	return ec.marshalOPost2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Profile_pic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_parent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Profile",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "tx":
-				return ec.fieldContext_SetPic_tx(ctx, field)
+				return ec.fieldContext_Post_tx(ctx, field)
 			case "tx_hash":
-				return ec.fieldContext_SetPic_tx_hash(ctx, field)
+				return ec.fieldContext_Post_tx_hash(ctx, field)
 			case "lock":
-				return ec.fieldContext_SetPic_lock(ctx, field)
+				return ec.fieldContext_Post_lock(ctx, field)
 			case "address":
-				return ec.fieldContext_SetPic_address(ctx, field)
-			case "pic":
-				return ec.fieldContext_SetPic_pic(ctx, field)
+				return ec.fieldContext_Post_address(ctx, field)
+			case "text":
+				return ec.fieldContext_Post_text(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Post_parent(ctx, field)
+			case "replies":
+				return ec.fieldContext_Post_replies(ctx, field)
+			case "room":
+				return ec.fieldContext_Post_room(ctx, field)
+			case "repost_of":
+				return ec.fieldContext_Post_repost_of(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Post_reposts(ctx, field)
+			case "repost_count":
+				return ec.fieldContext_Post_repost_count(ctx, field)
+			case "pictures":
+				return ec.fieldContext_Post_pictures(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SetPic", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Profile_following(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Profile_following(ctx, field)
+func (ec *executionContext) _Post_replies(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_replies(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4151,7 +4848,7 @@ func (ec *executionContext) _Profile_following(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Following, nil
+		return obj.Replies, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4160,53 +4857,54 @@ func (ec *executionContext) _Profile_following(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Follow)
+	res := resTmp.([]*model.Post)
 	fc.Result = res
-	return ec.marshalOFollow2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐFollow(ctx, field.Selections, res)
+	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPostᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Profile_following(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_replies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Profile",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "tx":
-				return ec.fieldContext_Follow_tx(ctx, field)
+				return ec.fieldContext_Post_tx(ctx, field)
 			case "tx_hash":
-				return ec.fieldContext_Follow_tx_hash(ctx, field)
+				return ec.fieldContext_Post_tx_hash(ctx, field)
 			case "lock":
-				return ec.fieldContext_Follow_lock(ctx, field)
+				return ec.fieldContext_Post_lock(ctx, field)
 			case "address":
-				return ec.fieldContext_Follow_address(ctx, field)
-			case "follow_lock":
-				return ec.fieldContext_Follow_follow_lock(ctx, field)
-			case "follow_address":
-				return ec.fieldContext_Follow_follow_address(ctx, field)
-			case "unfollow":
-				return ec.fieldContext_Follow_unfollow(ctx, field)
+				return ec.fieldContext_Post_address(ctx, field)
+			case "text":
+				return ec.fieldContext_Post_text(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Post_parent(ctx, field)
+			case "replies":
+				return ec.fieldContext_Post_replies(ctx, field)
+			case "room":
+				return ec.fieldContext_Post_room(ctx, field)
+			case "repost_of":
+				return ec.fieldContext_Post_repost_of(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Post_reposts(ctx, field)
+			case "repost_count":
+				return ec.fieldContext_Post_repost_count(ctx, field)
+			case "pictures":
+				return ec.fieldContext_Post_pictures(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Follow", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Profile_following_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Profile_followers(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Profile_followers(ctx, field)
+func (ec *executionContext) _Post_room(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_room(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4219,7 +4917,7 @@ func (ec *executionContext) _Profile_followers(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Followers, nil
+		return obj.Room, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4228,53 +4926,34 @@ func (ec *executionContext) _Profile_followers(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Follow)
+	res := resTmp.(*model.Room)
 	fc.Result = res
-	return ec.marshalOFollow2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐFollow(ctx, field.Selections, res)
+	return ec.marshalORoom2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐRoom(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Profile_followers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_room(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Profile",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "tx":
-				return ec.fieldContext_Follow_tx(ctx, field)
-			case "tx_hash":
-				return ec.fieldContext_Follow_tx_hash(ctx, field)
-			case "lock":
-				return ec.fieldContext_Follow_lock(ctx, field)
-			case "address":
-				return ec.fieldContext_Follow_address(ctx, field)
-			case "follow_lock":
-				return ec.fieldContext_Follow_follow_lock(ctx, field)
-			case "follow_address":
-				return ec.fieldContext_Follow_follow_address(ctx, field)
-			case "unfollow":
-				return ec.fieldContext_Follow_unfollow(ctx, field)
+			case "name":
+				return ec.fieldContext_Room_name(ctx, field)
+			case "posts":
+				return ec.fieldContext_Room_posts(ctx, field)
+			case "followers":
+				return ec.fieldContext_Room_followers(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Follow", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Room", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Profile_followers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Profile_posts(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Profile_posts(ctx, field)
+func (ec *executionContext) _Post_repost_of(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_repost_of(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4287,7 +4966,7 @@ func (ec *executionContext) _Profile_posts(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Posts, nil
+		return obj.RepostOf, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4296,14 +4975,14 @@ func (ec *executionContext) _Profile_posts(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Post)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
+	return ec.marshalOPost2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Profile_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_repost_of(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Profile",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4327,26 +5006,23 @@ func (ec *executionContext) fieldContext_Profile_posts(ctx context.Context, fiel
 				return ec.fieldContext_Post_replies(ctx, field)
 			case "room":
 				return ec.fieldContext_Post_room(ctx, field)
+			case "repost_of":
+				return ec.fieldContext_Post_repost_of(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Post_reposts(ctx, field)
+			case "repost_count":
+				return ec.fieldContext_Post_repost_count(ctx, field)
+			case "pictures":
+				return ec.fieldContext_Post_pictures(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Profile_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Profile_rooms(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Profile_rooms(ctx, field)
+func (ec *executionContext) _Post_reposts(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_reposts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4359,7 +5035,7 @@ func (ec *executionContext) _Profile_rooms(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Rooms, nil
+		return obj.Reposts, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4368,53 +5044,54 @@ func (ec *executionContext) _Profile_rooms(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.RoomFollow)
+	res := resTmp.([]*model.Post)
 	fc.Result = res
-	return ec.marshalORoomFollow2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐRoomFollowᚄ(ctx, field.Selections, res)
+	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPostᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Profile_rooms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_reposts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Profile",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext_RoomFollow_name(ctx, field)
-			case "room":
-				return ec.fieldContext_RoomFollow_room(ctx, field)
+			case "tx":
+				return ec.fieldContext_Post_tx(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_Post_tx_hash(ctx, field)
 			case "lock":
-				return ec.fieldContext_RoomFollow_lock(ctx, field)
+				return ec.fieldContext_Post_lock(ctx, field)
 			case "address":
-				return ec.fieldContext_RoomFollow_address(ctx, field)
-			case "unfollow":
-				return ec.fieldContext_RoomFollow_unfollow(ctx, field)
-			case "tx_hash":
-				return ec.fieldContext_RoomFollow_tx_hash(ctx, field)
-			case "tx":
-				return ec.fieldContext_RoomFollow_tx(ctx, field)
+				return ec.fieldContext_Post_address(ctx, field)
+			case "text":
+				return ec.fieldContext_Post_text(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Post_parent(ctx, field)
+			case "replies":
+				return ec.fieldContext_Post_replies(ctx, field)
+			case "room":
+				return ec.fieldContext_Post_room(ctx, field)
+			case "repost_of":
+				return ec.fieldContext_Post_repost_of(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Post_reposts(ctx, field)
+			case "repost_count":
+				return ec.fieldContext_Post_repost_count(ctx, field)
+			case "pictures":
+				return ec.fieldContext_Post_pictures(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RoomFollow", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Profile_rooms_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_tx(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_tx(ctx, field)
+func (ec *executionContext) _Post_repost_count(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_repost_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4427,64 +5104,38 @@ func (ec *executionContext) _Query_tx(ctx context.Context, field graphql.Collect
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Tx(rctx, fc.Args["hash"].(model.Hash))
+		return obj.RepostCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Tx)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_repost_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Post",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "hash":
-				return ec.fieldContext_Tx_hash(ctx, field)
-			case "raw":
-				return ec.fieldContext_Tx_raw(ctx, field)
-			case "inputs":
-				return ec.fieldContext_Tx_inputs(ctx, field)
-			case "outputs":
-				return ec.fieldContext_Tx_outputs(ctx, field)
-			case "blocks":
-				return ec.fieldContext_Tx_blocks(ctx, field)
-			case "seen":
-				return ec.fieldContext_Tx_seen(ctx, field)
-			case "version":
-				return ec.fieldContext_Tx_version(ctx, field)
-			case "locktime":
-				return ec.fieldContext_Tx_locktime(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_tx_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_txs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_txs(ctx, field)
+func (ec *executionContext) _Post_pictures(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_pictures(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4497,7 +5148,7 @@ func (ec *executionContext) _Query_txs(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Txs(rctx, fc.Args["hashes"].([]model.Hash))
+		return obj.Pictures, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4506,55 +5157,42 @@ func (ec *executionContext) _Query_txs(ctx context.Context, field graphql.Collec
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Tx)
+	res := resTmp.([]*model.Picture)
 	fc.Result = res
-	return ec.marshalOTx2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
+	return ec.marshalOPicture2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPictureᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_txs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_pictures(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Post",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "hash":
-				return ec.fieldContext_Tx_hash(ctx, field)
-			case "raw":
-				return ec.fieldContext_Tx_raw(ctx, field)
-			case "inputs":
-				return ec.fieldContext_Tx_inputs(ctx, field)
-			case "outputs":
-				return ec.fieldContext_Tx_outputs(ctx, field)
-			case "blocks":
-				return ec.fieldContext_Tx_blocks(ctx, field)
-			case "seen":
-				return ec.fieldContext_Tx_seen(ctx, field)
-			case "version":
-				return ec.fieldContext_Tx_version(ctx, field)
-			case "locktime":
-				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "tx":
+				return ec.fieldContext_Picture_tx(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_Picture_tx_hash(ctx, field)
+			case "lock":
+				return ec.fieldContext_Picture_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_Picture_address(ctx, field)
+			case "post_tx_hash":
+				return ec.fieldContext_Picture_post_tx_hash(ctx, field)
+			case "picture":
+				return ec.fieldContext_Picture_picture(ctx, field)
+			case "url":
+				return ec.fieldContext_Picture_url(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Picture", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_txs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_address(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_address(ctx, field)
+func (ec *executionContext) _Profile_lock(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Profile_lock(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4567,26 +5205,29 @@ func (ec *executionContext) _Query_address(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Address(rctx, fc.Args["address"].(model.Address))
+		return obj.Lock, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(*model.Lock)
 	fc.Result = res
-	return ec.marshalOLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
+	return ec.marshalNLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Profile_lock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Profile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "address":
@@ -4599,22 +5240,11 @@ func (ec *executionContext) fieldContext_Query_address(ctx context.Context, fiel
 			return nil, fmt.Errorf("no field named %q was found under type Lock", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_address_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_addresses(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_addresses(ctx, field)
+func (ec *executionContext) _Profile_address(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Profile_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4627,54 +5257,38 @@ func (ec *executionContext) _Query_addresses(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Addresses(rctx, fc.Args["addresses"].([]model.Address))
+		return obj.Address, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Lock)
+	res := resTmp.(model.Address)
 	fc.Result = res
-	return ec.marshalOLock2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
+	return ec.marshalNAddress2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐAddress(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_addresses(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Profile_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Profile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "address":
-				return ec.fieldContext_Lock_address(ctx, field)
-			case "profile":
-				return ec.fieldContext_Lock_profile(ctx, field)
-			case "txs":
-				return ec.fieldContext_Lock_txs(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Lock", field.Name)
+			return nil, errors.New("field of type Address does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_addresses_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_block(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_block(ctx, field)
+func (ec *executionContext) _Profile_name(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Profile_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4687,7 +5301,7 @@ func (ec *executionContext) _Query_block(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Block(rctx, fc.Args["hash"].(model.Hash))
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4696,53 +5310,38 @@ func (ec *executionContext) _Query_block(ctx context.Context, field graphql.Coll
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Block)
+	res := resTmp.(*model.SetName)
 	fc.Result = res
-	return ec.marshalOBlock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐBlock(ctx, field.Selections, res)
+	return ec.marshalOSetName2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐSetName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_block(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Profile_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Profile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "hash":
-				return ec.fieldContext_Block_hash(ctx, field)
-			case "raw":
-				return ec.fieldContext_Block_raw(ctx, field)
-			case "timestamp":
-				return ec.fieldContext_Block_timestamp(ctx, field)
-			case "height":
-				return ec.fieldContext_Block_height(ctx, field)
-			case "size":
-				return ec.fieldContext_Block_size(ctx, field)
-			case "tx_count":
-				return ec.fieldContext_Block_tx_count(ctx, field)
-			case "txs":
-				return ec.fieldContext_Block_txs(ctx, field)
+			case "tx":
+				return ec.fieldContext_SetName_tx(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_SetName_tx_hash(ctx, field)
+			case "lock":
+				return ec.fieldContext_SetName_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_SetName_address(ctx, field)
+			case "name":
+				return ec.fieldContext_SetName_name(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Block", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SetName", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_block_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_block_newest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_block_newest(ctx, field)
+func (ec *executionContext) _Profile_profile(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Profile_profile(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4755,7 +5354,7 @@ func (ec *executionContext) _Query_block_newest(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().BlockNewest(rctx)
+		return obj.Profile, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4764,42 +5363,38 @@ func (ec *executionContext) _Query_block_newest(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Block)
+	res := resTmp.(*model.SetProfile)
 	fc.Result = res
-	return ec.marshalOBlock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐBlock(ctx, field.Selections, res)
+	return ec.marshalOSetProfile2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐSetProfile(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_block_newest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Profile_profile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Profile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "hash":
-				return ec.fieldContext_Block_hash(ctx, field)
-			case "raw":
-				return ec.fieldContext_Block_raw(ctx, field)
-			case "timestamp":
-				return ec.fieldContext_Block_timestamp(ctx, field)
-			case "height":
-				return ec.fieldContext_Block_height(ctx, field)
-			case "size":
-				return ec.fieldContext_Block_size(ctx, field)
-			case "tx_count":
-				return ec.fieldContext_Block_tx_count(ctx, field)
-			case "txs":
-				return ec.fieldContext_Block_txs(ctx, field)
+			case "tx":
+				return ec.fieldContext_SetProfile_tx(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_SetProfile_tx_hash(ctx, field)
+			case "lock":
+				return ec.fieldContext_SetProfile_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_SetProfile_address(ctx, field)
+			case "text":
+				return ec.fieldContext_SetProfile_text(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Block", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SetProfile", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_blocks(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_blocks(ctx, field)
+func (ec *executionContext) _Profile_pic(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Profile_pic(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4812,7 +5407,7 @@ func (ec *executionContext) _Query_blocks(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Blocks(rctx, fc.Args["newest"].(*bool), fc.Args["start"].(*uint32))
+		return obj.Pic, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4821,53 +5416,38 @@ func (ec *executionContext) _Query_blocks(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Block)
+	res := resTmp.(*model.SetPic)
 	fc.Result = res
-	return ec.marshalOBlock2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐBlockᚄ(ctx, field.Selections, res)
+	return ec.marshalOSetPic2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐSetPic(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_blocks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Profile_pic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Profile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "hash":
-				return ec.fieldContext_Block_hash(ctx, field)
-			case "raw":
-				return ec.fieldContext_Block_raw(ctx, field)
-			case "timestamp":
-				return ec.fieldContext_Block_timestamp(ctx, field)
-			case "height":
-				return ec.fieldContext_Block_height(ctx, field)
-			case "size":
-				return ec.fieldContext_Block_size(ctx, field)
-			case "tx_count":
-				return ec.fieldContext_Block_tx_count(ctx, field)
-			case "txs":
-				return ec.fieldContext_Block_txs(ctx, field)
+			case "tx":
+				return ec.fieldContext_SetPic_tx(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_SetPic_tx_hash(ctx, field)
+			case "lock":
+				return ec.fieldContext_SetPic_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_SetPic_address(ctx, field)
+			case "pic":
+				return ec.fieldContext_SetPic_pic(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Block", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SetPic", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_blocks_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_profiles(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_profiles(ctx, field)
+func (ec *executionContext) _Profile_image_base_url(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Profile_image_base_url(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4880,7 +5460,7 @@ func (ec *executionContext) _Query_profiles(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Profiles(rctx, fc.Args["addresses"].([]model.Address))
+		return obj.ImageBaseUrl, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4889,39 +5469,76 @@ func (ec *executionContext) _Query_profiles(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Profile)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOProfile2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐProfile(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_profiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Profile_image_base_url(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Profile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Profile_following(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Profile_following(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Following, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Follow)
+	fc.Result = res
+	return ec.marshalOFollow2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐFollow(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Profile_following(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Profile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "tx":
+				return ec.fieldContext_Follow_tx(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_Follow_tx_hash(ctx, field)
 			case "lock":
-				return ec.fieldContext_Profile_lock(ctx, field)
+				return ec.fieldContext_Follow_lock(ctx, field)
 			case "address":
-				return ec.fieldContext_Profile_address(ctx, field)
-			case "name":
-				return ec.fieldContext_Profile_name(ctx, field)
-			case "profile":
-				return ec.fieldContext_Profile_profile(ctx, field)
-			case "pic":
-				return ec.fieldContext_Profile_pic(ctx, field)
-			case "following":
-				return ec.fieldContext_Profile_following(ctx, field)
-			case "followers":
-				return ec.fieldContext_Profile_followers(ctx, field)
-			case "posts":
-				return ec.fieldContext_Profile_posts(ctx, field)
-			case "rooms":
-				return ec.fieldContext_Profile_rooms(ctx, field)
+				return ec.fieldContext_Follow_address(ctx, field)
+			case "follow_lock":
+				return ec.fieldContext_Follow_follow_lock(ctx, field)
+			case "follow_address":
+				return ec.fieldContext_Follow_follow_address(ctx, field)
+			case "unfollow":
+				return ec.fieldContext_Follow_unfollow(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Profile", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Follow", field.Name)
 		},
 	}
 	defer func() {
@@ -4931,15 +5548,15 @@ func (ec *executionContext) fieldContext_Query_profiles(ctx context.Context, fie
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_profiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Profile_following_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_posts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_posts(ctx, field)
+func (ec *executionContext) _Profile_followers(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Profile_followers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4952,7 +5569,7 @@ func (ec *executionContext) _Query_posts(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Posts(rctx, fc.Args["txHashes"].([]model.Hash))
+		return obj.Followers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4961,39 +5578,35 @@ func (ec *executionContext) _Query_posts(ctx context.Context, field graphql.Coll
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Post)
+	res := resTmp.([]*model.Follow)
 	fc.Result = res
-	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
+	return ec.marshalOFollow2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐFollow(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Profile_followers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Profile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "tx":
-				return ec.fieldContext_Post_tx(ctx, field)
+				return ec.fieldContext_Follow_tx(ctx, field)
 			case "tx_hash":
-				return ec.fieldContext_Post_tx_hash(ctx, field)
+				return ec.fieldContext_Follow_tx_hash(ctx, field)
 			case "lock":
-				return ec.fieldContext_Post_lock(ctx, field)
+				return ec.fieldContext_Follow_lock(ctx, field)
 			case "address":
-				return ec.fieldContext_Post_address(ctx, field)
-			case "text":
-				return ec.fieldContext_Post_text(ctx, field)
-			case "likes":
-				return ec.fieldContext_Post_likes(ctx, field)
-			case "parent":
-				return ec.fieldContext_Post_parent(ctx, field)
-			case "replies":
-				return ec.fieldContext_Post_replies(ctx, field)
-			case "room":
-				return ec.fieldContext_Post_room(ctx, field)
+				return ec.fieldContext_Follow_address(ctx, field)
+			case "follow_lock":
+				return ec.fieldContext_Follow_follow_lock(ctx, field)
+			case "follow_address":
+				return ec.fieldContext_Follow_follow_address(ctx, field)
+			case "unfollow":
+				return ec.fieldContext_Follow_unfollow(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Follow", field.Name)
 		},
 	}
 	defer func() {
@@ -5003,15 +5616,15 @@ func (ec *executionContext) fieldContext_Query_posts(ctx context.Context, field
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Profile_followers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_posts_newest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_posts_newest(ctx, field)
+func (ec *executionContext) _Profile_posts(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Profile_posts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5024,7 +5637,7 @@ func (ec *executionContext) _Query_posts_newest(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().PostsNewest(rctx, fc.Args["start"].(*model.Date), fc.Args["tx"].(*model.Hash), fc.Args["limit"].(*uint32))
+		return obj.Posts, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5038,12 +5651,12 @@ func (ec *executionContext) _Query_posts_newest(ctx context.Context, field graph
 	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_posts_newest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Profile_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Profile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "tx":
@@ -5064,6 +5677,14 @@ func (ec *executionContext) fieldContext_Query_posts_newest(ctx context.Context,
 				return ec.fieldContext_Post_replies(ctx, field)
 			case "room":
 				return ec.fieldContext_Post_room(ctx, field)
+			case "repost_of":
+				return ec.fieldContext_Post_repost_of(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Post_reposts(ctx, field)
+			case "repost_count":
+				return ec.fieldContext_Post_repost_count(ctx, field)
+			case "pictures":
+				return ec.fieldContext_Post_pictures(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
@@ -5075,15 +5696,15 @@ func (ec *executionContext) fieldContext_Query_posts_newest(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_posts_newest_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Profile_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_room(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_room(ctx, field)
+func (ec *executionContext) _Profile_reposts(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Profile_reposts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5096,39 +5717,56 @@ func (ec *executionContext) _Query_room(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Room(rctx, fc.Args["name"].(string))
+		return obj.Reposts, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Room)
+	res := resTmp.([]*model.Post)
 	fc.Result = res
-	return ec.marshalNRoom2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐRoom(ctx, field.Selections, res)
+	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPostᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_room(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Profile_reposts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Profile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext_Room_name(ctx, field)
-			case "posts":
-				return ec.fieldContext_Room_posts(ctx, field)
-			case "followers":
-				return ec.fieldContext_Room_followers(ctx, field)
+			case "tx":
+				return ec.fieldContext_Post_tx(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_Post_tx_hash(ctx, field)
+			case "lock":
+				return ec.fieldContext_Post_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_Post_address(ctx, field)
+			case "text":
+				return ec.fieldContext_Post_text(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Post_parent(ctx, field)
+			case "replies":
+				return ec.fieldContext_Post_replies(ctx, field)
+			case "room":
+				return ec.fieldContext_Post_room(ctx, field)
+			case "repost_of":
+				return ec.fieldContext_Post_repost_of(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Post_reposts(ctx, field)
+			case "repost_count":
+				return ec.fieldContext_Post_repost_count(ctx, field)
+			case "pictures":
+				return ec.fieldContext_Post_pictures(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Room", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
 	defer func() {
@@ -5138,15 +5776,15 @@ func (ec *executionContext) fieldContext_Query_room(ctx context.Context, field g
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_room_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Profile_reposts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _Profile_rooms(ctx context.Context, field graphql.CollectedField, obj *model.Profile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Profile_rooms(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5159,7 +5797,7 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return obj.Rooms, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5168,41 +5806,35 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]*model.RoomFollow)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalORoomFollow2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐRoomFollowᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Profile_rooms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Profile",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
 			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+				return ec.fieldContext_RoomFollow_name(ctx, field)
+			case "room":
+				return ec.fieldContext_RoomFollow_room(ctx, field)
+			case "lock":
+				return ec.fieldContext_RoomFollow_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_RoomFollow_address(ctx, field)
+			case "unfollow":
+				return ec.fieldContext_RoomFollow_unfollow(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_RoomFollow_tx_hash(ctx, field)
+			case "tx":
+				return ec.fieldContext_RoomFollow_tx(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RoomFollow", field.Name)
 		},
 	}
 	defer func() {
@@ -5212,15 +5844,15 @@ func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Profile_rooms_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _Query_tx(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_tx(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5233,7 +5865,7 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return ec.resolvers.Query().Tx(rctx, fc.Args["hash"].(model.Hash))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5242,40 +5874,57 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(*model.Tx)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalOTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
+			case "hash":
+				return ec.fieldContext_Tx_hash(ctx, field)
+			case "raw":
+				return ec.fieldContext_Tx_raw(ctx, field)
+			case "inputs":
+				return ec.fieldContext_Tx_inputs(ctx, field)
+			case "outputs":
+				return ec.fieldContext_Tx_outputs(ctx, field)
+			case "blocks":
+				return ec.fieldContext_Tx_blocks(ctx, field)
+			case "seen":
+				return ec.fieldContext_Tx_seen(ctx, field)
+			case "version":
+				return ec.fieldContext_Tx_version(ctx, field)
+			case "locktime":
+				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_tx_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Room_name(ctx context.Context, field graphql.CollectedField, obj *model.Room) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Room_name(ctx, field)
+func (ec *executionContext) _Query_txs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_txs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5288,38 +5937,66 @@ func (ec *executionContext) _Room_name(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Query().Txs(rctx, fc.Args["hashes"].([]model.Hash))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Tx)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOTx2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Room_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_txs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Room",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "hash":
+				return ec.fieldContext_Tx_hash(ctx, field)
+			case "raw":
+				return ec.fieldContext_Tx_raw(ctx, field)
+			case "inputs":
+				return ec.fieldContext_Tx_inputs(ctx, field)
+			case "outputs":
+				return ec.fieldContext_Tx_outputs(ctx, field)
+			case "blocks":
+				return ec.fieldContext_Tx_blocks(ctx, field)
+			case "seen":
+				return ec.fieldContext_Tx_seen(ctx, field)
+			case "version":
+				return ec.fieldContext_Tx_version(ctx, field)
+			case "locktime":
+				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_txs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Room_posts(ctx context.Context, field graphql.CollectedField, obj *model.Room) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Room_posts(ctx, field)
+func (ec *executionContext) _Query_address(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5332,7 +6009,7 @@ func (ec *executionContext) _Room_posts(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Posts, nil
+		return ec.resolvers.Query().Address(rctx, fc.Args["address"].(model.Address))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5341,39 +6018,27 @@ func (ec *executionContext) _Room_posts(ctx context.Context, field graphql.Colle
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Post)
+	res := resTmp.(*model.Lock)
 	fc.Result = res
-	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPostᚄ(ctx, field.Selections, res)
+	return ec.marshalOLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Room_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Room",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "tx":
-				return ec.fieldContext_Post_tx(ctx, field)
-			case "tx_hash":
-				return ec.fieldContext_Post_tx_hash(ctx, field)
-			case "lock":
-				return ec.fieldContext_Post_lock(ctx, field)
 			case "address":
-				return ec.fieldContext_Post_address(ctx, field)
-			case "text":
-				return ec.fieldContext_Post_text(ctx, field)
-			case "likes":
-				return ec.fieldContext_Post_likes(ctx, field)
-			case "parent":
-				return ec.fieldContext_Post_parent(ctx, field)
-			case "replies":
-				return ec.fieldContext_Post_replies(ctx, field)
-			case "room":
-				return ec.fieldContext_Post_room(ctx, field)
+				return ec.fieldContext_Lock_address(ctx, field)
+			case "profile":
+				return ec.fieldContext_Lock_profile(ctx, field)
+			case "txs":
+				return ec.fieldContext_Lock_txs(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Lock", field.Name)
 		},
 	}
 	defer func() {
@@ -5383,15 +6048,15 @@ func (ec *executionContext) fieldContext_Room_posts(ctx context.Context, field g
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Room_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_address_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Room_followers(ctx context.Context, field graphql.CollectedField, obj *model.Room) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Room_followers(ctx, field)
+func (ec *executionContext) _Query_addresses(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_addresses(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5404,7 +6069,7 @@ func (ec *executionContext) _Room_followers(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Followers, nil
+		return ec.resolvers.Query().Addresses(rctx, fc.Args["addresses"].([]model.Address))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5413,35 +6078,27 @@ func (ec *executionContext) _Room_followers(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.RoomFollow)
+	res := resTmp.([]*model.Lock)
 	fc.Result = res
-	return ec.marshalORoomFollow2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐRoomFollowᚄ(ctx, field.Selections, res)
+	return ec.marshalOLock2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Room_followers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_addresses(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Room",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext_RoomFollow_name(ctx, field)
-			case "room":
-				return ec.fieldContext_RoomFollow_room(ctx, field)
-			case "lock":
-				return ec.fieldContext_RoomFollow_lock(ctx, field)
 			case "address":
-				return ec.fieldContext_RoomFollow_address(ctx, field)
-			case "unfollow":
-				return ec.fieldContext_RoomFollow_unfollow(ctx, field)
-			case "tx_hash":
-				return ec.fieldContext_RoomFollow_tx_hash(ctx, field)
-			case "tx":
-				return ec.fieldContext_RoomFollow_tx(ctx, field)
+				return ec.fieldContext_Lock_address(ctx, field)
+			case "profile":
+				return ec.fieldContext_Lock_profile(ctx, field)
+			case "txs":
+				return ec.fieldContext_Lock_txs(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RoomFollow", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Lock", field.Name)
 		},
 	}
 	defer func() {
@@ -5451,15 +6108,15 @@ func (ec *executionContext) fieldContext_Room_followers(ctx context.Context, fie
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Room_followers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_addresses_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RoomFollow_name(ctx context.Context, field graphql.CollectedField, obj *model.RoomFollow) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RoomFollow_name(ctx, field)
+func (ec *executionContext) _Query_block(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_block(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5472,38 +6129,62 @@ func (ec *executionContext) _RoomFollow_name(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Query().Block(rctx, fc.Args["hash"].(model.Hash))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Block)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOBlock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐBlock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RoomFollow_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_block(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RoomFollow",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "hash":
+				return ec.fieldContext_Block_hash(ctx, field)
+			case "raw":
+				return ec.fieldContext_Block_raw(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_Block_timestamp(ctx, field)
+			case "height":
+				return ec.fieldContext_Block_height(ctx, field)
+			case "size":
+				return ec.fieldContext_Block_size(ctx, field)
+			case "tx_count":
+				return ec.fieldContext_Block_tx_count(ctx, field)
+			case "txs":
+				return ec.fieldContext_Block_txs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Block", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_block_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RoomFollow_room(ctx context.Context, field graphql.CollectedField, obj *model.RoomFollow) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RoomFollow_room(ctx, field)
+func (ec *executionContext) _Query_block_newest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_block_newest(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5516,46 +6197,51 @@ func (ec *executionContext) _RoomFollow_room(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Room, nil
+		return ec.resolvers.Query().BlockNewest(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Room)
+	res := resTmp.(*model.Block)
 	fc.Result = res
-	return ec.marshalNRoom2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐRoom(ctx, field.Selections, res)
+	return ec.marshalOBlock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐBlock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RoomFollow_room(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_block_newest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RoomFollow",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext_Room_name(ctx, field)
-			case "posts":
-				return ec.fieldContext_Room_posts(ctx, field)
-			case "followers":
-				return ec.fieldContext_Room_followers(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Room", field.Name)
-		},
-	}
-	return fc, nil
+			case "hash":
+				return ec.fieldContext_Block_hash(ctx, field)
+			case "raw":
+				return ec.fieldContext_Block_raw(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_Block_timestamp(ctx, field)
+			case "height":
+				return ec.fieldContext_Block_height(ctx, field)
+			case "size":
+				return ec.fieldContext_Block_size(ctx, field)
+			case "tx_count":
+				return ec.fieldContext_Block_tx_count(ctx, field)
+			case "txs":
+				return ec.fieldContext_Block_txs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Block", field.Name)
+		},
+	}
+	return fc, nil
 }
 
-func (ec *executionContext) _RoomFollow_lock(ctx context.Context, field graphql.CollectedField, obj *model.RoomFollow) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RoomFollow_lock(ctx, field)
+func (ec *executionContext) _Query_blocks(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_blocks(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5568,46 +6254,62 @@ func (ec *executionContext) _RoomFollow_lock(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Lock, nil
+		return ec.resolvers.Query().Blocks(rctx, fc.Args["newest"].(*bool), fc.Args["start"].(*uint32))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Lock)
+	res := resTmp.([]*model.Block)
 	fc.Result = res
-	return ec.marshalNLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
+	return ec.marshalOBlock2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐBlockᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RoomFollow_lock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_blocks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RoomFollow",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "address":
-				return ec.fieldContext_Lock_address(ctx, field)
-			case "profile":
-				return ec.fieldContext_Lock_profile(ctx, field)
+			case "hash":
+				return ec.fieldContext_Block_hash(ctx, field)
+			case "raw":
+				return ec.fieldContext_Block_raw(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_Block_timestamp(ctx, field)
+			case "height":
+				return ec.fieldContext_Block_height(ctx, field)
+			case "size":
+				return ec.fieldContext_Block_size(ctx, field)
+			case "tx_count":
+				return ec.fieldContext_Block_tx_count(ctx, field)
 			case "txs":
-				return ec.fieldContext_Lock_txs(ctx, field)
+				return ec.fieldContext_Block_txs(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Lock", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Block", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_blocks_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RoomFollow_address(ctx context.Context, field graphql.CollectedField, obj *model.RoomFollow) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RoomFollow_address(ctx, field)
+func (ec *executionContext) _Query_profiles(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_profiles(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5620,38 +6322,70 @@ func (ec *executionContext) _RoomFollow_address(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return ec.resolvers.Query().Profiles(rctx, fc.Args["addresses"].([]model.Address))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Address)
+	res := resTmp.([]*model.Profile)
 	fc.Result = res
-	return ec.marshalNAddress2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐAddress(ctx, field.Selections, res)
+	return ec.marshalOProfile2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐProfile(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RoomFollow_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_profiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RoomFollow",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Address does not have child fields")
+			switch field.Name {
+			case "lock":
+				return ec.fieldContext_Profile_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_Profile_address(ctx, field)
+			case "name":
+				return ec.fieldContext_Profile_name(ctx, field)
+			case "profile":
+				return ec.fieldContext_Profile_profile(ctx, field)
+			case "pic":
+				return ec.fieldContext_Profile_pic(ctx, field)
+			case "image_base_url":
+				return ec.fieldContext_Profile_image_base_url(ctx, field)
+			case "following":
+				return ec.fieldContext_Profile_following(ctx, field)
+			case "followers":
+				return ec.fieldContext_Profile_followers(ctx, field)
+			case "posts":
+				return ec.fieldContext_Profile_posts(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Profile_reposts(ctx, field)
+			case "rooms":
+				return ec.fieldContext_Profile_rooms(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Profile", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_profiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RoomFollow_unfollow(ctx context.Context, field graphql.CollectedField, obj *model.RoomFollow) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RoomFollow_unfollow(ctx, field)
+func (ec *executionContext) _Query_posts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_posts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5664,38 +6398,74 @@ func (ec *executionContext) _RoomFollow_unfollow(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Unfollow, nil
+		return ec.resolvers.Query().Posts(rctx, fc.Args["txHashes"].([]model.Hash))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]*model.Post)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RoomFollow_unfollow(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RoomFollow",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "tx":
+				return ec.fieldContext_Post_tx(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_Post_tx_hash(ctx, field)
+			case "lock":
+				return ec.fieldContext_Post_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_Post_address(ctx, field)
+			case "text":
+				return ec.fieldContext_Post_text(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Post_parent(ctx, field)
+			case "replies":
+				return ec.fieldContext_Post_replies(ctx, field)
+			case "room":
+				return ec.fieldContext_Post_room(ctx, field)
+			case "repost_of":
+				return ec.fieldContext_Post_repost_of(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Post_reposts(ctx, field)
+			case "repost_count":
+				return ec.fieldContext_Post_repost_count(ctx, field)
+			case "pictures":
+				return ec.fieldContext_Post_pictures(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RoomFollow_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.RoomFollow) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RoomFollow_tx_hash(ctx, field)
+func (ec *executionContext) _Query_posts_newest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_posts_newest(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5708,38 +6478,74 @@ func (ec *executionContext) _RoomFollow_tx_hash(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TxHash, nil
+		return ec.resolvers.Query().PostsNewest(rctx, fc.Args["start"].(*model.Date), fc.Args["tx"].(*model.Hash), fc.Args["limit"].(*uint32))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Hash)
+	res := resTmp.([]*model.Post)
 	fc.Result = res
-	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
+	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RoomFollow_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_posts_newest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RoomFollow",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Hash does not have child fields")
+			switch field.Name {
+			case "tx":
+				return ec.fieldContext_Post_tx(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_Post_tx_hash(ctx, field)
+			case "lock":
+				return ec.fieldContext_Post_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_Post_address(ctx, field)
+			case "text":
+				return ec.fieldContext_Post_text(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Post_parent(ctx, field)
+			case "replies":
+				return ec.fieldContext_Post_replies(ctx, field)
+			case "room":
+				return ec.fieldContext_Post_room(ctx, field)
+			case "repost_of":
+				return ec.fieldContext_Post_repost_of(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Post_reposts(ctx, field)
+			case "repost_count":
+				return ec.fieldContext_Post_repost_count(ctx, field)
+			case "pictures":
+				return ec.fieldContext_Post_pictures(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_posts_newest_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RoomFollow_tx(ctx context.Context, field graphql.CollectedField, obj *model.RoomFollow) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RoomFollow_tx(ctx, field)
+func (ec *executionContext) _Query_room(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_room(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5752,7 +6558,7 @@ func (ec *executionContext) _RoomFollow_tx(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tx, nil
+		return ec.resolvers.Query().Room(rctx, fc.Args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5764,44 +6570,45 @@ func (ec *executionContext) _RoomFollow_tx(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Tx)
+	res := resTmp.(*model.Room)
 	fc.Result = res
-	return ec.marshalNTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
+	return ec.marshalNRoom2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐRoom(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RoomFollow_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_room(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RoomFollow",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "hash":
-				return ec.fieldContext_Tx_hash(ctx, field)
-			case "raw":
-				return ec.fieldContext_Tx_raw(ctx, field)
-			case "inputs":
-				return ec.fieldContext_Tx_inputs(ctx, field)
-			case "outputs":
-				return ec.fieldContext_Tx_outputs(ctx, field)
-			case "blocks":
-				return ec.fieldContext_Tx_blocks(ctx, field)
-			case "seen":
-				return ec.fieldContext_Tx_seen(ctx, field)
-			case "version":
-				return ec.fieldContext_Tx_version(ctx, field)
-			case "locktime":
-				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "name":
+				return ec.fieldContext_Room_name(ctx, field)
+			case "posts":
+				return ec.fieldContext_Room_posts(ctx, field)
+			case "followers":
+				return ec.fieldContext_Room_followers(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Room", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_room_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetName_tx(ctx context.Context, field graphql.CollectedField, obj *model.SetName) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetName_tx(ctx, field)
+func (ec *executionContext) _Query_thread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_thread(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5814,56 +6621,62 @@ func (ec *executionContext) _SetName_tx(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tx, nil
+		return ec.resolvers.Query().Thread(rctx, fc.Args["root"].(model.Hash), fc.Args["maxDepth"].(*int), fc.Args["sort"].(*model.ThreadSort))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Tx)
+	res := resTmp.([]*model.ThreadNode)
 	fc.Result = res
-	return ec.marshalNTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
+	return ec.marshalOThreadNode2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐThreadNodeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetName_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_thread(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetName",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "hash":
-				return ec.fieldContext_Tx_hash(ctx, field)
-			case "raw":
-				return ec.fieldContext_Tx_raw(ctx, field)
-			case "inputs":
-				return ec.fieldContext_Tx_inputs(ctx, field)
-			case "outputs":
-				return ec.fieldContext_Tx_outputs(ctx, field)
-			case "blocks":
-				return ec.fieldContext_Tx_blocks(ctx, field)
-			case "seen":
-				return ec.fieldContext_Tx_seen(ctx, field)
-			case "version":
-				return ec.fieldContext_Tx_version(ctx, field)
-			case "locktime":
-				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_ThreadNode_tx_hash(ctx, field)
+			case "parent_tx_hash":
+				return ec.fieldContext_ThreadNode_parent_tx_hash(ctx, field)
+			case "path":
+				return ec.fieldContext_ThreadNode_path(ctx, field)
+			case "depth":
+				return ec.fieldContext_ThreadNode_depth(ctx, field)
+			case "reply_count":
+				return ec.fieldContext_ThreadNode_reply_count(ctx, field)
+			case "like_count":
+				return ec.fieldContext_ThreadNode_like_count(ctx, field)
+			case "post":
+				return ec.fieldContext_ThreadNode_post(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ThreadNode", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_thread_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetName_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.SetName) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetName_tx_hash(ctx, field)
+func (ec *executionContext) _Query_broadcast_result(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_broadcast_result(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5876,38 +6689,60 @@ func (ec *executionContext) _SetName_tx_hash(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TxHash, nil
+		return ec.resolvers.Query().BroadcastResult(rctx, fc.Args["hash"].(model.Hash))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Hash)
+	res := resTmp.(*model.BroadcastResult)
 	fc.Result = res
-	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
+	return ec.marshalOBroadcastResult2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐBroadcastResult(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetName_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_broadcast_result(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetName",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Hash does not have child fields")
+			switch field.Name {
+			case "tx_hash":
+				return ec.fieldContext_BroadcastResult_tx_hash(ctx, field)
+			case "status":
+				return ec.fieldContext_BroadcastResult_status(ctx, field)
+			case "code":
+				return ec.fieldContext_BroadcastResult_code(ctx, field)
+			case "reason":
+				return ec.fieldContext_BroadcastResult_reason(ctx, field)
+			case "host":
+				return ec.fieldContext_BroadcastResult_host(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_BroadcastResult_timestamp(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BroadcastResult", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_broadcast_result_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetName_lock(ctx context.Context, field graphql.CollectedField, obj *model.SetName) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetName_lock(ctx, field)
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5920,46 +6755,68 @@ func (ec *executionContext) _SetName_lock(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Lock, nil
+		return ec.introspectType(fc.Args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Lock)
+	res := resTmp.(*introspection.Type)
 	fc.Result = res
-	return ec.marshalNLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetName_lock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetName",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "address":
-				return ec.fieldContext_Lock_address(ctx, field)
-			case "profile":
-				return ec.fieldContext_Lock_profile(ctx, field)
-			case "txs":
-				return ec.fieldContext_Lock_txs(ctx, field)
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Lock", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetName_address(ctx context.Context, field graphql.CollectedField, obj *model.SetName) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetName_address(ctx, field)
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5972,38 +6829,49 @@ func (ec *executionContext) _SetName_address(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return ec.introspectSchema()
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Address)
+	res := resTmp.(*introspection.Schema)
 	fc.Result = res
-	return ec.marshalNAddress2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐAddress(ctx, field.Selections, res)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetName_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetName",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Address does not have child fields")
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetName_name(ctx context.Context, field graphql.CollectedField, obj *model.SetName) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetName_name(ctx, field)
+func (ec *executionContext) _Room_name(ctx context.Context, field graphql.CollectedField, obj *model.Room) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Room_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6033,9 +6901,9 @@ func (ec *executionContext) _SetName_name(ctx context.Context, field graphql.Col
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetName_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Room_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetName",
+		Object:     "Room",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6046,8 +6914,8 @@ func (ec *executionContext) fieldContext_SetName_name(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _SetPic_tx(ctx context.Context, field graphql.CollectedField, obj *model.SetPic) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetPic_tx(ctx, field)
+func (ec *executionContext) _Room_posts(ctx context.Context, field graphql.CollectedField, obj *model.Room) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Room_posts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6060,56 +6928,74 @@ func (ec *executionContext) _SetPic_tx(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tx, nil
+		return obj.Posts, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Tx)
+	res := resTmp.([]*model.Post)
 	fc.Result = res
-	return ec.marshalNTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
+	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPostᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetPic_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Room_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetPic",
+		Object:     "Room",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "hash":
-				return ec.fieldContext_Tx_hash(ctx, field)
-			case "raw":
-				return ec.fieldContext_Tx_raw(ctx, field)
-			case "inputs":
-				return ec.fieldContext_Tx_inputs(ctx, field)
-			case "outputs":
-				return ec.fieldContext_Tx_outputs(ctx, field)
-			case "blocks":
-				return ec.fieldContext_Tx_blocks(ctx, field)
-			case "seen":
-				return ec.fieldContext_Tx_seen(ctx, field)
-			case "version":
-				return ec.fieldContext_Tx_version(ctx, field)
-			case "locktime":
-				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "tx":
+				return ec.fieldContext_Post_tx(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_Post_tx_hash(ctx, field)
+			case "lock":
+				return ec.fieldContext_Post_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_Post_address(ctx, field)
+			case "text":
+				return ec.fieldContext_Post_text(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Post_parent(ctx, field)
+			case "replies":
+				return ec.fieldContext_Post_replies(ctx, field)
+			case "room":
+				return ec.fieldContext_Post_room(ctx, field)
+			case "repost_of":
+				return ec.fieldContext_Post_repost_of(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Post_reposts(ctx, field)
+			case "repost_count":
+				return ec.fieldContext_Post_repost_count(ctx, field)
+			case "pictures":
+				return ec.fieldContext_Post_pictures(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Room_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetPic_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.SetPic) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetPic_tx_hash(ctx, field)
+func (ec *executionContext) _Room_followers(ctx context.Context, field graphql.CollectedField, obj *model.Room) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Room_followers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6122,38 +7008,62 @@ func (ec *executionContext) _SetPic_tx_hash(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TxHash, nil
+		return obj.Followers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Hash)
+	res := resTmp.([]*model.RoomFollow)
 	fc.Result = res
-	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
+	return ec.marshalORoomFollow2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐRoomFollowᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetPic_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Room_followers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetPic",
+		Object:     "Room",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Hash does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_RoomFollow_name(ctx, field)
+			case "room":
+				return ec.fieldContext_RoomFollow_room(ctx, field)
+			case "lock":
+				return ec.fieldContext_RoomFollow_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_RoomFollow_address(ctx, field)
+			case "unfollow":
+				return ec.fieldContext_RoomFollow_unfollow(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_RoomFollow_tx_hash(ctx, field)
+			case "tx":
+				return ec.fieldContext_RoomFollow_tx(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RoomFollow", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Room_followers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetPic_lock(ctx context.Context, field graphql.CollectedField, obj *model.SetPic) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetPic_lock(ctx, field)
+func (ec *executionContext) _RoomFollow_name(ctx context.Context, field graphql.CollectedField, obj *model.RoomFollow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RoomFollow_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6166,7 +7076,7 @@ func (ec *executionContext) _SetPic_lock(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Lock, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6178,34 +7088,26 @@ func (ec *executionContext) _SetPic_lock(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Lock)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetPic_lock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RoomFollow_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetPic",
+		Object:     "RoomFollow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "address":
-				return ec.fieldContext_Lock_address(ctx, field)
-			case "profile":
-				return ec.fieldContext_Lock_profile(ctx, field)
-			case "txs":
-				return ec.fieldContext_Lock_txs(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Lock", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetPic_address(ctx context.Context, field graphql.CollectedField, obj *model.SetPic) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetPic_address(ctx, field)
+func (ec *executionContext) _RoomFollow_room(ctx context.Context, field graphql.CollectedField, obj *model.RoomFollow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RoomFollow_room(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6218,7 +7120,7 @@ func (ec *executionContext) _SetPic_address(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return obj.Room, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6230,26 +7132,34 @@ func (ec *executionContext) _SetPic_address(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Address)
+	res := resTmp.(*model.Room)
 	fc.Result = res
-	return ec.marshalNAddress2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐAddress(ctx, field.Selections, res)
+	return ec.marshalNRoom2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐRoom(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetPic_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RoomFollow_room(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetPic",
+		Object:     "RoomFollow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Address does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_Room_name(ctx, field)
+			case "posts":
+				return ec.fieldContext_Room_posts(ctx, field)
+			case "followers":
+				return ec.fieldContext_Room_followers(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Room", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetPic_pic(ctx context.Context, field graphql.CollectedField, obj *model.SetPic) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetPic_pic(ctx, field)
+func (ec *executionContext) _RoomFollow_lock(ctx context.Context, field graphql.CollectedField, obj *model.RoomFollow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RoomFollow_lock(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6262,7 +7172,7 @@ func (ec *executionContext) _SetPic_pic(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Pic, nil
+		return obj.Lock, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6274,26 +7184,34 @@ func (ec *executionContext) _SetPic_pic(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Lock)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetPic_pic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RoomFollow_lock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetPic",
+		Object:     "RoomFollow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_Lock_address(ctx, field)
+			case "profile":
+				return ec.fieldContext_Lock_profile(ctx, field)
+			case "txs":
+				return ec.fieldContext_Lock_txs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Lock", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetProfile_tx(ctx context.Context, field graphql.CollectedField, obj *model.SetProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetProfile_tx(ctx, field)
+func (ec *executionContext) _RoomFollow_address(ctx context.Context, field graphql.CollectedField, obj *model.RoomFollow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RoomFollow_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6306,7 +7224,7 @@ func (ec *executionContext) _SetProfile_tx(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tx, nil
+		return obj.Address, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6318,44 +7236,26 @@ func (ec *executionContext) _SetProfile_tx(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Tx)
+	res := resTmp.(model.Address)
 	fc.Result = res
-	return ec.marshalNTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
+	return ec.marshalNAddress2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐAddress(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetProfile_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RoomFollow_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetProfile",
+		Object:     "RoomFollow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "hash":
-				return ec.fieldContext_Tx_hash(ctx, field)
-			case "raw":
-				return ec.fieldContext_Tx_raw(ctx, field)
-			case "inputs":
-				return ec.fieldContext_Tx_inputs(ctx, field)
-			case "outputs":
-				return ec.fieldContext_Tx_outputs(ctx, field)
-			case "blocks":
-				return ec.fieldContext_Tx_blocks(ctx, field)
-			case "seen":
-				return ec.fieldContext_Tx_seen(ctx, field)
-			case "version":
-				return ec.fieldContext_Tx_version(ctx, field)
-			case "locktime":
-				return ec.fieldContext_Tx_locktime(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
+			return nil, errors.New("field of type Address does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetProfile_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.SetProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetProfile_tx_hash(ctx, field)
+func (ec *executionContext) _RoomFollow_unfollow(ctx context.Context, field graphql.CollectedField, obj *model.RoomFollow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RoomFollow_unfollow(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6368,7 +7268,7 @@ func (ec *executionContext) _SetProfile_tx_hash(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TxHash, nil
+		return obj.Unfollow, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6380,26 +7280,26 @@ func (ec *executionContext) _SetProfile_tx_hash(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Hash)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetProfile_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RoomFollow_unfollow(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetProfile",
+		Object:     "RoomFollow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Hash does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetProfile_lock(ctx context.Context, field graphql.CollectedField, obj *model.SetProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetProfile_lock(ctx, field)
+func (ec *executionContext) _RoomFollow_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.RoomFollow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RoomFollow_tx_hash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6412,7 +7312,7 @@ func (ec *executionContext) _SetProfile_lock(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Lock, nil
+		return obj.TxHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6424,34 +7324,26 @@ func (ec *executionContext) _SetProfile_lock(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Lock)
+	res := resTmp.(model.Hash)
 	fc.Result = res
-	return ec.marshalNLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
+	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetProfile_lock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RoomFollow_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetProfile",
+		Object:     "RoomFollow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "address":
-				return ec.fieldContext_Lock_address(ctx, field)
-			case "profile":
-				return ec.fieldContext_Lock_profile(ctx, field)
-			case "txs":
-				return ec.fieldContext_Lock_txs(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Lock", field.Name)
+			return nil, errors.New("field of type Hash does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetProfile_address(ctx context.Context, field graphql.CollectedField, obj *model.SetProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetProfile_address(ctx, field)
+func (ec *executionContext) _RoomFollow_tx(ctx context.Context, field graphql.CollectedField, obj *model.RoomFollow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RoomFollow_tx(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6464,7 +7356,7 @@ func (ec *executionContext) _SetProfile_address(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return obj.Tx, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6476,26 +7368,46 @@ func (ec *executionContext) _SetProfile_address(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Address)
+	res := resTmp.(*model.Tx)
 	fc.Result = res
-	return ec.marshalNAddress2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐAddress(ctx, field.Selections, res)
+	return ec.marshalNTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetProfile_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RoomFollow_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetProfile",
+		Object:     "RoomFollow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Address does not have child fields")
+			switch field.Name {
+			case "hash":
+				return ec.fieldContext_Tx_hash(ctx, field)
+			case "raw":
+				return ec.fieldContext_Tx_raw(ctx, field)
+			case "inputs":
+				return ec.fieldContext_Tx_inputs(ctx, field)
+			case "outputs":
+				return ec.fieldContext_Tx_outputs(ctx, field)
+			case "blocks":
+				return ec.fieldContext_Tx_blocks(ctx, field)
+			case "seen":
+				return ec.fieldContext_Tx_seen(ctx, field)
+			case "version":
+				return ec.fieldContext_Tx_version(ctx, field)
+			case "locktime":
+				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetProfile_text(ctx context.Context, field graphql.CollectedField, obj *model.SetProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetProfile_text(ctx, field)
+func (ec *executionContext) _SetName_tx(ctx context.Context, field graphql.CollectedField, obj *model.SetName) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetName_tx(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6508,7 +7420,7 @@ func (ec *executionContext) _SetProfile_text(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Text, nil
+		return obj.Tx, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6520,90 +7432,46 @@ func (ec *executionContext) _SetProfile_text(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Tx)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetProfile_text(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetName_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetProfile",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _SlpBaton_output(ctx context.Context, field graphql.CollectedField, obj *model.SlpBaton) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpBaton_output(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Output, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(*model.TxOutput)
-	fc.Result = res
-	return ec.marshalNTxOutput2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTxOutput(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_SlpBaton_output(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SlpBaton",
+		Object:     "SetName",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "tx":
-				return ec.fieldContext_TxOutput_tx(ctx, field)
 			case "hash":
-				return ec.fieldContext_TxOutput_hash(ctx, field)
-			case "index":
-				return ec.fieldContext_TxOutput_index(ctx, field)
-			case "amount":
-				return ec.fieldContext_TxOutput_amount(ctx, field)
-			case "script":
-				return ec.fieldContext_TxOutput_script(ctx, field)
-			case "spends":
-				return ec.fieldContext_TxOutput_spends(ctx, field)
-			case "slp":
-				return ec.fieldContext_TxOutput_slp(ctx, field)
-			case "slp_baton":
-				return ec.fieldContext_TxOutput_slp_baton(ctx, field)
-			case "lock":
-				return ec.fieldContext_TxOutput_lock(ctx, field)
+				return ec.fieldContext_Tx_hash(ctx, field)
+			case "raw":
+				return ec.fieldContext_Tx_raw(ctx, field)
+			case "inputs":
+				return ec.fieldContext_Tx_inputs(ctx, field)
+			case "outputs":
+				return ec.fieldContext_Tx_outputs(ctx, field)
+			case "blocks":
+				return ec.fieldContext_Tx_blocks(ctx, field)
+			case "seen":
+				return ec.fieldContext_Tx_seen(ctx, field)
+			case "version":
+				return ec.fieldContext_Tx_version(ctx, field)
+			case "locktime":
+				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TxOutput", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpBaton_hash(ctx context.Context, field graphql.CollectedField, obj *model.SlpBaton) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpBaton_hash(ctx, field)
+func (ec *executionContext) _SetName_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.SetName) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetName_tx_hash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6616,7 +7484,7 @@ func (ec *executionContext) _SlpBaton_hash(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hash, nil
+		return obj.TxHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6633,9 +7501,9 @@ func (ec *executionContext) _SlpBaton_hash(ctx context.Context, field graphql.Co
 	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpBaton_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetName_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpBaton",
+		Object:     "SetName",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6646,8 +7514,8 @@ func (ec *executionContext) fieldContext_SlpBaton_hash(ctx context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpBaton_index(ctx context.Context, field graphql.CollectedField, obj *model.SlpBaton) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpBaton_index(ctx, field)
+func (ec *executionContext) _SetName_lock(ctx context.Context, field graphql.CollectedField, obj *model.SetName) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetName_lock(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6660,7 +7528,7 @@ func (ec *executionContext) _SlpBaton_index(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Index, nil
+		return obj.Lock, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6672,26 +7540,34 @@ func (ec *executionContext) _SlpBaton_index(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint32)
+	res := resTmp.(*model.Lock)
 	fc.Result = res
-	return ec.marshalNUint322uint32(ctx, field.Selections, res)
+	return ec.marshalNLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpBaton_index(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetName_lock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpBaton",
+		Object:     "SetName",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Uint32 does not have child fields")
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_Lock_address(ctx, field)
+			case "profile":
+				return ec.fieldContext_Lock_profile(ctx, field)
+			case "txs":
+				return ec.fieldContext_Lock_txs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Lock", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpBaton_token_hash(ctx context.Context, field graphql.CollectedField, obj *model.SlpBaton) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpBaton_token_hash(ctx, field)
+func (ec *executionContext) _SetName_address(ctx context.Context, field graphql.CollectedField, obj *model.SetName) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetName_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6704,7 +7580,7 @@ func (ec *executionContext) _SlpBaton_token_hash(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TokenHash, nil
+		return obj.Address, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6716,26 +7592,26 @@ func (ec *executionContext) _SlpBaton_token_hash(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Hash)
+	res := resTmp.(model.Address)
 	fc.Result = res
-	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
+	return ec.marshalNAddress2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐAddress(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpBaton_token_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetName_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpBaton",
+		Object:     "SetName",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Hash does not have child fields")
+			return nil, errors.New("field of type Address does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpBaton_genesis(ctx context.Context, field graphql.CollectedField, obj *model.SlpBaton) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpBaton_genesis(ctx, field)
+func (ec *executionContext) _SetName_name(ctx context.Context, field graphql.CollectedField, obj *model.SetName) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetName_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6748,59 +7624,38 @@ func (ec *executionContext) _SlpBaton_genesis(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Genesis, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.SlpGenesis)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOSlpGenesis2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐSlpGenesis(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpBaton_genesis(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetName_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpBaton",
+		Object:     "SetName",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "tx":
-				return ec.fieldContext_SlpGenesis_tx(ctx, field)
-			case "hash":
-				return ec.fieldContext_SlpGenesis_hash(ctx, field)
-			case "token_type":
-				return ec.fieldContext_SlpGenesis_token_type(ctx, field)
-			case "decimals":
-				return ec.fieldContext_SlpGenesis_decimals(ctx, field)
-			case "output":
-				return ec.fieldContext_SlpGenesis_output(ctx, field)
-			case "baton":
-				return ec.fieldContext_SlpGenesis_baton(ctx, field)
-			case "baton_index":
-				return ec.fieldContext_SlpGenesis_baton_index(ctx, field)
-			case "ticker":
-				return ec.fieldContext_SlpGenesis_ticker(ctx, field)
-			case "name":
-				return ec.fieldContext_SlpGenesis_name(ctx, field)
-			case "doc_url":
-				return ec.fieldContext_SlpGenesis_doc_url(ctx, field)
-			case "doc_hash":
-				return ec.fieldContext_SlpGenesis_doc_hash(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SlpGenesis", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpGenesis_tx(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpGenesis_tx(ctx, field)
+func (ec *executionContext) _SetPic_tx(ctx context.Context, field graphql.CollectedField, obj *model.SetPic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetPic_tx(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6830,9 +7685,9 @@ func (ec *executionContext) _SlpGenesis_tx(ctx context.Context, field graphql.Co
 	return ec.marshalNTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpGenesis_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetPic_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpGenesis",
+		Object:     "SetPic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6854,6 +7709,8 @@ func (ec *executionContext) fieldContext_SlpGenesis_tx(ctx context.Context, fiel
 				return ec.fieldContext_Tx_version(ctx, field)
 			case "locktime":
 				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
@@ -6861,8 +7718,8 @@ func (ec *executionContext) fieldContext_SlpGenesis_tx(ctx context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpGenesis_hash(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpGenesis_hash(ctx, field)
+func (ec *executionContext) _SetPic_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.SetPic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetPic_tx_hash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6875,7 +7732,7 @@ func (ec *executionContext) _SlpGenesis_hash(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hash, nil
+		return obj.TxHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6892,9 +7749,9 @@ func (ec *executionContext) _SlpGenesis_hash(ctx context.Context, field graphql.
 	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpGenesis_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetPic_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpGenesis",
+		Object:     "SetPic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6905,8 +7762,8 @@ func (ec *executionContext) fieldContext_SlpGenesis_hash(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpGenesis_token_type(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpGenesis_token_type(ctx, field)
+func (ec *executionContext) _SetPic_lock(ctx context.Context, field graphql.CollectedField, obj *model.SetPic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetPic_lock(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6919,7 +7776,7 @@ func (ec *executionContext) _SlpGenesis_token_type(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TokenType, nil
+		return obj.Lock, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6931,26 +7788,34 @@ func (ec *executionContext) _SlpGenesis_token_type(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Uint8)
+	res := resTmp.(*model.Lock)
 	fc.Result = res
-	return ec.marshalNUint82githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐUint8(ctx, field.Selections, res)
+	return ec.marshalNLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpGenesis_token_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetPic_lock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpGenesis",
+		Object:     "SetPic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Uint8 does not have child fields")
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_Lock_address(ctx, field)
+			case "profile":
+				return ec.fieldContext_Lock_profile(ctx, field)
+			case "txs":
+				return ec.fieldContext_Lock_txs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Lock", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpGenesis_decimals(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpGenesis_decimals(ctx, field)
+func (ec *executionContext) _SetPic_address(ctx context.Context, field graphql.CollectedField, obj *model.SetPic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetPic_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6963,7 +7828,7 @@ func (ec *executionContext) _SlpGenesis_decimals(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Decimals, nil
+		return obj.Address, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6975,26 +7840,26 @@ func (ec *executionContext) _SlpGenesis_decimals(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Uint8)
+	res := resTmp.(model.Address)
 	fc.Result = res
-	return ec.marshalNUint82githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐUint8(ctx, field.Selections, res)
+	return ec.marshalNAddress2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐAddress(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpGenesis_decimals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetPic_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpGenesis",
+		Object:     "SetPic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Uint8 does not have child fields")
+			return nil, errors.New("field of type Address does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpGenesis_output(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpGenesis_output(ctx, field)
+func (ec *executionContext) _SetPic_pic(ctx context.Context, field graphql.CollectedField, obj *model.SetPic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetPic_pic(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7007,7 +7872,7 @@ func (ec *executionContext) _SlpGenesis_output(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Output, nil
+		return obj.Pic, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7019,40 +7884,26 @@ func (ec *executionContext) _SlpGenesis_output(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.SlpOutput)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNSlpOutput2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐSlpOutput(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpGenesis_output(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetPic_pic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpGenesis",
+		Object:     "SetPic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "output":
-				return ec.fieldContext_SlpOutput_output(ctx, field)
-			case "hash":
-				return ec.fieldContext_SlpOutput_hash(ctx, field)
-			case "index":
-				return ec.fieldContext_SlpOutput_index(ctx, field)
-			case "amount":
-				return ec.fieldContext_SlpOutput_amount(ctx, field)
-			case "token_hash":
-				return ec.fieldContext_SlpOutput_token_hash(ctx, field)
-			case "genesis":
-				return ec.fieldContext_SlpOutput_genesis(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SlpOutput", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpGenesis_baton(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpGenesis_baton(ctx, field)
+func (ec *executionContext) _SetProfile_tx(ctx context.Context, field graphql.CollectedField, obj *model.SetProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetProfile_tx(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7065,7 +7916,7 @@ func (ec *executionContext) _SlpGenesis_baton(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Baton, nil
+		return obj.Tx, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7077,82 +7928,46 @@ func (ec *executionContext) _SlpGenesis_baton(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.SlpBaton)
+	res := resTmp.(*model.Tx)
 	fc.Result = res
-	return ec.marshalNSlpBaton2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐSlpBaton(ctx, field.Selections, res)
+	return ec.marshalNTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpGenesis_baton(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetProfile_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpGenesis",
+		Object:     "SetProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "output":
-				return ec.fieldContext_SlpBaton_output(ctx, field)
 			case "hash":
-				return ec.fieldContext_SlpBaton_hash(ctx, field)
-			case "index":
-				return ec.fieldContext_SlpBaton_index(ctx, field)
-			case "token_hash":
-				return ec.fieldContext_SlpBaton_token_hash(ctx, field)
-			case "genesis":
-				return ec.fieldContext_SlpBaton_genesis(ctx, field)
+				return ec.fieldContext_Tx_hash(ctx, field)
+			case "raw":
+				return ec.fieldContext_Tx_raw(ctx, field)
+			case "inputs":
+				return ec.fieldContext_Tx_inputs(ctx, field)
+			case "outputs":
+				return ec.fieldContext_Tx_outputs(ctx, field)
+			case "blocks":
+				return ec.fieldContext_Tx_blocks(ctx, field)
+			case "seen":
+				return ec.fieldContext_Tx_seen(ctx, field)
+			case "version":
+				return ec.fieldContext_Tx_version(ctx, field)
+			case "locktime":
+				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SlpBaton", field.Name)
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _SlpGenesis_baton_index(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpGenesis_baton_index(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.BatonIndex, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(uint32)
-	fc.Result = res
-	return ec.marshalNUint322uint32(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_SlpGenesis_baton_index(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SlpGenesis",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Uint32 does not have child fields")
+			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpGenesis_ticker(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpGenesis_ticker(ctx, field)
+func (ec *executionContext) _SetProfile_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.SetProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetProfile_tx_hash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7165,7 +7980,7 @@ func (ec *executionContext) _SlpGenesis_ticker(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Ticker, nil
+		return obj.TxHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7177,26 +7992,26 @@ func (ec *executionContext) _SlpGenesis_ticker(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.Hash)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpGenesis_ticker(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetProfile_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpGenesis",
+		Object:     "SetProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Hash does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpGenesis_name(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpGenesis_name(ctx, field)
+func (ec *executionContext) _SetProfile_lock(ctx context.Context, field graphql.CollectedField, obj *model.SetProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetProfile_lock(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7209,7 +8024,7 @@ func (ec *executionContext) _SlpGenesis_name(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Lock, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7221,26 +8036,34 @@ func (ec *executionContext) _SlpGenesis_name(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Lock)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐLock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpGenesis_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetProfile_lock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpGenesis",
+		Object:     "SetProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_Lock_address(ctx, field)
+			case "profile":
+				return ec.fieldContext_Lock_profile(ctx, field)
+			case "txs":
+				return ec.fieldContext_Lock_txs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Lock", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpGenesis_doc_url(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpGenesis_doc_url(ctx, field)
+func (ec *executionContext) _SetProfile_address(ctx context.Context, field graphql.CollectedField, obj *model.SetProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetProfile_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7253,7 +8076,7 @@ func (ec *executionContext) _SlpGenesis_doc_url(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DocURL, nil
+		return obj.Address, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7265,26 +8088,26 @@ func (ec *executionContext) _SlpGenesis_doc_url(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.Address)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNAddress2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐAddress(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpGenesis_doc_url(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetProfile_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpGenesis",
+		Object:     "SetProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Address does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpGenesis_doc_hash(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpGenesis_doc_hash(ctx, field)
+func (ec *executionContext) _SetProfile_text(ctx context.Context, field graphql.CollectedField, obj *model.SetProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetProfile_text(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7297,7 +8120,7 @@ func (ec *executionContext) _SlpGenesis_doc_hash(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DocHash, nil
+		return obj.Text, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7314,9 +8137,9 @@ func (ec *executionContext) _SlpGenesis_doc_hash(ctx context.Context, field grap
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpGenesis_doc_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SetProfile_text(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpGenesis",
+		Object:     "SetProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7327,8 +8150,8 @@ func (ec *executionContext) fieldContext_SlpGenesis_doc_hash(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpOutput_output(ctx context.Context, field graphql.CollectedField, obj *model.SlpOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpOutput_output(ctx, field)
+func (ec *executionContext) _SlpBaton_output(ctx context.Context, field graphql.CollectedField, obj *model.SlpBaton) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpBaton_output(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7358,9 +8181,9 @@ func (ec *executionContext) _SlpOutput_output(ctx context.Context, field graphql
 	return ec.marshalNTxOutput2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTxOutput(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpOutput_output(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SlpBaton_output(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpOutput",
+		Object:     "SlpBaton",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7391,8 +8214,8 @@ func (ec *executionContext) fieldContext_SlpOutput_output(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpOutput_hash(ctx context.Context, field graphql.CollectedField, obj *model.SlpOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpOutput_hash(ctx, field)
+func (ec *executionContext) _SlpBaton_hash(ctx context.Context, field graphql.CollectedField, obj *model.SlpBaton) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpBaton_hash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7422,9 +8245,9 @@ func (ec *executionContext) _SlpOutput_hash(ctx context.Context, field graphql.C
 	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpOutput_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SlpBaton_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpOutput",
+		Object:     "SlpBaton",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7435,8 +8258,8 @@ func (ec *executionContext) fieldContext_SlpOutput_hash(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpOutput_index(ctx context.Context, field graphql.CollectedField, obj *model.SlpOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpOutput_index(ctx, field)
+func (ec *executionContext) _SlpBaton_index(ctx context.Context, field graphql.CollectedField, obj *model.SlpBaton) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpBaton_index(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7466,9 +8289,9 @@ func (ec *executionContext) _SlpOutput_index(ctx context.Context, field graphql.
 	return ec.marshalNUint322uint32(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpOutput_index(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SlpBaton_index(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpOutput",
+		Object:     "SlpBaton",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7479,52 +8302,8 @@ func (ec *executionContext) fieldContext_SlpOutput_index(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpOutput_amount(ctx context.Context, field graphql.CollectedField, obj *model.SlpOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpOutput_amount(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Amount, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(uint64)
-	fc.Result = res
-	return ec.marshalNUint642uint64(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_SlpOutput_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SlpOutput",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Uint64 does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _SlpOutput_token_hash(ctx context.Context, field graphql.CollectedField, obj *model.SlpOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpOutput_token_hash(ctx, field)
+func (ec *executionContext) _SlpBaton_token_hash(ctx context.Context, field graphql.CollectedField, obj *model.SlpBaton) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpBaton_token_hash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7554,9 +8333,9 @@ func (ec *executionContext) _SlpOutput_token_hash(ctx context.Context, field gra
 	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpOutput_token_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SlpBaton_token_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpOutput",
+		Object:     "SlpBaton",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7567,8 +8346,8 @@ func (ec *executionContext) fieldContext_SlpOutput_token_hash(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _SlpOutput_genesis(ctx context.Context, field graphql.CollectedField, obj *model.SlpOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlpOutput_genesis(ctx, field)
+func (ec *executionContext) _SlpBaton_genesis(ctx context.Context, field graphql.CollectedField, obj *model.SlpBaton) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpBaton_genesis(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7595,9 +8374,9 @@ func (ec *executionContext) _SlpOutput_genesis(ctx context.Context, field graphq
 	return ec.marshalOSlpGenesis2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐSlpGenesis(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlpOutput_genesis(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SlpBaton_genesis(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlpOutput",
+		Object:     "SlpBaton",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7632,54 +8411,43 @@ func (ec *executionContext) fieldContext_SlpOutput_genesis(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_address(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_address(ctx, field)
+func (ec *executionContext) _SlpGenesis_tx(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpGenesis_tx(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().Address(rctx, fc.Args["address"].(model.Address))
+		return obj.Tx, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.Tx):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalOTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
 	}
+	res := resTmp.(*model.Tx)
+	fc.Result = res
+	return ec.marshalNTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SlpGenesis_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "SlpGenesis",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "hash":
@@ -7698,472 +8466,1506 @@ func (ec *executionContext) fieldContext_Subscription_address(ctx context.Contex
 				return ec.fieldContext_Tx_version(ctx, field)
 			case "locktime":
 				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_address_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_addresses(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_addresses(ctx, field)
+func (ec *executionContext) _SlpGenesis_hash(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpGenesis_hash(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().Addresses(rctx, fc.Args["addresses"].([]model.Address))
+		return obj.Hash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.Tx):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalOTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
+	res := resTmp.(model.Hash)
+	fc.Result = res
+	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_addresses(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SlpGenesis_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "SlpGenesis",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "hash":
-				return ec.fieldContext_Tx_hash(ctx, field)
-			case "raw":
-				return ec.fieldContext_Tx_raw(ctx, field)
-			case "inputs":
-				return ec.fieldContext_Tx_inputs(ctx, field)
-			case "outputs":
-				return ec.fieldContext_Tx_outputs(ctx, field)
-			case "blocks":
-				return ec.fieldContext_Tx_blocks(ctx, field)
-			case "seen":
-				return ec.fieldContext_Tx_seen(ctx, field)
-			case "version":
-				return ec.fieldContext_Tx_version(ctx, field)
-			case "locktime":
-				return ec.fieldContext_Tx_locktime(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
+			return nil, errors.New("field of type Hash does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_addresses_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_blocks(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_blocks(ctx, field)
+func (ec *executionContext) _SlpGenesis_token_type(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpGenesis_token_type(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().Blocks(rctx)
+		return obj.TokenType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.Block):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalOBlock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐBlock(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
+	res := resTmp.(model.Uint8)
+	fc.Result = res
+	return ec.marshalNUint82githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐUint8(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_blocks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SlpGenesis_token_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "SlpGenesis",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "hash":
-				return ec.fieldContext_Block_hash(ctx, field)
-			case "raw":
-				return ec.fieldContext_Block_raw(ctx, field)
-			case "timestamp":
-				return ec.fieldContext_Block_timestamp(ctx, field)
-			case "height":
-				return ec.fieldContext_Block_height(ctx, field)
-			case "size":
-				return ec.fieldContext_Block_size(ctx, field)
-			case "tx_count":
-				return ec.fieldContext_Block_tx_count(ctx, field)
-			case "txs":
-				return ec.fieldContext_Block_txs(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Block", field.Name)
+			return nil, errors.New("field of type Uint8 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_posts(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_posts(ctx, field)
+func (ec *executionContext) _SlpGenesis_decimals(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpGenesis_decimals(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().Posts(rctx, fc.Args["hashes"].([]model.Hash))
+		return obj.Decimals, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.Post):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalOPost2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
+	res := resTmp.(model.Uint8)
+	fc.Result = res
+	return ec.marshalNUint82githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐUint8(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SlpGenesis_decimals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "SlpGenesis",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "tx":
-				return ec.fieldContext_Post_tx(ctx, field)
-			case "tx_hash":
-				return ec.fieldContext_Post_tx_hash(ctx, field)
-			case "lock":
-				return ec.fieldContext_Post_lock(ctx, field)
-			case "address":
-				return ec.fieldContext_Post_address(ctx, field)
-			case "text":
-				return ec.fieldContext_Post_text(ctx, field)
-			case "likes":
-				return ec.fieldContext_Post_likes(ctx, field)
-			case "parent":
-				return ec.fieldContext_Post_parent(ctx, field)
-			case "replies":
-				return ec.fieldContext_Post_replies(ctx, field)
-			case "room":
-				return ec.fieldContext_Post_room(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, errors.New("field of type Uint8 does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_profiles(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_profiles(ctx, field)
+func (ec *executionContext) _SlpGenesis_output(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpGenesis_output(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().Profiles(rctx, fc.Args["addresses"].([]model.Address))
+		return obj.Output, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.Profile):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalOProfile2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐProfile(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
+	res := resTmp.(*model.SlpOutput)
+	fc.Result = res
+	return ec.marshalNSlpOutput2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐSlpOutput(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_profiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SlpGenesis_output(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "SlpGenesis",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "lock":
-				return ec.fieldContext_Profile_lock(ctx, field)
-			case "address":
-				return ec.fieldContext_Profile_address(ctx, field)
-			case "name":
-				return ec.fieldContext_Profile_name(ctx, field)
-			case "profile":
-				return ec.fieldContext_Profile_profile(ctx, field)
-			case "pic":
-				return ec.fieldContext_Profile_pic(ctx, field)
-			case "following":
-				return ec.fieldContext_Profile_following(ctx, field)
-			case "followers":
-				return ec.fieldContext_Profile_followers(ctx, field)
-			case "posts":
-				return ec.fieldContext_Profile_posts(ctx, field)
-			case "rooms":
-				return ec.fieldContext_Profile_rooms(ctx, field)
+			case "output":
+				return ec.fieldContext_SlpOutput_output(ctx, field)
+			case "hash":
+				return ec.fieldContext_SlpOutput_hash(ctx, field)
+			case "index":
+				return ec.fieldContext_SlpOutput_index(ctx, field)
+			case "amount":
+				return ec.fieldContext_SlpOutput_amount(ctx, field)
+			case "token_hash":
+				return ec.fieldContext_SlpOutput_token_hash(ctx, field)
+			case "genesis":
+				return ec.fieldContext_SlpOutput_genesis(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Profile", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SlpOutput", field.Name)
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SlpGenesis_baton(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpGenesis_baton(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_profiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Baton, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.SlpBaton)
+	fc.Result = res
+	return ec.marshalNSlpBaton2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐSlpBaton(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SlpGenesis_baton(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SlpGenesis",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "output":
+				return ec.fieldContext_SlpBaton_output(ctx, field)
+			case "hash":
+				return ec.fieldContext_SlpBaton_hash(ctx, field)
+			case "index":
+				return ec.fieldContext_SlpBaton_index(ctx, field)
+			case "token_hash":
+				return ec.fieldContext_SlpBaton_token_hash(ctx, field)
+			case "genesis":
+				return ec.fieldContext_SlpBaton_genesis(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SlpBaton", field.Name)
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_rooms(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_rooms(ctx, field)
+func (ec *executionContext) _SlpGenesis_baton_index(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpGenesis_baton_index(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().Rooms(rctx, fc.Args["names"].([]string))
+		return obj.BatonIndex, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.Post):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalOPost2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
+	res := resTmp.(uint32)
+	fc.Result = res
+	return ec.marshalNUint322uint32(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_rooms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SlpGenesis_baton_index(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "SlpGenesis",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "tx":
-				return ec.fieldContext_Post_tx(ctx, field)
-			case "tx_hash":
-				return ec.fieldContext_Post_tx_hash(ctx, field)
-			case "lock":
-				return ec.fieldContext_Post_lock(ctx, field)
-			case "address":
-				return ec.fieldContext_Post_address(ctx, field)
-			case "text":
-				return ec.fieldContext_Post_text(ctx, field)
-			case "likes":
-				return ec.fieldContext_Post_likes(ctx, field)
-			case "parent":
-				return ec.fieldContext_Post_parent(ctx, field)
-			case "replies":
-				return ec.fieldContext_Post_replies(ctx, field)
-			case "room":
-				return ec.fieldContext_Post_room(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, errors.New("field of type Uint32 does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SlpGenesis_ticker(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpGenesis_ticker(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_rooms_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Ticker, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SlpGenesis_ticker(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SlpGenesis",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_room_follows(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_room_follows(ctx, field)
+func (ec *executionContext) _SlpGenesis_name(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpGenesis_name(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().RoomFollows(rctx, fc.Args["addresses"].([]model.Address))
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		return nil
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
 	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.RoomFollow):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalORoomFollow2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐRoomFollow(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SlpGenesis_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SlpGenesis",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SlpGenesis_doc_url(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpGenesis_doc_url(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DocURL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SlpGenesis_doc_url(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SlpGenesis",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SlpGenesis_doc_hash(ctx context.Context, field graphql.CollectedField, obj *model.SlpGenesis) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpGenesis_doc_hash(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DocHash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SlpGenesis_doc_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SlpGenesis",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SlpOutput_output(ctx context.Context, field graphql.CollectedField, obj *model.SlpOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpOutput_output(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Output, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TxOutput)
+	fc.Result = res
+	return ec.marshalNTxOutput2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTxOutput(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SlpOutput_output(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SlpOutput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "tx":
+				return ec.fieldContext_TxOutput_tx(ctx, field)
+			case "hash":
+				return ec.fieldContext_TxOutput_hash(ctx, field)
+			case "index":
+				return ec.fieldContext_TxOutput_index(ctx, field)
+			case "amount":
+				return ec.fieldContext_TxOutput_amount(ctx, field)
+			case "script":
+				return ec.fieldContext_TxOutput_script(ctx, field)
+			case "spends":
+				return ec.fieldContext_TxOutput_spends(ctx, field)
+			case "slp":
+				return ec.fieldContext_TxOutput_slp(ctx, field)
+			case "slp_baton":
+				return ec.fieldContext_TxOutput_slp_baton(ctx, field)
+			case "lock":
+				return ec.fieldContext_TxOutput_lock(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TxOutput", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SlpOutput_hash(ctx context.Context, field graphql.CollectedField, obj *model.SlpOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpOutput_hash(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.Hash)
+	fc.Result = res
+	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SlpOutput_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SlpOutput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Hash does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SlpOutput_index(ctx context.Context, field graphql.CollectedField, obj *model.SlpOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpOutput_index(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Index, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint32)
+	fc.Result = res
+	return ec.marshalNUint322uint32(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SlpOutput_index(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SlpOutput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Uint32 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SlpOutput_amount(ctx context.Context, field graphql.CollectedField, obj *model.SlpOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpOutput_amount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Amount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint64)
+	fc.Result = res
+	return ec.marshalNUint642uint64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SlpOutput_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SlpOutput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Uint64 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SlpOutput_token_hash(ctx context.Context, field graphql.CollectedField, obj *model.SlpOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpOutput_token_hash(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TokenHash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.Hash)
+	fc.Result = res
+	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SlpOutput_token_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SlpOutput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Hash does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SlpOutput_genesis(ctx context.Context, field graphql.CollectedField, obj *model.SlpOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlpOutput_genesis(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Genesis, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.SlpGenesis)
+	fc.Result = res
+	return ec.marshalOSlpGenesis2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐSlpGenesis(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SlpOutput_genesis(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SlpOutput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "tx":
+				return ec.fieldContext_SlpGenesis_tx(ctx, field)
+			case "hash":
+				return ec.fieldContext_SlpGenesis_hash(ctx, field)
+			case "token_type":
+				return ec.fieldContext_SlpGenesis_token_type(ctx, field)
+			case "decimals":
+				return ec.fieldContext_SlpGenesis_decimals(ctx, field)
+			case "output":
+				return ec.fieldContext_SlpGenesis_output(ctx, field)
+			case "baton":
+				return ec.fieldContext_SlpGenesis_baton(ctx, field)
+			case "baton_index":
+				return ec.fieldContext_SlpGenesis_baton_index(ctx, field)
+			case "ticker":
+				return ec.fieldContext_SlpGenesis_ticker(ctx, field)
+			case "name":
+				return ec.fieldContext_SlpGenesis_name(ctx, field)
+			case "doc_url":
+				return ec.fieldContext_SlpGenesis_doc_url(ctx, field)
+			case "doc_hash":
+				return ec.fieldContext_SlpGenesis_doc_hash(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SlpGenesis", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_address(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_address(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().Address(rctx, fc.Args["address"].(model.Address))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.Tx):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalOTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hash":
+				return ec.fieldContext_Tx_hash(ctx, field)
+			case "raw":
+				return ec.fieldContext_Tx_raw(ctx, field)
+			case "inputs":
+				return ec.fieldContext_Tx_inputs(ctx, field)
+			case "outputs":
+				return ec.fieldContext_Tx_outputs(ctx, field)
+			case "blocks":
+				return ec.fieldContext_Tx_blocks(ctx, field)
+			case "seen":
+				return ec.fieldContext_Tx_seen(ctx, field)
+			case "version":
+				return ec.fieldContext_Tx_version(ctx, field)
+			case "locktime":
+				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_address_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_addresses(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_addresses(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().Addresses(rctx, fc.Args["addresses"].([]model.Address))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.Tx):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalOTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_addresses(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hash":
+				return ec.fieldContext_Tx_hash(ctx, field)
+			case "raw":
+				return ec.fieldContext_Tx_raw(ctx, field)
+			case "inputs":
+				return ec.fieldContext_Tx_inputs(ctx, field)
+			case "outputs":
+				return ec.fieldContext_Tx_outputs(ctx, field)
+			case "blocks":
+				return ec.fieldContext_Tx_blocks(ctx, field)
+			case "seen":
+				return ec.fieldContext_Tx_seen(ctx, field)
+			case "version":
+				return ec.fieldContext_Tx_version(ctx, field)
+			case "locktime":
+				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_addresses_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_blocks(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_blocks(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().Blocks(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.Block):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalOBlock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐBlock(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_blocks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hash":
+				return ec.fieldContext_Block_hash(ctx, field)
+			case "raw":
+				return ec.fieldContext_Block_raw(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_Block_timestamp(ctx, field)
+			case "height":
+				return ec.fieldContext_Block_height(ctx, field)
+			case "size":
+				return ec.fieldContext_Block_size(ctx, field)
+			case "tx_count":
+				return ec.fieldContext_Block_tx_count(ctx, field)
+			case "txs":
+				return ec.fieldContext_Block_txs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Block", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_posts(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_posts(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().Posts(rctx, fc.Args["hashes"].([]model.Hash))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.Post):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalOPost2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "tx":
+				return ec.fieldContext_Post_tx(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_Post_tx_hash(ctx, field)
+			case "lock":
+				return ec.fieldContext_Post_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_Post_address(ctx, field)
+			case "text":
+				return ec.fieldContext_Post_text(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Post_parent(ctx, field)
+			case "replies":
+				return ec.fieldContext_Post_replies(ctx, field)
+			case "room":
+				return ec.fieldContext_Post_room(ctx, field)
+			case "repost_of":
+				return ec.fieldContext_Post_repost_of(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Post_reposts(ctx, field)
+			case "repost_count":
+				return ec.fieldContext_Post_repost_count(ctx, field)
+			case "pictures":
+				return ec.fieldContext_Post_pictures(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_profiles(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_profiles(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().Profiles(rctx, fc.Args["addresses"].([]model.Address))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.Profile):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalOProfile2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐProfile(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_profiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "lock":
+				return ec.fieldContext_Profile_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_Profile_address(ctx, field)
+			case "name":
+				return ec.fieldContext_Profile_name(ctx, field)
+			case "profile":
+				return ec.fieldContext_Profile_profile(ctx, field)
+			case "pic":
+				return ec.fieldContext_Profile_pic(ctx, field)
+			case "image_base_url":
+				return ec.fieldContext_Profile_image_base_url(ctx, field)
+			case "following":
+				return ec.fieldContext_Profile_following(ctx, field)
+			case "followers":
+				return ec.fieldContext_Profile_followers(ctx, field)
+			case "posts":
+				return ec.fieldContext_Profile_posts(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Profile_reposts(ctx, field)
+			case "rooms":
+				return ec.fieldContext_Profile_rooms(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Profile", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_profiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_rooms(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_rooms(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().Rooms(rctx, fc.Args["names"].([]string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.Post):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalOPost2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_rooms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "tx":
+				return ec.fieldContext_Post_tx(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_Post_tx_hash(ctx, field)
+			case "lock":
+				return ec.fieldContext_Post_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_Post_address(ctx, field)
+			case "text":
+				return ec.fieldContext_Post_text(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Post_parent(ctx, field)
+			case "replies":
+				return ec.fieldContext_Post_replies(ctx, field)
+			case "room":
+				return ec.fieldContext_Post_room(ctx, field)
+			case "repost_of":
+				return ec.fieldContext_Post_repost_of(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Post_reposts(ctx, field)
+			case "repost_count":
+				return ec.fieldContext_Post_repost_count(ctx, field)
+			case "pictures":
+				return ec.fieldContext_Post_pictures(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_rooms_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_room_follows(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_room_follows(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().RoomFollows(rctx, fc.Args["addresses"].([]model.Address))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.RoomFollow):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalORoomFollow2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐRoomFollow(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_room_follows(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_RoomFollow_name(ctx, field)
+			case "room":
+				return ec.fieldContext_RoomFollow_room(ctx, field)
+			case "lock":
+				return ec.fieldContext_RoomFollow_lock(ctx, field)
+			case "address":
+				return ec.fieldContext_RoomFollow_address(ctx, field)
+			case "unfollow":
+				return ec.fieldContext_RoomFollow_unfollow(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_RoomFollow_tx_hash(ctx, field)
+			case "tx":
+				return ec.fieldContext_RoomFollow_tx(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RoomFollow", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_room_follows_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_thread(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_thread(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().Thread(rctx, fc.Args["root"].(model.Hash))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.ThreadNode):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalOThreadNode2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐThreadNode(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_thread(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "tx_hash":
+				return ec.fieldContext_ThreadNode_tx_hash(ctx, field)
+			case "parent_tx_hash":
+				return ec.fieldContext_ThreadNode_parent_tx_hash(ctx, field)
+			case "path":
+				return ec.fieldContext_ThreadNode_path(ctx, field)
+			case "depth":
+				return ec.fieldContext_ThreadNode_depth(ctx, field)
+			case "reply_count":
+				return ec.fieldContext_ThreadNode_reply_count(ctx, field)
+			case "like_count":
+				return ec.fieldContext_ThreadNode_like_count(ctx, field)
+			case "post":
+				return ec.fieldContext_ThreadNode_post(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ThreadNode", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_thread_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_broadcast_results(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_broadcast_results(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().BroadcastResults(rctx, fc.Args["hashes"].([]model.Hash))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.BroadcastResult):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalOBroadcastResult2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐBroadcastResult(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
 			})
 		case <-ctx.Done():
 			return nil
@@ -8171,43 +9973,374 @@ func (ec *executionContext) _Subscription_room_follows(ctx context.Context, fiel
 	}
 }
 
-func (ec *executionContext) fieldContext_Subscription_room_follows(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_broadcast_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "tx_hash":
+				return ec.fieldContext_BroadcastResult_tx_hash(ctx, field)
+			case "status":
+				return ec.fieldContext_BroadcastResult_status(ctx, field)
+			case "code":
+				return ec.fieldContext_BroadcastResult_code(ctx, field)
+			case "reason":
+				return ec.fieldContext_BroadcastResult_reason(ctx, field)
+			case "host":
+				return ec.fieldContext_BroadcastResult_host(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_BroadcastResult_timestamp(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BroadcastResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_broadcast_results_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThreadNode_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.ThreadNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ThreadNode_tx_hash(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TxHash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.Hash)
+	fc.Result = res
+	return ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ThreadNode_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThreadNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Hash does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThreadNode_parent_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.ThreadNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ThreadNode_parent_tx_hash(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ParentTxHash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Hash)
+	fc.Result = res
+	return ec.marshalOHash2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ThreadNode_parent_tx_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThreadNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Hash does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThreadNode_path(ctx context.Context, field graphql.CollectedField, obj *model.ThreadNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ThreadNode_path(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.Hash)
+	fc.Result = res
+	return ec.marshalNHash2ᚕgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHashᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ThreadNode_path(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThreadNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Hash does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThreadNode_depth(ctx context.Context, field graphql.CollectedField, obj *model.ThreadNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ThreadNode_depth(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Depth, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ThreadNode_depth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThreadNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThreadNode_reply_count(ctx context.Context, field graphql.CollectedField, obj *model.ThreadNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ThreadNode_reply_count(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ReplyCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ThreadNode_reply_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThreadNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThreadNode_like_count(ctx context.Context, field graphql.CollectedField, obj *model.ThreadNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ThreadNode_like_count(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LikeCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ThreadNode_like_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThreadNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThreadNode_post(ctx context.Context, field graphql.CollectedField, obj *model.ThreadNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ThreadNode_post(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Post, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Post)
+	fc.Result = res
+	return ec.marshalNPost2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ThreadNode_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "ThreadNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext_RoomFollow_name(ctx, field)
-			case "room":
-				return ec.fieldContext_RoomFollow_room(ctx, field)
+			case "tx":
+				return ec.fieldContext_Post_tx(ctx, field)
+			case "tx_hash":
+				return ec.fieldContext_Post_tx_hash(ctx, field)
 			case "lock":
-				return ec.fieldContext_RoomFollow_lock(ctx, field)
+				return ec.fieldContext_Post_lock(ctx, field)
 			case "address":
-				return ec.fieldContext_RoomFollow_address(ctx, field)
-			case "unfollow":
-				return ec.fieldContext_RoomFollow_unfollow(ctx, field)
-			case "tx_hash":
-				return ec.fieldContext_RoomFollow_tx_hash(ctx, field)
-			case "tx":
-				return ec.fieldContext_RoomFollow_tx(ctx, field)
+				return ec.fieldContext_Post_address(ctx, field)
+			case "text":
+				return ec.fieldContext_Post_text(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Post_parent(ctx, field)
+			case "replies":
+				return ec.fieldContext_Post_replies(ctx, field)
+			case "room":
+				return ec.fieldContext_Post_room(ctx, field)
+			case "repost_of":
+				return ec.fieldContext_Post_repost_of(ctx, field)
+			case "reposts":
+				return ec.fieldContext_Post_reposts(ctx, field)
+			case "repost_count":
+				return ec.fieldContext_Post_repost_count(ctx, field)
+			case "pictures":
+				return ec.fieldContext_Post_pictures(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RoomFollow", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_room_follows_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
@@ -8607,6 +10740,50 @@ func (ec *executionContext) fieldContext_Tx_locktime(ctx context.Context, field
 	return fc, nil
 }
 
+func (ec *executionContext) _Tx_conflicted(ctx context.Context, field graphql.CollectedField, obj *model.Tx) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Tx_conflicted(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Conflicted, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Tx_conflicted(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Tx",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TxBlock_tx_hash(ctx context.Context, field graphql.CollectedField, obj *model.TxBlock) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TxBlock_tx_hash(ctx, field)
 	if err != nil {
@@ -8706,6 +10883,8 @@ func (ec *executionContext) fieldContext_TxBlock_tx(ctx context.Context, field g
 				return ec.fieldContext_Tx_version(ctx, field)
 			case "locktime":
 				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
@@ -8916,6 +11095,8 @@ func (ec *executionContext) fieldContext_TxInput_tx(ctx context.Context, field g
 				return ec.fieldContext_Tx_version(ctx, field)
 			case "locktime":
 				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
@@ -9303,6 +11484,8 @@ func (ec *executionContext) fieldContext_TxOutput_tx(ctx context.Context, field
 				return ec.fieldContext_Tx_version(ctx, field)
 			case "locktime":
 				return ec.fieldContext_Tx_locktime(ctx, field)
+			case "conflicted":
+				return ec.fieldContext_Tx_conflicted(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Tx", field.Name)
 		},
@@ -11541,6 +13724,69 @@ func (ec *executionContext) _Block(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
+var broadcastResultImplementors = []string{"BroadcastResult"}
+
+func (ec *executionContext) _BroadcastResult(ctx context.Context, sel ast.SelectionSet, obj *model.BroadcastResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, broadcastResultImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BroadcastResult")
+		case "tx_hash":
+
+			out.Values[i] = ec._BroadcastResult_tx_hash(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "status":
+
+			out.Values[i] = ec._BroadcastResult_status(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "code":
+
+			out.Values[i] = ec._BroadcastResult_code(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "reason":
+
+			out.Values[i] = ec._BroadcastResult_reason(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "host":
+
+			out.Values[i] = ec._BroadcastResult_host(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "timestamp":
+
+			out.Values[i] = ec._BroadcastResult_timestamp(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var followImplementors = []string{"Follow"}
 
 func (ec *executionContext) _Follow(ctx context.Context, sel ast.SelectionSet, obj *model.Follow) graphql.Marshaler {
@@ -11747,6 +13993,73 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	return out
 }
 
+var pictureImplementors = []string{"Picture"}
+
+func (ec *executionContext) _Picture(ctx context.Context, sel ast.SelectionSet, obj *model.Picture) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pictureImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Picture")
+		case "tx":
+
+			out.Values[i] = ec._Picture_tx(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "tx_hash":
+
+			out.Values[i] = ec._Picture_tx_hash(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lock":
+
+			out.Values[i] = ec._Picture_lock(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "address":
+
+			out.Values[i] = ec._Picture_address(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "post_tx_hash":
+
+			out.Values[i] = ec._Picture_post_tx_hash(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "picture":
+
+			out.Values[i] = ec._Picture_picture(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "url":
+
+			out.Values[i] = ec._Picture_url(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var postImplementors = []string{"Post"}
 
 func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj *model.Post) graphql.Marshaler {
@@ -11808,6 +14121,25 @@ func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj
 
 			out.Values[i] = ec._Post_room(ctx, field, obj)
 
+		case "repost_of":
+
+			out.Values[i] = ec._Post_repost_of(ctx, field, obj)
+
+		case "reposts":
+
+			out.Values[i] = ec._Post_reposts(ctx, field, obj)
+
+		case "repost_count":
+
+			out.Values[i] = ec._Post_repost_count(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pictures":
+
+			out.Values[i] = ec._Post_pictures(ctx, field, obj)
+
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -11855,6 +14187,10 @@ func (ec *executionContext) _Profile(ctx context.Context, sel ast.SelectionSet,
 
 			out.Values[i] = ec._Profile_pic(ctx, field, obj)
 
+		case "image_base_url":
+
+			out.Values[i] = ec._Profile_image_base_url(ctx, field, obj)
+
 		case "following":
 
 			out.Values[i] = ec._Profile_following(ctx, field, obj)
@@ -11867,6 +14203,10 @@ func (ec *executionContext) _Profile(ctx context.Context, sel ast.SelectionSet,
 
 			out.Values[i] = ec._Profile_posts(ctx, field, obj)
 
+		case "reposts":
+
+			out.Values[i] = ec._Profile_reposts(ctx, field, obj)
+
 		case "rooms":
 
 			out.Values[i] = ec._Profile_rooms(ctx, field, obj)
@@ -11981,7 +14321,47 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
-		case "block":
+		case "block":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_block(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "block_newest":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_block_newest(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "blocks":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -11990,7 +14370,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_block(ctx, field)
+				res = ec._Query_blocks(ctx, field)
 				return res
 			}
 
@@ -12001,7 +14381,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
-		case "block_newest":
+		case "profiles":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -12010,7 +14390,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_block_newest(ctx, field)
+				res = ec._Query_profiles(ctx, field)
 				return res
 			}
 
@@ -12021,7 +14401,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
-		case "blocks":
+		case "posts":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -12030,7 +14410,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_blocks(ctx, field)
+				res = ec._Query_posts(ctx, field)
 				return res
 			}
 
@@ -12041,7 +14421,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
-		case "profiles":
+		case "posts_newest":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -12050,7 +14430,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_profiles(ctx, field)
+				res = ec._Query_posts_newest(ctx, field)
 				return res
 			}
 
@@ -12061,7 +14441,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
-		case "posts":
+		case "room":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -12070,7 +14450,10 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_posts(ctx, field)
+				res = ec._Query_room(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			}
 
@@ -12081,7 +14464,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
-		case "posts_newest":
+		case "thread":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -12090,7 +14473,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_posts_newest(ctx, field)
+				res = ec._Query_thread(ctx, field)
 				return res
 			}
 
@@ -12101,7 +14484,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
-		case "room":
+		case "broadcast_result":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -12110,10 +14493,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_room(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._Query_broadcast_result(ctx, field)
 				return res
 			}
 
@@ -12659,11 +15039,82 @@ func (ec *executionContext) _Subscription(ctx context.Context, sel ast.Selection
 		return ec._Subscription_rooms(ctx, fields[0])
 	case "room_follows":
 		return ec._Subscription_room_follows(ctx, fields[0])
+	case "thread":
+		return ec._Subscription_thread(ctx, fields[0])
+	case "broadcast_results":
+		return ec._Subscription_broadcast_results(ctx, fields[0])
 	default:
 		panic("unknown field " + strconv.Quote(fields[0].Name))
 	}
 }
 
+var threadNodeImplementors = []string{"ThreadNode"}
+
+func (ec *executionContext) _ThreadNode(ctx context.Context, sel ast.SelectionSet, obj *model.ThreadNode) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, threadNodeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ThreadNode")
+		case "tx_hash":
+
+			out.Values[i] = ec._ThreadNode_tx_hash(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "parent_tx_hash":
+
+			out.Values[i] = ec._ThreadNode_parent_tx_hash(ctx, field, obj)
+
+		case "path":
+
+			out.Values[i] = ec._ThreadNode_path(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "depth":
+
+			out.Values[i] = ec._ThreadNode_depth(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "reply_count":
+
+			out.Values[i] = ec._ThreadNode_reply_count(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "like_count":
+
+			out.Values[i] = ec._ThreadNode_like_count(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "post":
+
+			out.Values[i] = ec._ThreadNode_post(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var txImplementors = []string{"Tx"}
 
 func (ec *executionContext) _Tx(ctx context.Context, sel ast.SelectionSet, obj *model.Tx) graphql.Marshaler {
@@ -12721,6 +15172,13 @@ func (ec *executionContext) _Tx(ctx context.Context, sel ast.SelectionSet, obj *
 
 			out.Values[i] = ec._Tx_locktime(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "conflicted":
+
+			out.Values[i] = ec._Tx_conflicted(ctx, field, obj)
+
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -13346,6 +15804,53 @@ func (ec *executionContext) marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraph
 	return res
 }
 
+func (ec *executionContext) unmarshalNHash2ᚕgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHashᚄ(ctx context.Context, v interface{}) ([]model.Hash, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]model.Hash, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNHash2ᚕgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHashᚄ(ctx context.Context, sel ast.SelectionSet, v []model.Hash) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNHash2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐHash(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
 func (ec *executionContext) unmarshalNInt322int32(ctx context.Context, v interface{}) (int32, error) {
 	res, err := graphql.UnmarshalInt32(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -13396,6 +15901,16 @@ func (ec *executionContext) marshalNLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgr
 	return ec._Lock(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNPicture2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPicture(ctx context.Context, sel ast.SelectionSet, v *model.Picture) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Picture(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNPost2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx context.Context, sel ast.SelectionSet, v *model.Post) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -13465,6 +15980,16 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) marshalNThreadNode2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐThreadNode(ctx context.Context, sel ast.SelectionSet, v *model.ThreadNode) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ThreadNode(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNTx2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx context.Context, sel ast.SelectionSet, v *model.Tx) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -14019,6 +16544,13 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) marshalOBroadcastResult2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐBroadcastResult(ctx context.Context, sel ast.SelectionSet, v *model.BroadcastResult) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._BroadcastResult(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalODate2githubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐDate(ctx context.Context, v interface{}) (model.Date, error) {
 	res, err := model.UnmarshalDate(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -14278,6 +16810,53 @@ func (ec *executionContext) marshalOLock2ᚖgithubᚗcomᚋmemocashᚋindexᚋgr
 	return ec._Lock(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOPicture2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPictureᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Picture) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPicture2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPicture(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalOPost2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐPost(ctx context.Context, sel ast.SelectionSet, v []*model.Post) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -14578,6 +17157,76 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) marshalOThreadNode2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐThreadNodeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ThreadNode) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNThreadNode2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐThreadNode(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalOThreadNode2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐThreadNode(ctx context.Context, sel ast.SelectionSet, v *model.ThreadNode) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ThreadNode(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOThreadSort2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐThreadSort(ctx context.Context, v interface{}) (*model.ThreadSort, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.ThreadSort)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOThreadSort2ᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐThreadSort(ctx context.Context, sel ast.SelectionSet, v *model.ThreadSort) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOTx2ᚕᚖgithubᚗcomᚋmemocashᚋindexᚋgraphᚋmodelᚐTx(ctx context.Context, sel ast.SelectionSet, v []*model.Tx) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -26,7 +26,11 @@ func UnmarshalAddress(v interface{}) (Address, error) {
 	case string:
 		addr, err := wallet.GetAddrFromString(v)
 		if err != nil {
-			return Address{}, fmt.Errorf("error unmarshal parsing string as address; %w", err)
+			address, errCashAddr := wallet.GetAddressFromStringErr(v)
+			if errCashAddr != nil {
+				return Address{}, fmt.Errorf("error unmarshal parsing string as address; %w", err)
+			}
+			return Address(address.GetAddr()), nil
 		}
 		return Address(*addr), nil
 	default:
@@ -1,14 +1,15 @@
 package model
 
 type Tx struct {
-	Hash     Hash        `json:"hash"`
-	Raw      Bytes       `json:"raw"`
-	Seen     Date        `json:"seen"`
-	Version  int32       `json:"version"`
-	LockTime uint32      `json:"locktime"`
-	Inputs   []*TxInput  `json:"inputs"`
-	Outputs  []*TxOutput `json:"outputs"`
-	Blocks   []*TxBlock  `json:"blocks"`
+	Hash       Hash        `json:"hash"`
+	Raw        Bytes       `json:"raw"`
+	Seen       Date        `json:"seen"`
+	Version    int32       `json:"version"`
+	LockTime   uint32      `json:"locktime"`
+	Inputs     []*TxInput  `json:"inputs"`
+	Outputs    []*TxOutput `json:"outputs"`
+	Blocks     []*TxBlock  `json:"blocks"`
+	Conflicted bool        `json:"conflicted"`
 }
 
 type TxOutput struct {
@@ -58,16 +59,27 @@ type Block struct {
 	Txs       []*TxBlock `json:"txs"`
 }
 
+type BroadcastResult struct {
+	TxHash    Hash   `json:"tx_hash"`
+	Status    string `json:"status"`
+	Code      int    `json:"code"`
+	Reason    string `json:"reason"`
+	Host      string `json:"host"`
+	Timestamp Date   `json:"timestamp"`
+}
+
 type Profile struct {
-	Address   Address       `json:"address"`
-	Name      *SetName      `json:"name"`
-	Profile   *SetProfile   `json:"profile"`
-	Pic       *SetPic       `json:"pic"`
-	Lock      *Lock         `json:"lock"`
-	Posts     []*Post       `json:"posts"`
-	Following []*Follow     `json:"following"`
-	Followers []*Follow     `json:"followers"`
-	Rooms     []*RoomFollow `json:"rooms"`
+	Address      Address       `json:"address"`
+	Name         *SetName      `json:"name"`
+	Profile      *SetProfile   `json:"profile"`
+	Pic          *SetPic       `json:"pic"`
+	ImageBaseUrl *string       `json:"image_base_url"`
+	Lock         *Lock         `json:"lock"`
+	Posts        []*Post       `json:"posts"`
+	Reposts      []*Post       `json:"reposts"`
+	Following    []*Follow     `json:"following"`
+	Followers    []*Follow     `json:"followers"`
+	Rooms        []*RoomFollow `json:"rooms"`
 }
 
 type Follow struct {
@@ -105,15 +117,29 @@ type SetProfile struct {
 }
 
 type Post struct {
-	TxHash  Hash    `json:"tx_hash"`
-	Address Address `json:"address"`
-	Text    string  `json:"text"`
-	Lock    *Lock   `json:"lock"`
-	Tx      *Tx     `json:"tx"`
-	Parent  *Post   `json:"parent"`
-	Likes   []*Like `json:"likes"`
-	Replies []*Post `json:"replies"`
-	Room    *Room   `json:"room"`
+	TxHash      Hash       `json:"tx_hash"`
+	Address     Address    `json:"address"`
+	Text        string     `json:"text"`
+	Lock        *Lock      `json:"lock"`
+	Tx          *Tx        `json:"tx"`
+	Parent      *Post      `json:"parent"`
+	Likes       []*Like    `json:"likes"`
+	Replies     []*Post    `json:"replies"`
+	Room        *Room      `json:"room"`
+	RepostOf    *Post      `json:"repost_of"`
+	Reposts     []*Post    `json:"reposts"`
+	RepostCount int        `json:"repost_count"`
+	Pictures    []*Picture `json:"pictures"`
+}
+
+type Picture struct {
+	TxHash     Hash    `json:"tx_hash"`
+	Address    Address `json:"address"`
+	PostTxHash Hash    `json:"post_tx_hash"`
+	Picture    string  `json:"picture"`
+	Url        *string `json:"url"`
+	Lock       *Lock   `json:"lock"`
+	Tx         *Tx     `json:"tx"`
 }
 
 type Like struct {
@@ -172,3 +198,13 @@ type SlpOutput struct {
 	Genesis   *SlpGenesis `json:"genesis"`
 	Output    *TxOutput   `json:"output"`
 }
+
+type ThreadNode struct {
+	TxHash       Hash   `json:"tx_hash"`
+	ParentTxHash *Hash  `json:"parent_tx_hash"`
+	Path         []Hash `json:"path"`
+	Depth        int    `json:"depth"`
+	ReplyCount   int    `json:"reply_count"`
+	LikeCount    int    `json:"like_count"`
+	Post         *Post  `json:"post"`
+}
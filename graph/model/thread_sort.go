@@ -0,0 +1,43 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type ThreadSort string
+
+const (
+	ThreadSortOldest ThreadSort = "OLDEST"
+	ThreadSortNewest ThreadSort = "NEWEST"
+	ThreadSortLikes  ThreadSort = "LIKES"
+)
+
+func (e ThreadSort) IsValid() bool {
+	switch e {
+	case ThreadSortOldest, ThreadSortNewest, ThreadSortLikes:
+		return true
+	}
+	return false
+}
+
+func (e ThreadSort) String() string {
+	return string(e)
+}
+
+func (e *ThreadSort) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+	*e = ThreadSort(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ThreadSort", str)
+	}
+	return nil
+}
+
+func (e ThreadSort) MarshalGQL(w io.Writer) {
+	_, _ = fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/db/item"
 	"github.com/memocash/index/graph/generated"
 	"github.com/memocash/index/ref/bitcoin/memo"
 	"github.com/memocash/index/ref/broadcast/broadcast_client"
@@ -28,6 +30,11 @@ func (r *mutationResolver) Broadcast(ctx context.Context, raw string) (bool, err
 	log.Printf("Broadcasting tx: %s\n", msgTx.TxHash())
 	if err := client.Broadcast(ctx, rawBytes); err != nil {
 		log.Printf("Broadcast tx failed: %s\n", msgTx.TxHash())
+		broadcastResult, resultErr := item.GetBroadcastResult(msgTx.TxHash())
+		if resultErr == nil && broadcastResult.Status == item.BroadcastResultStatusRejected {
+			return false, fmt.Errorf("tx rejected by node: %s (code: %s)", broadcastResult.Reason,
+				wire.RejectCode(broadcastResult.Code))
+		}
 		return false, fmt.Errorf("error broadcasting tx for graphql; %w", err)
 	}
 	return true, nil
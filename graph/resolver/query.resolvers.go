@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item"
 	"github.com/memocash/index/db/item/addr"
 	"github.com/memocash/index/db/item/chain"
 	memo_db "github.com/memocash/index/db/item/memo"
@@ -215,6 +217,40 @@ func (r *queryResolver) Room(ctx context.Context, name string) (*model.Room, err
 	return room, nil
 }
 
+// Thread is the resolver for the thread field.
+func (r *queryResolver) Thread(ctx context.Context, root model.Hash, maxDepth *int, sort *model.ThreadSort) ([]*model.ThreadNode, error) {
+	SetEndPoint(ctx, metric.EndPointThread)
+	var depth = attach.ThreadMaxDepth
+	if maxDepth != nil {
+		depth = *maxDepth
+	}
+	var threadSort = model.ThreadSortOldest
+	if sort != nil {
+		threadSort = *sort
+	}
+	threadNodes, err := attach.ToMemoThread(ctx, attach.GetFields(ctx), root, depth, threadSort)
+	if err != nil {
+		if errors.Is(err, attach.PostMissingError) {
+			return nil, fmt.Errorf("thread root post not found: %s", root)
+		}
+		return nil, InternalError{fmt.Errorf("error getting memo thread for thread query resolver; %w", err)}
+	}
+	return threadNodes, nil
+}
+
+// BroadcastResult is the resolver for the broadcast_result field.
+func (r *queryResolver) BroadcastResult(ctx context.Context, hash model.Hash) (*model.BroadcastResult, error) {
+	SetEndPoint(ctx, metric.EndPointBroadcastResult)
+	broadcastResult, err := item.GetBroadcastResult(hash)
+	if err != nil {
+		if client.IsEntryNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, InternalError{fmt.Errorf("error getting broadcast result for query resolver; %w", err)}
+	}
+	return sub.GetBroadcastResultModel(broadcastResult), nil
+}
+
 // Address is the resolver for the address field.
 func (r *subscriptionResolver) Address(ctx context.Context, address model.Address) (<-chan *model.Tx, error) {
 	OpenSubscriptionWithRequest(ctx, "address")
@@ -313,6 +349,30 @@ func (r *subscriptionResolver) RoomFollows(ctx context.Context, addresses []mode
 	return roomFollowsChan, nil
 }
 
+// Thread is the resolver for the thread field.
+func (r *subscriptionResolver) Thread(ctx context.Context, root model.Hash) (<-chan *model.ThreadNode, error) {
+	OpenSubscriptionWithRequest(ctx, "thread")
+	var thread = new(sub.Thread)
+	threadNodeChan, err := thread.Listen(ctx, root, attach.GetFields(ctx))
+	if err != nil {
+		if errors.Is(err, attach.PostMissingError) {
+			return nil, fmt.Errorf("thread root post not found: %s", root)
+		}
+		return nil, InternalError{fmt.Errorf("error getting thread listener for subscription; %w", err)}
+	}
+	return threadNodeChan, nil
+}
+
+// BroadcastResults is the resolver for the broadcast_results field.
+func (r *subscriptionResolver) BroadcastResults(ctx context.Context, hashes []model.Hash) (<-chan *model.BroadcastResult, error) {
+	OpenSubscriptionWithRequest(ctx, "broadcast_results")
+	broadcastResultChan, err := new(sub.BroadcastResult).Listen(ctx, model.HashesToArrays(hashes))
+	if err != nil {
+		return nil, InternalError{fmt.Errorf("error getting broadcast result listener for subscription; %w", err)}
+	}
+	return broadcastResultChan, nil
+}
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
@@ -13,13 +13,14 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/memocash/index/graph/generated"
 	"github.com/memocash/index/graph/resolver"
+	"github.com/memocash/index/ref/config"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	"net"
 	"net/http"
 	"time"
 )
 
-func getGqlGenHandler() *handler.Server {
+func getGqlGenHandler(persistedQueries *PersistedQueries) *handler.Server {
 	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: &resolver.Resolver{}}))
 	srv.AddTransport(transport.Websocket{
 		KeepAlivePingInterval: 10 * time.Second,
@@ -35,9 +36,13 @@ func getGqlGenHandler() *handler.Server {
 	srv.AddTransport(transport.MultipartForm{})
 	srv.SetQueryCache(lru.New(1000))
 	srv.Use(extension.Introspection{})
+	allowlist := config.GetGraphQLAllowlist()
 	srv.Use(extension.AutomaticPersistedQuery{
-		Cache: lru.New(100),
+		Cache: newPersistedQueryCache(persistedQueries, allowlist),
 	})
+	if allowlist {
+		srv.Use(persistedQueryAllowlist{Registry: persistedQueries})
+	}
 	srv.SetErrorPresenter(func(ctx context.Context, e error) *gqlerror.Error {
 		pathStr := graphql.GetPath(ctx).String()
 		if pathStr != "" {
@@ -58,8 +63,8 @@ func getGqlGenHandler() *handler.Server {
 	return srv
 }
 
-func GetGraphQLHandler() func(w http.ResponseWriter, r *http.Request) {
-	srv := getGqlGenHandler()
+func GetGraphQLHandler(persistedQueries *PersistedQueries) func(w http.ResponseWriter, r *http.Request) {
+	srv := getGqlGenHandler(persistedQueries)
 	return func(w http.ResponseWriter, r *http.Request) {
 		graphRequest := resolver.NewRequest(getIpAddress(r), "/graphql")
 		var finalMessages []string
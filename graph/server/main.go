@@ -3,15 +3,17 @@ package server
 import (
 	"bufio"
 	"fmt"
+	"github.com/memocash/index/db/item/db"
 	"github.com/memocash/index/ref/config"
 	"net"
 	"net/http"
 )
 
 type Server struct {
-	Port     uint
-	server   http.Server
-	listener net.Listener
+	Port             uint
+	PersistedQueries *PersistedQueries
+	server           http.Server
+	listener         net.Listener
 }
 
 func (s *Server) GetHost() string {
@@ -29,12 +31,16 @@ func (s *Server) Run() error {
 func (s *Server) Start() error {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", GetIndexHandler())
-	mux.HandleFunc("/graphql", GetGraphQLHandler())
+	s.PersistedQueries = NewPersistedQueries()
+	graphQLHandler := GetGraphQLHandler(s.PersistedQueries)
+	mux.HandleFunc("/graphql", graphQLHandler)
+	mux.HandleFunc(UrlPersistedQuery, GetPersistedQueryHandler(s.PersistedQueries, graphQLHandler))
 	s.server = http.Server{Handler: mux}
 	var err error
 	if s.listener, err = net.Listen("tcp", s.GetHost()); err != nil {
 		return fmt.Errorf("failed to listen admin server; %w", err)
 	}
+	db.InitCache()
 	return nil
 }
 
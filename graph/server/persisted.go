@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/memocash/index/db/item"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	persistedQueryRetry      = 5 * time.Second
+	errQueryNotAllowed       = "query not in persisted query allowlist"
+	errQueryNotAllowedCode   = "PERSISTED_QUERY_NOT_ALLOWED"
+	persistedQueryCacheLimit = 100
+)
+
+// PersistedQueries is a registry of named queries stored in the queue, kept in sync by listening for new items.
+type PersistedQueries struct {
+	byName map[string]*item.PersistedQuery
+	byHash map[string]*item.PersistedQuery
+	mutex  sync.RWMutex
+}
+
+func (p *PersistedQueries) add(persistedQuery *item.PersistedQuery) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if existing, ok := p.byName[persistedQuery.Name]; ok {
+		delete(p.byHash, existing.HashString())
+	}
+	p.byName[persistedQuery.Name] = persistedQuery
+	p.byHash[persistedQuery.HashString()] = persistedQuery
+}
+
+func (p *PersistedQueries) GetByName(name string) *item.PersistedQuery {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.byName[name]
+}
+
+func (p *PersistedQueries) GetByHash(hash string) *item.PersistedQuery {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.byHash[hash]
+}
+
+func (p *PersistedQueries) load(ctx context.Context) error {
+	allPersistedQueries, err := item.GetPersistedQueries(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting persisted queries; %w", err)
+	}
+	for _, persistedQuery := range allPersistedQueries {
+		p.add(persistedQuery)
+	}
+	return nil
+}
+
+// listen subscribes before loading so queries registered during the initial load are not missed.
+func (p *PersistedQueries) listen() {
+	for {
+		ctx, cancel := context.WithCancel(context.Background())
+		persistedQueryChan, err := item.ListenPersistedQueries(ctx)
+		if err != nil {
+			log.Printf("error listening for persisted queries; %v", err)
+		} else if err := p.load(ctx); err != nil {
+			log.Printf("error loading persisted queries; %v", err)
+		} else {
+			for persistedQuery := range persistedQueryChan {
+				p.add(persistedQuery)
+			}
+		}
+		cancel()
+		time.Sleep(persistedQueryRetry)
+	}
+}
+
+func NewPersistedQueries() *PersistedQueries {
+	p := &PersistedQueries{
+		byName: make(map[string]*item.PersistedQuery),
+		byHash: make(map[string]*item.PersistedQuery),
+	}
+	go p.listen()
+	return p
+}
+
+// persistedQueryCache serves registered queries to the automatic persisted query extension, falling back to an
+// in-memory cache for client registered queries unless the allowlist is enforced.
+type persistedQueryCache struct {
+	Registry  *PersistedQueries
+	Allowlist bool
+	fallback  *lru.LRU
+}
+
+func (c *persistedQueryCache) Get(ctx context.Context, hash string) (interface{}, bool) {
+	if persistedQuery := c.Registry.GetByHash(hash); persistedQuery != nil {
+		return persistedQuery.Query, true
+	}
+	if c.Allowlist {
+		return nil, false
+	}
+	return c.fallback.Get(ctx, hash)
+}
+
+func (c *persistedQueryCache) Add(ctx context.Context, hash string, query interface{}) {
+	if c.Allowlist || c.Registry.GetByHash(hash) != nil {
+		return
+	}
+	c.fallback.Add(ctx, hash, query)
+}
+
+func newPersistedQueryCache(registry *PersistedQueries, allowlist bool) *persistedQueryCache {
+	return &persistedQueryCache{
+		Registry:  registry,
+		Allowlist: allowlist,
+		fallback:  lru.New(persistedQueryCacheLimit),
+	}
+}
+
+// persistedQueryAllowlist rejects any operation whose query is not registered, must run after the automatic
+// persisted query extension so hash-only requests have been expanded.
+type persistedQueryAllowlist struct {
+	Registry *PersistedQueries
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = persistedQueryAllowlist{}
+
+func (a persistedQueryAllowlist) ExtensionName() string {
+	return "PersistedQueryAllowlist"
+}
+
+func (a persistedQueryAllowlist) Validate(graphql.ExecutableSchema) error {
+	if a.Registry == nil {
+		return fmt.Errorf("PersistedQueryAllowlist.Registry can not be nil")
+	}
+	return nil
+}
+
+func (a persistedQueryAllowlist) MutateOperationParameters(_ context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	hash := sha256.Sum256([]byte(rawParams.Query))
+	if a.Registry.GetByHash(hex.EncodeToString(hash[:])) != nil {
+		return nil
+	}
+	err := gqlerror.Errorf(errQueryNotAllowed)
+	errcode.Set(err, errQueryNotAllowedCode)
+	return err
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/memocash/index/graph/resolver"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	UrlPersistedQuery    = "/q/"
+	persistedQueryMaxAge = 60
+)
+
+// GetPersistedQueryHandler runs a registered query by name over GET, e.g. /q/posts?vars={"limit":10}, so
+// responses can be cached by a CDN.
+func GetPersistedQueryHandler(persistedQueries *PersistedQueries, graphQLHandler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, UrlPersistedQuery)
+		persistedQuery := persistedQueries.GetByName(name)
+		if r.Method != http.MethodGet || persistedQuery == nil {
+			queryRequest := resolver.NewRequest(getIpAddress(r), r.URL.String())
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			if err := json.NewEncoder(w).Encode(struct {
+				Error string
+			}{
+				Error: fmt.Sprintf("persisted query not found: %s", name),
+			}); err != nil {
+				log.Printf("error writing persisted query not found response; %v", err)
+			}
+			queryRequest.LogFinal("[not found]")
+			return
+		}
+		var values = url.Values{"query": []string{persistedQuery.Query}}
+		if vars := r.URL.Query().Get("vars"); vars != "" {
+			values.Set("variables", vars)
+		}
+		if operationName := r.URL.Query().Get("operationName"); operationName != "" {
+			values.Set("operationName", operationName)
+		}
+		r.URL.RawQuery = values.Encode()
+		writer := &cacheWriter{httpWriter: w}
+		graphQLHandler(writer, r)
+		if err := writer.flush(); err != nil {
+			log.Printf("error writing persisted query response; %v", err)
+		}
+	}
+}
+
+// cacheWriter buffers a persisted query response so it is only marked cacheable once it is known to have succeeded
+// without errors, error and partial responses are marked not to be stored.
+type cacheWriter struct {
+	httpWriter http.ResponseWriter
+	status     int
+	body       bytes.Buffer
+}
+
+func (w *cacheWriter) Header() http.Header {
+	return w.httpWriter.Header()
+}
+
+func (w *cacheWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *cacheWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *cacheWriter) flush() error {
+	if (w.status == 0 || w.status == http.StatusOK) && !hasGraphQLErrors(w.body.Bytes()) {
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", persistedQueryMaxAge))
+	} else {
+		w.Header().Set("Cache-Control", "no-store")
+	}
+	if w.status != 0 {
+		w.httpWriter.WriteHeader(w.status)
+	}
+	if _, err := w.httpWriter.Write(w.body.Bytes()); err != nil {
+		return fmt.Errorf("error writing buffered response; %w", err)
+	}
+	return nil
+}
+
+// hasGraphQLErrors returns true if a response has errors or can't be parsed.
+func hasGraphQLErrors(body []byte) bool {
+	var response struct {
+		Errors []json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return true
+	}
+	return len(response.Errors) > 0
+}
@@ -0,0 +1,51 @@
+package sub
+
+import (
+	"context"
+	"fmt"
+	"github.com/memocash/index/db/item"
+	"github.com/memocash/index/graph/model"
+)
+
+type BroadcastResult struct {
+	Cancel context.CancelFunc
+}
+
+func (b *BroadcastResult) Listen(ctx context.Context, txHashes [][32]byte) (<-chan *model.BroadcastResult, error) {
+	ctx, b.Cancel = context.WithCancel(ctx)
+	broadcastResultListener, err := item.ListenBroadcastResults(ctx, txHashes)
+	if err != nil {
+		b.Cancel()
+		return nil, fmt.Errorf("error getting broadcast result listener for subscription; %w", err)
+	}
+	var broadcastResultChan = make(chan *model.BroadcastResult)
+	go func() {
+		defer func() {
+			close(broadcastResultChan)
+			b.Cancel()
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case broadcastResult, ok := <-broadcastResultListener:
+				if !ok {
+					return
+				}
+				broadcastResultChan <- GetBroadcastResultModel(broadcastResult)
+			}
+		}
+	}()
+	return broadcastResultChan, nil
+}
+
+func GetBroadcastResultModel(broadcastResult *item.BroadcastResult) *model.BroadcastResult {
+	return &model.BroadcastResult{
+		TxHash:    broadcastResult.TxHash,
+		Status:    broadcastResult.Status.String(),
+		Code:      int(broadcastResult.Code),
+		Reason:    broadcastResult.Reason,
+		Host:      broadcastResult.Host,
+		Timestamp: model.Date(broadcastResult.Time),
+	}
+}
@@ -0,0 +1,157 @@
+package sub
+
+import (
+	"context"
+	"fmt"
+	"github.com/memocash/index/db/item/memo"
+	"github.com/memocash/index/graph/attach"
+	"github.com/memocash/index/graph/model"
+	"log"
+	"sync"
+)
+
+const threadSubscriberBuffer = 100
+
+type threadSubscriber struct {
+	postChildren chan *memo.PostChild
+}
+
+// threadListener shares one listener for all post children across thread subscriptions, since replies can be to any
+// post in a thread. The listener is started by the first subscriber and stopped after the last one is removed.
+type threadListener struct {
+	subscribers map[*threadSubscriber]struct{}
+	cancel      context.CancelFunc
+	mutex       sync.Mutex
+}
+
+var _threadListener threadListener
+
+func (l *threadListener) add() (*threadSubscriber, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if len(l.subscribers) == 0 {
+		listenCtx, cancel := context.WithCancel(context.Background())
+		postChildListener, err := memo.ListenAllPostChildren(listenCtx)
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("error getting memo post child listener for thread subscriptions; %w", err)
+		}
+		l.subscribers = make(map[*threadSubscriber]struct{})
+		l.cancel = cancel
+		go l.run(postChildListener, l.subscribers, cancel)
+	}
+	var subscriber = &threadSubscriber{
+		postChildren: make(chan *memo.PostChild, threadSubscriberBuffer),
+	}
+	l.subscribers[subscriber] = struct{}{}
+	return subscriber, nil
+}
+
+func (l *threadListener) remove(subscriber *threadSubscriber) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if _, ok := l.subscribers[subscriber]; !ok {
+		return
+	}
+	delete(l.subscribers, subscriber)
+	if len(l.subscribers) == 0 {
+		l.cancel()
+	}
+}
+
+// run sends each post child to the subscribers of a listener without blocking, a subscriber with a full buffer is
+// too slow and is closed and removed. When the listener closes its subscribers are closed and the next subscriber
+// starts a new listener.
+func (l *threadListener) run(postChildListener chan *memo.PostChild, subscribers map[*threadSubscriber]struct{},
+	cancel context.CancelFunc) {
+	defer cancel()
+	for postChild := range postChildListener {
+		l.mutex.Lock()
+		for subscriber := range subscribers {
+			select {
+			case subscriber.postChildren <- postChild:
+			default:
+				log.Printf("thread subscriber buffer full, closing slow subscriber\n")
+				close(subscriber.postChildren)
+				delete(subscribers, subscriber)
+				if len(subscribers) == 0 {
+					cancel()
+				}
+			}
+		}
+		l.mutex.Unlock()
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	for subscriber := range subscribers {
+		close(subscriber.postChildren)
+		delete(subscribers, subscriber)
+	}
+}
+
+type Thread struct {
+	Nodes  map[[32]byte]*model.ThreadNode
+	Cancel context.CancelFunc
+}
+
+func (t *Thread) Listen(ctx context.Context, root [32]byte, fields []attach.Field) (<-chan *model.ThreadNode, error) {
+	ctx, t.Cancel = context.WithCancel(ctx)
+	subscriber, err := _threadListener.add()
+	if err != nil {
+		t.Cancel()
+		return nil, fmt.Errorf("error adding thread subscriber; %w", err)
+	}
+	nodes, err := attach.ToMemoThread(ctx, nil, root, attach.ThreadMaxDepth, model.ThreadSortOldest)
+	if err != nil {
+		t.Cancel()
+		_threadListener.remove(subscriber)
+		return nil, fmt.Errorf("error getting existing thread for thread subscription; %w", err)
+	}
+	t.Nodes = make(map[[32]byte]*model.ThreadNode)
+	for _, node := range nodes {
+		t.Nodes[node.TxHash] = node
+	}
+	var threadNodeChan = make(chan *model.ThreadNode)
+	go func() {
+		defer func() {
+			close(threadNodeChan)
+			t.Cancel()
+			_threadListener.remove(subscriber)
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case postChild, ok := <-subscriber.postChildren:
+				if !ok {
+					return
+				}
+				parent, ok := t.Nodes[postChild.PostTxHash]
+				if !ok || t.Nodes[postChild.ChildTxHash] != nil {
+					continue
+				}
+				parent.ReplyCount++
+				parentTxHash := parent.TxHash
+				var threadNode = &model.ThreadNode{
+					TxHash:       postChild.ChildTxHash,
+					ParentTxHash: &parentTxHash,
+					Path:         append(append([]model.Hash{}, parent.Path...), parent.TxHash),
+					Depth:        parent.Depth + 1,
+					Post:         &model.Post{TxHash: postChild.ChildTxHash},
+				}
+				t.Nodes[postChild.ChildTxHash] = threadNode
+				postFields := attach.GetPrefixFields(fields, "post.")
+				if err := attach.ToMemoPosts(ctx, postFields, []*model.Post{threadNode.Post}); err != nil {
+					log.Printf("error attaching to post for thread subscription; %v", err)
+					return
+				}
+				select {
+				case threadNodeChan <- threadNode:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return threadNodeChan, nil
+}
@@ -0,0 +1,274 @@
+package maint
+
+import (
+	"context"
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item"
+	"github.com/memocash/index/db/item/chain"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/node/act/tx_raw"
+	"github.com/memocash/index/node/obj/saver"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/config"
+	"github.com/memocash/index/ref/dbi"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+const reindexProgressInterval = 10 * time.Second
+
+// Reindex replays savers for a block height range using txs already stored. Each block is rebuilt from its chain
+// block txs and the raw txs from chain tx, input and output items. Shards are processed in parallel, each handling
+// the txs in its shard in height order, with progress saved per shard so a run with the same range can be resumed.
+type Reindex struct {
+	From     int64
+	To       int64
+	Savers   []string
+	Delete   bool
+	Verbose  bool
+	Blocks   int64
+	Txs      int64
+	Removed  int64
+	heights  map[uint32]int64
+	mu       sync.Mutex
+	combined map[uint32]*saver.CombinedTx
+}
+
+func (r *Reindex) getStatusName() string {
+	return item.ProcessStatusReindex
+}
+
+// getStatus is the range followed by the last height completed, status for another range is ignored.
+func (r *Reindex) getStatus(height int64) []byte {
+	return jutil.CombineBytes(
+		jutil.GetInt64DataBig(r.From),
+		jutil.GetInt64DataBig(r.To),
+		jutil.GetInt64DataBig(height),
+	)
+}
+
+func (r *Reindex) getStartHeight(shard uint32, restart bool) (int64, error) {
+	if restart {
+		return r.From, nil
+	}
+	processStatus, err := item.GetProcessStatus(uint(shard), r.getStatusName())
+	if err != nil && !client.IsMessageNotSetError(err) {
+		return 0, fmt.Errorf("error getting reindex process status; %w", err)
+	}
+	if processStatus == nil || len(processStatus.Status) != 24 ||
+		jutil.GetInt64Big(processStatus.Status[:8]) != r.From || jutil.GetInt64Big(processStatus.Status[8:16]) != r.To {
+		return r.From, nil
+	}
+	return jutil.GetInt64Big(processStatus.Status[16:]) + 1, nil
+}
+
+func (r *Reindex) Run(restart bool) error {
+	if r.From < 0 || r.To < r.From {
+		return fmt.Errorf("error invalid reindex range: %d to %d", r.From, r.To)
+	}
+	if r.Delete {
+		for _, name := range r.Savers {
+			if !saver.CanRemoveDerived(name) {
+				return fmt.Errorf("error delete not supported for saver: %s (supported: %s, %s)", name,
+					saver.NameAddress, saver.NameTxProcessed)
+			}
+		}
+	}
+	shardConfigs := config.GetQueueShards()
+	r.heights = make(map[uint32]int64)
+	r.combined = make(map[uint32]*saver.CombinedTx)
+	var startHeights = make(map[uint32]int64)
+	for _, shardConfig := range shardConfigs {
+		combined, err := saver.NewCombinedTxNames(r.Savers, r.Verbose)
+		if err != nil {
+			return fmt.Errorf("error getting savers for reindex; %w", err)
+		}
+		r.combined[shardConfig.Shard] = combined
+		if startHeights[shardConfig.Shard], err = r.getStartHeight(shardConfig.Shard, restart); err != nil {
+			return fmt.Errorf("error getting reindex start height for shard: %d; %w", shardConfig.Shard, err)
+		}
+		r.heights[shardConfig.Shard] = startHeights[shardConfig.Shard] - 1
+	}
+	var process ShardProcess
+	process.Wg.Add(len(shardConfigs))
+	for _, shardConfig := range shardConfigs {
+		go func(shard uint32) {
+			defer process.Wg.Done()
+			for height := startHeights[shard]; height <= r.To; height++ {
+				if err := r.reindexShardBlock(shard, height); err != nil {
+					process.AddError(shard, fmt.Errorf("error reindexing block at height: %d; %w", height, err))
+					return
+				}
+			}
+			log.Printf("Completed reindex for shard: %d\n", shard)
+		}(shardConfig.Shard)
+	}
+	var done = make(chan struct{})
+	go func() {
+		process.Wg.Wait()
+		close(done)
+	}()
+	ticker := time.NewTicker(reindexProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			if len(process.Errors) > 0 {
+				return fmt.Errorf("error reindexing shards; %w", process.Errors[0])
+			}
+			return nil
+		case <-ticker.C:
+			r.mu.Lock()
+			log.Printf("Reindexing %d to %d: %d block shards, %d txs, %d removed\n",
+				r.From, r.To, r.Blocks, r.Txs, r.Removed)
+			for shard, height := range r.heights {
+				log.Printf("Shard %d height: %d\n", shard, height)
+			}
+			r.mu.Unlock()
+		}
+	}
+}
+
+func (r *Reindex) reindexShardBlock(shard uint32, height int64) error {
+	ctx := context.Background()
+	block, err := GetShardBlock(ctx, shard, height)
+	if err != nil {
+		return fmt.Errorf("error getting stored block for reindex; %w", err)
+	}
+	var removed int
+	if r.Delete && len(block.Transactions) > 0 {
+		var objects []db.Object
+		for _, name := range r.Savers {
+			derived, err := saver.GetDerivedObjects(ctx, name, block.Transactions)
+			if err != nil {
+				return fmt.Errorf("error getting derived items for reindex; %w", err)
+			}
+			objects = append(objects, derived...)
+		}
+		if len(objects) > 0 {
+			if err := db.Remove(objects); err != nil {
+				return fmt.Errorf("error removing derived items for reindex; %w", err)
+			}
+		}
+		removed = len(objects)
+	}
+	if len(block.Transactions) > 0 {
+		if err := r.combined[shard].SaveTxs(ctx, block); err != nil {
+			return fmt.Errorf("error saving txs for reindex; %w", err)
+		}
+	}
+	processStatus := item.NewProcessStatus(uint(shard), r.getStatusName())
+	processStatus.Status = r.getStatus(height)
+	if err := processStatus.Save(); err != nil {
+		return fmt.Errorf("error saving reindex process status; %w", err)
+	}
+	r.mu.Lock()
+	r.Blocks++
+	r.Txs += int64(len(block.Transactions))
+	r.Removed += int64(removed)
+	r.heights[shard] = height
+	r.mu.Unlock()
+	return nil
+}
+
+// GetShardBlock rebuilds the block at a height from stored items with only the txs in the shard, in block order.
+// Txs are marked as saved with their first seen time, the same as a block saved by a cluster shard after sync.
+func GetShardBlock(ctx context.Context, shard uint32, height int64) (*dbi.Block, error) {
+	heightBlock, err := chain.GetHeightBlockSingle(height)
+	if err != nil {
+		return nil, fmt.Errorf("error getting height block; %w", err)
+	}
+	chainBlock, err := chain.GetBlock(heightBlock.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chain block; %w", err)
+	}
+	header, err := memo.GetBlockHeaderFromRaw(chainBlock.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing chain block header; %w", err)
+	}
+	var block = &dbi.Block{
+		Header: *header,
+		Height: height,
+		Seen:   header.Timestamp,
+	}
+	var txIndexes = make(map[[32]byte]uint32)
+	var txHashes [][32]byte
+	for startIndex := uint32(0); ; {
+		blockTxs, err := chain.GetBlockTxs(chain.BlockTxsRequest{
+			Context:    ctx,
+			BlockHash:  heightBlock.BlockHash,
+			StartIndex: startIndex,
+			Limit:      client.LargeLimit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error getting chain block txs; %w", err)
+		}
+		for _, blockTx := range blockTxs {
+			if blockTx.Index >= startIndex {
+				startIndex = blockTx.Index + 1
+			}
+			if db.GetShardIdFromByte32(blockTx.TxHash[:]) != shard {
+				continue
+			}
+			if _, ok := txIndexes[blockTx.TxHash]; !ok {
+				txHashes = append(txHashes, blockTx.TxHash)
+			}
+			txIndexes[blockTx.TxHash] = blockTx.Index
+		}
+		if len(blockTxs) < client.LargeLimit {
+			break
+		}
+	}
+	if len(txHashes) == 0 {
+		return block, nil
+	}
+	txRaws, err := tx_raw.Get(ctx, txHashes)
+	if err != nil {
+		return nil, fmt.Errorf("error getting raw txs; %w", err)
+	}
+	if len(txRaws) != len(txHashes) {
+		return nil, fmt.Errorf("error raw txs missing for block: %d of %d found", len(txRaws), len(txHashes))
+	}
+	txSeens, err := chain.GetTxSeens(ctx, txHashes)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tx seens; %w", err)
+	}
+	var seens = make(map[[32]byte]time.Time)
+	for _, txSeen := range txSeens {
+		if seen, ok := seens[txSeen.TxHash]; !ok || txSeen.Timestamp.Before(seen) {
+			seens[txSeen.TxHash] = txSeen.Timestamp
+		}
+	}
+	for _, txRaw := range txRaws {
+		msgTx, err := memo.GetMsgFromRaw(txRaw.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing raw tx; %w", err)
+		}
+		tx := dbi.WireTxToTx(msgTx, txIndexes[txRaw.Hash])
+		tx.Saved = true
+		if seen, ok := seens[txRaw.Hash]; ok {
+			tx.Seen = seen
+		} else {
+			tx.Seen = block.Seen
+		}
+		block.Transactions = append(block.Transactions, *tx)
+	}
+	sort.Slice(block.Transactions, func(i, j int) bool {
+		return block.Transactions[i].BlockIndex < block.Transactions[j].BlockIndex
+	})
+	return block, nil
+}
+
+func NewReindex(from, to int64, savers []string, deleteDerived, verbose bool) *Reindex {
+	return &Reindex{
+		From:    from,
+		To:      to,
+		Savers:  savers,
+		Delete:  deleteDerived,
+		Verbose: verbose,
+	}
+}
@@ -0,0 +1,161 @@
+package node
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item"
+	"github.com/memocash/index/ref/config"
+	"log"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	CrawlerDefaultParallel = 16
+	CrawlerDefaultInterval = time.Hour
+	CrawlerDefaultTimeout  = 20 * time.Second
+
+	crawlerPassDelay = time.Minute
+)
+
+// Crawler continuously connects to found peers, recording their version and addrs. A peer is crawled again once
+// Interval has passed since its last connection attempt.
+type Crawler struct {
+	Parallel int
+	Interval time.Duration
+	Timeout  time.Duration
+	Crawled  int
+	Failed   int
+	running  bool
+	stop     chan struct{}
+	mutex    sync.Mutex
+}
+
+func (c *Crawler) IsRunning() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.running
+}
+
+func (c *Crawler) GetCounts() (int, int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.Crawled, c.Failed
+}
+
+func (c *Crawler) Start() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.running {
+		return
+	}
+	c.running = true
+	c.stop = make(chan struct{})
+	go c.run(c.stop)
+}
+
+func (c *Crawler) Stop() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if !c.running {
+		return
+	}
+	c.running = false
+	close(c.stop)
+}
+
+func (c *Crawler) run(stop chan struct{}) {
+	log.Printf("Starting peer crawler (parallel: %d)\n", c.Parallel)
+	var peers = make(chan *item.Peer)
+	var wg sync.WaitGroup
+	for i := 0; i < c.Parallel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for peer := range peers {
+				c.crawl(peer)
+			}
+		}()
+	}
+	defer func() {
+		close(peers)
+		wg.Wait()
+		log.Println("Stopped peer crawler")
+	}()
+	for {
+		if err := c.pass(peers, stop); err != nil {
+			log.Printf("error running peer crawler pass; %v", err)
+		}
+		select {
+		case <-stop:
+			return
+		case <-time.After(crawlerPassDelay):
+		}
+	}
+}
+
+// pass sends each found peer not attempted within the interval to the crawl workers.
+func (c *Crawler) pass(peers chan *item.Peer, stop chan struct{}) error {
+	for shard := uint32(0); shard < config.GetTotalShards(); shard++ {
+		for startId := []byte{}; ; {
+			shardPeers, err := item.GetPeers(shard, startId)
+			if err != nil {
+				return fmt.Errorf("error getting peers for crawler; %w", err)
+			}
+			for i, peer := range shardPeers {
+				if i == 0 && bytes.Equal(peer.GetUid(), startId) {
+					continue
+				}
+				if !c.isDue(peer) {
+					continue
+				}
+				select {
+				case peers <- peer:
+				case <-stop:
+					return nil
+				}
+			}
+			if len(shardPeers) < client.LargeLimit {
+				break
+			}
+			startId = shardPeers[len(shardPeers)-1].GetUid()
+		}
+	}
+	return nil
+}
+
+func (c *Crawler) isDue(peer *item.Peer) bool {
+	if ip := net.IP(peer.Ip); ip.IsUnspecified() || ip.IsLoopback() || peer.Port == 0 {
+		return false
+	}
+	peerConnection, err := item.GetPeerConnectionLast(peer.Ip, peer.Port)
+	if err != nil && !client.IsEntryNotFoundError(err) {
+		log.Printf("error getting last peer connection for crawler; %v", err)
+		return false
+	}
+	return peerConnection == nil || time.Since(peerConnection.Time) > c.Interval
+}
+
+func (c *Crawler) crawl(peer *item.Peer) {
+	server := NewServer(peer.Ip, peer.Port)
+	server.Timeout = c.Timeout
+	server.Crawl = true
+	err := server.Run()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if err != nil {
+		c.Failed++
+	} else {
+		c.Crawled++
+	}
+}
+
+func NewCrawler() *Crawler {
+	return &Crawler{
+		Parallel: CrawlerDefaultParallel,
+		Interval: CrawlerDefaultInterval,
+		Timeout:  CrawlerDefaultTimeout,
+	}
+}
@@ -14,6 +14,7 @@ type Group struct {
 	Looping    bool
 	LastPeerId []byte
 	StartTime  time.Time
+	Crawler    *Crawler
 }
 
 func (g Group) HasActive() bool {
@@ -91,7 +92,8 @@ func (g *Group) AddNode(ip []byte, port uint16) {
 
 func NewGroup() *Group {
 	return &Group{
-		Nodes: make(map[string]*Server),
+		Nodes:   make(map[string]*Server),
+		Crawler: NewCrawler(),
 	}
 }
 
@@ -34,11 +34,14 @@ func GetHandlers() ([]*Handler, error) {
 		memoNameHandler,
 		memoProfileHandler,
 		memoProfilePicHandler,
+		memoImageBaseHandler,
 		memoFollowHandler,
 		memoUnfollowHandler,
 		memoPostHandler,
 		memoLikeHandler,
 		memoReplyHandler,
+		memoRepostHandler,
+		memoAttachPictureHandler,
 		memoRoomPostHandler,
 		memoRoomFollowHandler,
 		memoRoomUnfollowHandler,
@@ -0,0 +1,49 @@
+package op_return
+
+import (
+	"context"
+	"fmt"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/item"
+	"github.com/memocash/index/db/item/db"
+	dbMemo "github.com/memocash/index/db/item/memo"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/tx/parse"
+)
+
+var memoAttachPictureHandler = &Handler{
+	prefix: memo.PrefixAttachPicture,
+	handle: func(ctx context.Context, info parse.OpReturn) error {
+		if len(info.PushData) != 3 {
+			if err := item.LogProcessError(&item.ProcessError{
+				TxHash: info.TxHash,
+				Error:  fmt.Sprintf("invalid attach picture, incorrect push data (%d)", len(info.PushData)),
+			}); err != nil {
+				return fmt.Errorf("error saving process error for memo attach picture incorrect push data; %w", err)
+			}
+			return nil
+		}
+		postTxHash, err := chainhash.NewHash(info.PushData[1])
+		if err != nil {
+			if err := item.LogProcessError(&item.ProcessError{
+				TxHash: info.TxHash,
+				Error:  fmt.Sprintf("invalid post tx hash for attach picture (%x); %s", info.PushData[1], err),
+			}); err != nil {
+				return fmt.Errorf("error saving process error for memo attach picture invalid post tx hash; %w", err)
+			}
+			return nil
+		}
+		var memoPostPicture = &dbMemo.PostPicture{
+			PostTxHash: *postTxHash,
+			Seen:       info.Seen,
+			TxHash:     info.TxHash,
+			Addr:       info.Addr,
+			Picture:    jutil.GetUtf8String(info.PushData[2]),
+		}
+		if err := db.Save([]db.Object{memoPostPicture}); err != nil {
+			return fmt.Errorf("error saving db memo post picture object; %w", err)
+		}
+		return nil
+	},
+}
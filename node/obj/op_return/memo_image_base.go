@@ -0,0 +1,37 @@
+package op_return
+
+import (
+	"context"
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/item"
+	"github.com/memocash/index/db/item/db"
+	dbMemo "github.com/memocash/index/db/item/memo"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/tx/parse"
+)
+
+var memoImageBaseHandler = &Handler{
+	prefix: memo.PrefixSetImageBaseUrl,
+	handle: func(ctx context.Context, info parse.OpReturn) error {
+		if len(info.PushData) != 2 {
+			if err := item.LogProcessError(&item.ProcessError{
+				TxHash: info.TxHash,
+				Error:  fmt.Sprintf("invalid set image base url, incorrect push data (%d)", len(info.PushData)),
+			}); err != nil {
+				return fmt.Errorf("error saving process error for memo image base incorrect push data; %w", err)
+			}
+			return nil
+		}
+		var addrMemoImageBase = &dbMemo.AddrImageBase{
+			Addr:    info.Addr,
+			Seen:    info.Seen,
+			TxHash:  info.TxHash,
+			BaseUrl: jutil.GetUtf8String(info.PushData[1]),
+		}
+		if err := db.Save([]db.Object{addrMemoImageBase}); err != nil {
+			return fmt.Errorf("error saving db addr memo image base object; %w", err)
+		}
+		return nil
+	},
+}
@@ -0,0 +1,65 @@
+package op_return
+
+import (
+	"context"
+	"fmt"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/item"
+	"github.com/memocash/index/db/item/db"
+	dbMemo "github.com/memocash/index/db/item/memo"
+	"github.com/memocash/index/node/obj/op_return/save"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/tx/parse"
+)
+
+var memoRepostHandler = &Handler{
+	prefix: memo.PrefixRepost,
+	handle: func(ctx context.Context, info parse.OpReturn) error {
+		if len(info.PushData) != 2 && len(info.PushData) != 3 {
+			if err := item.LogProcessError(&item.ProcessError{
+				TxHash: info.TxHash,
+				Error:  fmt.Sprintf("invalid repost, incorrect push data (%d)", len(info.PushData)),
+			}); err != nil {
+				return fmt.Errorf("error saving process error for memo repost incorrect push data; %w", err)
+			}
+			return nil
+		}
+		postTxHash, err := chainhash.NewHash(info.PushData[1])
+		if err != nil {
+			if err := item.LogProcessError(&item.ProcessError{
+				TxHash: info.TxHash,
+				Error:  fmt.Sprintf("invalid post tx hash for repost (%x); %s", info.PushData[1], err),
+			}); err != nil {
+				return fmt.Errorf("error saving process error for memo repost invalid post tx hash; %w", err)
+			}
+			return nil
+		}
+		var memoRepost = &dbMemo.Repost{
+			RepostTxHash: info.TxHash,
+			PostTxHash:   *postTxHash,
+		}
+		var memoPostRepost = &dbMemo.PostRepost{
+			PostTxHash:   *postTxHash,
+			RepostTxHash: info.TxHash,
+		}
+		var memoAddrRepost = &dbMemo.AddrRepost{
+			Addr:         info.Addr,
+			Seen:         info.Seen,
+			RepostTxHash: info.TxHash,
+			PostTxHash:   *postTxHash,
+		}
+		if err := db.Save([]db.Object{memoRepost, memoPostRepost, memoAddrRepost}); err != nil {
+			return fmt.Errorf("error saving memo repost objects for memo repost handler; %w", err)
+		}
+		var quote string
+		if len(info.PushData) == 3 {
+			quote = jutil.GetUtf8String(info.PushData[2])
+		}
+		// Reposts are also saved as posts so they show in newest posts and profile posts used to build feeds.
+		if err := save.MemoPost(ctx, info, quote); err != nil {
+			return fmt.Errorf("error saving memo post for memo repost handler; %w", err)
+		}
+		return nil
+	},
+}
@@ -62,6 +62,7 @@ func (s *Server) Run() error {
 	}()
 	if !s.Dev {
 		processor := lead.NewProcessor(s.Verbose)
+		adminServer.Backfill = processor.Backfill
 		log.Printf("Cluster lead processor starting...\n")
 		go func() {
 			errorHandler <- fmt.Errorf("error running cluster lead processor; %w", processor.Run())
@@ -72,8 +73,8 @@ func (s *Server) Run() error {
 				return fmt.Errorf("error parsing raw tx; %w", err)
 			}
 			log.Printf("Broadcasting transaction: %s\n", txMsg.TxHash())
-			if err := processor.BlockNode.Peer.BroadcastTx(ctx, txMsg); err != nil {
-				return fmt.Errorf("error broadcasting tx to connection peer; %w", err)
+			if err := processor.BroadcastTx(ctx, txMsg); err != nil {
+				return fmt.Errorf("error broadcasting tx upstream; %w", err)
 			}
 			return nil
 		})
@@ -23,33 +23,21 @@ func (a *Address) SaveTxs(ctx context.Context, b *dbi.Block) error {
 	if b.IsNil() {
 		return fmt.Errorf("error nil block")
 	}
+	if err := db.Save(a.GetObjects(b)); err != nil {
+		return fmt.Errorf("error saving db tx objects; %w", err)
+	}
+	return nil
+}
+
+// GetObjects returns the seen tx items saved for a block's txs.
+func (a *Address) GetObjects(b *dbi.Block) []db.Object {
 	var objects []db.Object
 	for _, transaction := range b.Transactions {
-		var tx = transaction.MsgTx
 		txHash := chainhash.Hash(transaction.Hash)
 		if a.Verbose {
 			log.Printf("tx: %s\n", txHash.String())
 		}
-		var addrs = make(map[wallet.Addr]struct{})
-		for j := range tx.TxIn {
-			if memo.IsCoinbaseInput(tx.TxIn[j]) {
-				continue
-			}
-			address, err := wallet.GetAddrFromUnlockScript(tx.TxIn[j].SignatureScript)
-			if err != nil {
-				//log.Printf("error getting address from unlock script; %v", err)
-				continue
-			}
-			addrs[*address] = struct{}{}
-		}
-		for h := range tx.TxOut {
-			address, err := wallet.GetAddrFromLockScript(tx.TxOut[h].PkScript)
-			if err != nil {
-				continue
-			}
-			addrs[*address] = struct{}{}
-		}
-		for address := range addrs {
+		for address := range getTxAddrs(transaction) {
 			if address.IsP2SH() {
 				a.P2shCount++
 				if a.Verbose {
@@ -68,10 +56,32 @@ func (a *Address) SaveTxs(ctx context.Context, b *dbi.Block) error {
 			})
 		}
 	}
-	if err := db.Save(objects); err != nil {
-		return fmt.Errorf("error saving db tx objects; %w", err)
+	return objects
+}
+
+// getTxAddrs returns the addresses of a tx's inputs and outputs, the addresses a seen tx item is saved for.
+func getTxAddrs(transaction dbi.Tx) map[wallet.Addr]struct{} {
+	var tx = transaction.MsgTx
+	var addrs = make(map[wallet.Addr]struct{})
+	for j := range tx.TxIn {
+		if memo.IsCoinbaseInput(tx.TxIn[j]) {
+			continue
+		}
+		address, err := wallet.GetAddrFromUnlockScript(tx.TxIn[j].SignatureScript)
+		if err != nil {
+			//log.Printf("error getting address from unlock script; %v", err)
+			continue
+		}
+		addrs[*address] = struct{}{}
 	}
-	return nil
+	for h := range tx.TxOut {
+		address, err := wallet.GetAddrFromLockScript(tx.TxOut[h].PkScript)
+		if err != nil {
+			continue
+		}
+		addrs[*address] = struct{}{}
+	}
+	return addrs
 }
 
 func NewAddress(verbose bool) *Address {
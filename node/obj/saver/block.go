@@ -1,12 +1,13 @@
 package saver
 
 import (
+	"errors"
 	"fmt"
 	"github.com/jchavannes/btcd/chaincfg/chainhash"
-	"github.com/memocash/index/db/client"
 	"github.com/memocash/index/db/item/chain"
 	"github.com/memocash/index/db/item/db"
 	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/pow"
 	"github.com/memocash/index/ref/config"
 	"github.com/memocash/index/ref/dbi"
 	"log"
@@ -38,54 +39,55 @@ func (b *Block) saveBlockObjects(info dbi.BlockInfo) error {
 		Hash: b.BlockHash,
 		Raw:  headerRaw,
 	}
-	var parentHeight int64
-	var hasParent bool
-	if info.Header.PrevBlock == b.PrevBlockHash {
-		parentHeight = b.PrevBlockHeight
-		hasParent = true
-	} else {
-		parentBlockHeight, err := chain.GetBlockHeight(info.Header.PrevBlock)
-		if err != nil && !client.IsEntryNotFoundError(err) {
-			return fmt.Errorf("error getting parent block height for potential orphan; %w", err)
-		}
-		if parentBlockHeight != nil {
-			parentHeight = parentBlockHeight.Height
-			hasParent = true
-			if b.PrevBlockHash != [32]byte{} {
-				objects = append(objects, &chain.HeightDuplicate{
-					Height:    parentHeight + 1,
-					BlockHash: b.BlockHash,
-				})
-			}
-		}
+	headerChain, err := GetHeaderChain()
+	if err != nil {
+		return fmt.Errorf("error getting header chain for block save; %w", err)
 	}
-	if hasParent {
-		b.NewHeight = parentHeight + 1
-	} else {
-		initBlockParent, err := chainhash.NewHashFromStr(config.GetInitBlockParent())
-		if err != nil {
-			return fmt.Errorf("error parsing init block hash; %w", err)
-		}
-		if *initBlockParent == info.Header.PrevBlock {
-			b.NewHeight = int64(config.GetInitBlockHeight())
-		} else {
-			b.NewHeight = 0
-			// block does not match parent or config init block
-		}
+	node, err := headerChain.Check(info.Header)
+	if err != nil && !errors.Is(err, HeaderParentNotFoundError) {
+		return fmt.Errorf("error checking block header; %w", err)
 	}
 	var heightBlock *chain.HeightBlock
-	if b.NewHeight != 0 {
-		heightBlock = &chain.HeightBlock{
-			Height:    b.NewHeight,
-			BlockHash: b.BlockHash,
+	var mainChainObjects, removeObjects []db.Object
+	var isBest bool
+	if node == nil {
+		b.NewHeight = 0
+		// block does not match parent or config init block
+	} else {
+		b.NewHeight = node.Height
+		if b.PrevBlockHash != [32]byte{} && info.Header.PrevBlock != b.PrevBlockHash &&
+			b.NewHeight != int64(config.GetInitBlockHeight()) {
+			objects = append(objects, &chain.HeightDuplicate{
+				Height:    b.NewHeight,
+				BlockHash: b.BlockHash,
+			})
 		}
-		var blockHeight = &chain.BlockHeight{
+		objects = append(objects, &chain.BlockHeight{
 			Height:    b.NewHeight,
 			BlockHash: b.BlockHash,
+		})
+		if node.Work != nil {
+			objects = append(objects, &chain.BlockWork{
+				BlockHash: b.BlockHash,
+				Work:      node.Work,
+			})
+		}
+		if isBest, err = headerChain.IsBest(node); err != nil {
+			return fmt.Errorf("error checking if block is best; %w", err)
+		}
+		if isBest {
+			heightBlock = &chain.HeightBlock{
+				Height:    b.NewHeight,
+				BlockHash: b.BlockHash,
+			}
+			if mainChainObjects, removeObjects, err = b.getMainChainObjects(headerChain, node); err != nil {
+				return fmt.Errorf("error getting main chain objects for block; %w", err)
+			}
+			b.PrevBlockHeight = b.NewHeight
+			b.PrevBlockHash = b.BlockHash
+		} else if b.Verbose {
+			log.Printf("block has less work than tip, not setting height block: %s\n", b.BlockHash)
 		}
-		objects = append(objects, blockHeight)
-		b.PrevBlockHeight = b.NewHeight
-		b.PrevBlockHash = b.BlockHash
 	}
 	if info.Size > 0 {
 		objects = append(objects, &chain.BlockInfo{
@@ -97,15 +99,88 @@ func (b *Block) saveBlockObjects(info dbi.BlockInfo) error {
 	if err := db.Save(objects); err != nil {
 		return fmt.Errorf("error saving new db block objects; %w", err)
 	}
+	if len(removeObjects) > 0 {
+		if err := db.Remove(removeObjects); err != nil {
+			return fmt.Errorf("error removing reorged height blocks; %w", err)
+		}
+	}
+	if len(mainChainObjects) > 0 {
+		if err := db.Save(mainChainObjects); err != nil {
+			return fmt.Errorf("error saving reorg main chain height blocks; %w", err)
+		}
+	}
 	if heightBlock != nil {
 		// Save height block afterward to avoid race conditions with listeners not being able to find block info
 		if err := db.Save([]db.Object{heightBlock}); err != nil {
 			return fmt.Errorf("error saving height block; %w", err)
 		}
 	}
+	if isBest {
+		headerChain.SetTip(node)
+	}
 	return nil
 }
 
+// getMainChainObjects returns height blocks to save and remove when a block with more work is not a child of the
+// current tip. Ancestors not on the main chain replace the height blocks at their heights and height blocks of the
+// previous chain above the new block are removed.
+func (b *Block) getMainChainObjects(headerChain *HeaderChain, node *pow.Node) ([]db.Object, []db.Object, error) {
+	tip, err := headerChain.GetTip()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting header chain tip; %w", err)
+	}
+	if tip == nil || tip.Hash == node.Header.PrevBlock {
+		return nil, nil, nil
+	}
+	var saveObjects, removeObjects []db.Object
+	for ancestor := node; ancestor != nil; {
+		heightBlocks, err := chain.GetHeightBlock(ancestor.Height)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting height blocks for reorg ancestor; %w", err)
+		}
+		var onMainChain bool
+		for _, heightBlock := range heightBlocks {
+			if heightBlock.BlockHash == ancestor.Hash {
+				onMainChain = true
+			}
+		}
+		if onMainChain && ancestor != node {
+			break
+		}
+		for _, heightBlock := range heightBlocks {
+			if heightBlock.BlockHash != ancestor.Hash {
+				removeObjects = append(removeObjects, heightBlock)
+			}
+		}
+		if ancestor != node {
+			saveObjects = append(saveObjects, &chain.HeightBlock{
+				Height:    ancestor.Height,
+				BlockHash: ancestor.Hash,
+			})
+		}
+		if ancestor, err = headerChain.GetParent(ancestor); err != nil {
+			return nil, nil, fmt.Errorf("error getting parent of reorg ancestor; %w", err)
+		}
+	}
+	for height := node.Height + 1; ; height++ {
+		heightBlocks, err := chain.GetHeightBlock(height)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting height blocks above reorg block; %w", err)
+		}
+		if len(heightBlocks) == 0 {
+			break
+		}
+		for _, heightBlock := range heightBlocks {
+			removeObjects = append(removeObjects, heightBlock)
+		}
+	}
+	if len(removeObjects) > 0 {
+		log.Printf("reorg to block: %s (height: %d, removing %d height blocks)\n",
+			node.Hash, node.Height, len(removeObjects))
+	}
+	return saveObjects, removeObjects, nil
+}
+
 func (b *Block) GetBlock(heightBack int64) (*chainhash.Hash, error) {
 	heightBlock, err := chain.GetRecentHeightBlock()
 	if err != nil {
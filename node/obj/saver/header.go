@@ -0,0 +1,306 @@
+package saver
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/chain"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/pow"
+	"github.com/memocash/index/ref/config"
+	"math/big"
+	"sync"
+)
+
+const (
+	headerCacheMax  = 20000
+	headerCacheKeep = 2000
+)
+
+var (
+	InvalidHeaderError        = fmt.Errorf("error invalid header")
+	HeaderParentNotFoundError = fmt.Errorf("error header parent not found")
+)
+
+// HeaderChain validates headers against proof-of-work, difficulty and checkpoint rules and tracks cumulative
+// chainwork. Validated headers are kept in memory so a batch of headers can be checked before their blocks are saved.
+type HeaderChain struct {
+	Params      *pow.Params
+	Checkpoints map[int64]chainhash.Hash
+	nodes       map[chainhash.Hash]*pow.Node
+	tip         *pow.Node
+	maxHeight   int64
+	mutex       sync.Mutex
+}
+
+// Check validates a header and returns it with its height and cumulative work. Work is only counted from the init
+// block, so the work of a child of a parent with unknown work is also unknown (nil). Headers with an unknown parent
+// return HeaderParentNotFoundError and headers breaking consensus rules return InvalidHeaderError.
+func (h *HeaderChain) Check(header wire.BlockHeader) (*pow.Node, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	blockHash := header.BlockHash()
+	if node, ok := h.nodes[blockHash]; ok {
+		return node, nil
+	}
+	if err := pow.CheckProofOfWork(header, h.Params); err != nil {
+		return nil, fmt.Errorf("error checking header proof of work: %s; %w", err, InvalidHeaderError)
+	}
+	parent, err := h.getNode(header.PrevBlock)
+	if err != nil {
+		return nil, fmt.Errorf("error getting header parent node; %w", err)
+	}
+	var height int64
+	var parentWork *big.Int
+	if parent == nil {
+		initBlockParent, err := chainhash.NewHashFromStr(config.GetInitBlockParent())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing init block parent; %w", err)
+		}
+		if header.PrevBlock != *initBlockParent {
+			return nil, fmt.Errorf("error header parent: %s; %w", header.PrevBlock, HeaderParentNotFoundError)
+		}
+		if blockHash.String() != config.GetInitBlock() {
+			return nil, fmt.Errorf("error header does not match init block: %s; %w", blockHash, InvalidHeaderError)
+		}
+		height = int64(config.GetInitBlockHeight())
+		parentWork = new(big.Int)
+	} else {
+		height = parent.Height + 1
+		parentWork = parent.Work
+		bits, ok, err := h.getNextBits(parent)
+		if err != nil {
+			return nil, fmt.Errorf("error getting next bits for header; %w", err)
+		}
+		isMinDifficulty := h.Params.AllowMinDifficulty && header.Bits == h.Params.PowLimitBits &&
+			header.Timestamp.Unix() > parent.GetTime()+2*h.Params.TargetSpacing
+		if ok && header.Bits != bits && !isMinDifficulty {
+			return nil, fmt.Errorf("error header bits: %08x, expected: %08x (height: %d); %w",
+				header.Bits, bits, height, InvalidHeaderError)
+		}
+	}
+	if checkpoint, ok := h.Checkpoints[height]; ok && checkpoint != blockHash {
+		return nil, fmt.Errorf("error header does not match checkpoint: %s, expected: %s (height: %d); %w",
+			blockHash, checkpoint, height, InvalidHeaderError)
+	}
+	node := pow.NewNode(header, height, parentWork)
+	h.add(node)
+	return node, nil
+}
+
+// getNextBits returns the bits required for a child of the parent. Difficulty is not checked before cw-144 or
+// when ancestors needed for cw-144 are not available, e.g. the first blocks after INIT_BLOCK.
+func (h *HeaderChain) getNextBits(parent *pow.Node) (uint32, bool, error) {
+	if h.Params.NoRetargeting {
+		return parent.Header.Bits, true, nil
+	}
+	if h.Params.IsAsert(parent.Height) {
+		return pow.NextAsertBits(h.Params, parent.Height, parent.GetTime()), true, nil
+	}
+	if !h.Params.IsDaa(parent.Height) {
+		return 0, false, nil
+	}
+	last, err := h.getAncestors(parent, 3)
+	if err != nil {
+		return 0, false, fmt.Errorf("error getting last ancestors for cw-144; %w", err)
+	}
+	if len(last) != 3 {
+		return 0, false, nil
+	}
+	firstEnd := last[0]
+	for i := 2; firstEnd != nil && i < pow.Cw144Window; i++ {
+		if firstEnd, err = h.getNode(firstEnd.Header.PrevBlock); err != nil {
+			return 0, false, fmt.Errorf("error getting cw-144 window ancestor; %w", err)
+		}
+	}
+	if firstEnd == nil {
+		return 0, false, nil
+	}
+	first, err := h.getAncestors(firstEnd, 3)
+	if err != nil {
+		return 0, false, fmt.Errorf("error getting first ancestors for cw-144; %w", err)
+	}
+	if len(first) != 3 {
+		return 0, false, nil
+	}
+	for _, node := range append(first, last...) {
+		if node.Work == nil {
+			return 0, false, nil
+		}
+	}
+	return pow.NextCashWorkBits(h.Params, first, last), true, nil
+}
+
+// getAncestors returns count nodes ending at the node, oldest first, or fewer if not all are known.
+func (h *HeaderChain) getAncestors(node *pow.Node, count int) ([]*pow.Node, error) {
+	var ancestors = make([]*pow.Node, count)
+	ancestors[count-1] = node
+	for i := count - 2; i >= 0; i-- {
+		parent, err := h.getNode(ancestors[i+1].Header.PrevBlock)
+		if err != nil {
+			return nil, fmt.Errorf("error getting ancestor node; %w", err)
+		}
+		if parent == nil {
+			return nil, nil
+		}
+		ancestors[i] = parent
+	}
+	return ancestors, nil
+}
+
+// getNode returns a node from memory or loads it from the db, nil if the block is not known or has no height.
+func (h *HeaderChain) getNode(blockHash chainhash.Hash) (*pow.Node, error) {
+	if node, ok := h.nodes[blockHash]; ok {
+		return node, nil
+	}
+	block, err := chain.GetBlock(blockHash)
+	if err != nil {
+		if client.IsEntryNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting chain block for header node; %w", err)
+	}
+	header, err := memo.GetBlockHeaderFromRaw(block.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing chain block header for header node; %w", err)
+	}
+	blockHeight, err := chain.GetBlockHeight(blockHash)
+	if err != nil {
+		if client.IsEntryNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting block height for header node; %w", err)
+	}
+	var node = &pow.Node{
+		Hash:   blockHash,
+		Header: *header,
+		Height: blockHeight.Height,
+	}
+	blockWork, err := chain.GetBlockWork(blockHash)
+	if err != nil && !client.IsEntryNotFoundError(err) {
+		return nil, fmt.Errorf("error getting block work for header node; %w", err)
+	}
+	if blockWork != nil {
+		node.Work = blockWork.Work
+	}
+	h.add(node)
+	return node, nil
+}
+
+func (h *HeaderChain) GetParent(node *pow.Node) (*pow.Node, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	parent, err := h.getNode(node.Header.PrevBlock)
+	if err != nil {
+		return nil, fmt.Errorf("error getting parent header node; %w", err)
+	}
+	return parent, nil
+}
+
+// AddNode keeps a node in memory, e.g. a header known from another source, so children can be checked against it.
+func (h *HeaderChain) AddNode(node *pow.Node) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.add(node)
+}
+
+func (h *HeaderChain) add(node *pow.Node) {
+	h.nodes[node.Hash] = node
+	if node.Height > h.maxHeight {
+		h.maxHeight = node.Height
+	}
+	if len(h.nodes) <= headerCacheMax {
+		return
+	}
+	for blockHash, node := range h.nodes {
+		if node.Height < h.maxHeight-headerCacheKeep {
+			delete(h.nodes, blockHash)
+		}
+	}
+}
+
+// GetTip returns the node of the current best block, loaded from the most recent height block on first use.
+func (h *HeaderChain) GetTip() (*pow.Node, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.tip != nil {
+		return h.tip, nil
+	}
+	heightBlock, err := chain.GetRecentHeightBlock()
+	if err != nil {
+		return nil, fmt.Errorf("error getting recent height block for header tip; %w", err)
+	}
+	if heightBlock == nil {
+		return nil, nil
+	}
+	if h.tip, err = h.getNode(heightBlock.BlockHash); err != nil {
+		return nil, fmt.Errorf("error getting header tip node; %w", err)
+	}
+	return h.tip, nil
+}
+
+// IsBest returns whether the node has more work than the current tip. Tips saved before chainwork was tracked
+// are compared by height.
+func (h *HeaderChain) IsBest(node *pow.Node) (bool, error) {
+	tip, err := h.GetTip()
+	if err != nil {
+		return false, fmt.Errorf("error getting tip for header chain best check; %w", err)
+	}
+	if tip == nil || tip.Hash == node.Hash || tip.Hash == node.Header.PrevBlock {
+		return true, nil
+	}
+	if tip.Work == nil || node.Work == nil {
+		return node.Height > tip.Height, nil
+	}
+	return node.Work.Cmp(tip.Work) > 0, nil
+}
+
+func (h *HeaderChain) SetTip(node *pow.Node) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.tip = node
+}
+
+func NewHeaderChain(params *pow.Params, checkpoints []config.Checkpoint) (*HeaderChain, error) {
+	var headerChain = &HeaderChain{
+		Params:      params,
+		Checkpoints: make(map[int64]chainhash.Hash),
+		nodes:       make(map[chainhash.Hash]*pow.Node),
+	}
+	for _, checkpoint := range checkpoints {
+		blockHash, err := chainhash.NewHashFromStr(checkpoint.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing checkpoint hash (height: %d); %w", checkpoint.Height, err)
+		}
+		headerChain.Checkpoints[checkpoint.Height] = *blockHash
+	}
+	return headerChain, nil
+}
+
+var _headerChain struct {
+	HeaderChain *HeaderChain
+	Err         error
+	Once        sync.Once
+}
+
+// GetHeaderChain returns the header chain shared by block savers in this process.
+func GetHeaderChain() (*HeaderChain, error) {
+	_headerChain.Once.Do(func() {
+		params := pow.GetNetworkParams(config.GetNetwork())
+		if params == nil {
+			_headerChain.Err = fmt.Errorf("error unknown network for header chain: %s", config.GetNetwork())
+			return
+		}
+		_headerChain.HeaderChain, _headerChain.Err = NewHeaderChain(params, config.GetCheckpoints())
+	})
+	if _headerChain.Err != nil {
+		return nil, fmt.Errorf("error getting header chain; %w", _headerChain.Err)
+	}
+	return _headerChain.HeaderChain, nil
+}
+
+func IsInvalidHeaderError(err error) bool {
+	return errors.Is(err, InvalidHeaderError)
+}
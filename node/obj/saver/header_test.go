@@ -0,0 +1,75 @@
+package saver_test
+
+import (
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/node/obj/saver"
+	"github.com/memocash/index/ref/bitcoin/pow"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"math/big"
+	"testing"
+	"time"
+)
+
+const testBits = 0x207fffff
+
+func getTestHeader(t *testing.T, prevBlock chainhash.Hash, params *pow.Params) wire.BlockHeader {
+	var header = wire.BlockHeader{
+		Version:   1,
+		PrevBlock: prevBlock,
+		Timestamp: time.Unix(1700000000, 0),
+		Bits:      testBits,
+	}
+	for ; header.Nonce < 1000; header.Nonce++ {
+		if pow.CheckProofOfWork(header, params) == nil {
+			return header
+		}
+	}
+	t.Fatalf("error finding nonce for test header")
+	return header
+}
+
+func getTestHeaderChain(t *testing.T, parentWork *big.Int) (*saver.HeaderChain, *pow.Node) {
+	params := pow.GetNetworkParams(wallet.NetworkRegtest)
+	headerChain, err := saver.NewHeaderChain(params, nil)
+	if err != nil {
+		t.Fatalf("error getting header chain; %v", err)
+	}
+	parent := pow.NewNode(getTestHeader(t, chainhash.Hash{}, params), 100, nil)
+	parent.Work = parentWork
+	headerChain.AddNode(parent)
+	return headerChain, parent
+}
+
+func TestHeaderChainCheckUnknownParentWork(t *testing.T) {
+	headerChain, parent := getTestHeaderChain(t, nil)
+	node, err := headerChain.Check(getTestHeader(t, parent.Hash, headerChain.Params))
+	if err != nil {
+		t.Fatalf("error checking header; %v", err)
+	}
+	if node.Height != parent.Height+1 {
+		t.Errorf("unexpected height: %d, expected: %d", node.Height, parent.Height+1)
+	}
+	if node.Work != nil {
+		t.Errorf("unexpected work for child of parent with unknown work: %s", node.Work)
+	}
+	tip := pow.NewNode(getTestHeader(t, chainhash.Hash{1}, headerChain.Params), parent.Height+2, big.NewInt(1))
+	headerChain.SetTip(tip)
+	if isBest, err := headerChain.IsBest(node); err != nil {
+		t.Errorf("error checking is best; %v", err)
+	} else if isBest {
+		t.Errorf("unexpected best for node with unknown work below tip height")
+	}
+}
+
+func TestHeaderChainCheckKnownParentWork(t *testing.T) {
+	headerChain, parent := getTestHeaderChain(t, big.NewInt(1000))
+	node, err := headerChain.Check(getTestHeader(t, parent.Hash, headerChain.Params))
+	if err != nil {
+		t.Fatalf("error checking header; %v", err)
+	}
+	expectedWork := new(big.Int).Add(parent.Work, pow.CalcWork(testBits))
+	if node.Work == nil || node.Work.Cmp(expectedWork) != 0 {
+		t.Errorf("unexpected work: %v, expected: %s", node.Work, expectedWork)
+	}
+}
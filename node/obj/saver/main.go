@@ -3,11 +3,24 @@ package saver
 import (
 	"context"
 	"fmt"
+	"github.com/memocash/index/db/item/chain"
+	"github.com/memocash/index/db/item/db"
 	"github.com/memocash/index/ref/dbi"
 	"reflect"
+	"strings"
 	"time"
 )
 
+const (
+	NameTxMinimal   = "tx"
+	NameAddress     = "address"
+	NameOpReturn    = "opreturn"
+	NameTxProcessed = "processed"
+)
+
+// Names are the savers run by NewCombinedTx, in order.
+var Names = []string{NameTxMinimal, NameAddress, NameOpReturn, NameTxProcessed}
+
 type CombinedTx struct {
 	Savers    []dbi.TxSave
 	SaveTimes map[string]time.Duration
@@ -39,3 +52,78 @@ func NewCombinedTx(verbose bool) *CombinedTx {
 		NewTxProcessed(verbose),
 	})
 }
+
+// NewCombinedTxNames returns a combined saver with only the named savers, run in the same order as NewCombinedTx.
+func NewCombinedTxNames(names []string, verbose bool) (*CombinedTx, error) {
+	var selected = make(map[string]bool)
+	for _, name := range names {
+		if !isName(name) {
+			return nil, fmt.Errorf("error unknown saver: %s (savers: %s)", name, strings.Join(Names, ", "))
+		}
+		selected[name] = true
+	}
+	var savers []dbi.TxSave
+	for _, name := range Names {
+		if !selected[name] {
+			continue
+		}
+		switch name {
+		case NameTxMinimal:
+			savers = append(savers, NewTxMinimal(verbose))
+		case NameAddress:
+			savers = append(savers, NewAddress(verbose))
+		case NameOpReturn:
+			savers = append(savers, NewOpReturn(verbose))
+		case NameTxProcessed:
+			savers = append(savers, NewTxProcessed(verbose))
+		}
+	}
+	if len(savers) == 0 {
+		return nil, fmt.Errorf("error no savers selected")
+	}
+	return NewCombined(savers), nil
+}
+
+func isName(name string) bool {
+	for _, n := range Names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// CanRemoveDerived returns whether derived items of a saver can be removed before re-indexing. Items from the tx
+// saver are what re-indexing reads from so they are never removed. Op return items are keyed by parsed data such as
+// addresses, names and post hashes, so they can't be found from txs alone and are not removed either.
+func CanRemoveDerived(name string) bool {
+	switch name {
+	case NameAddress, NameTxProcessed:
+		return true
+	}
+	return false
+}
+
+// GetDerivedObjects returns items a saver created for txs, used to remove stale items before re-indexing.
+func GetDerivedObjects(ctx context.Context, name string, txs []dbi.Tx) ([]db.Object, error) {
+	var objects []db.Object
+	switch name {
+	case NameAddress:
+		objects = NewAddress(false).GetObjects(&dbi.Block{Transactions: txs})
+	case NameTxProcessed:
+		var txHashes = make([][32]byte, len(txs))
+		for i := range txs {
+			txHashes[i] = txs[i].Hash
+		}
+		txProcesseds, err := chain.GetTxProcesseds(ctx, txHashes)
+		if err != nil {
+			return nil, fmt.Errorf("error getting tx processeds for derived objects; %w", err)
+		}
+		for _, txProcessed := range txProcesseds {
+			objects = append(objects, txProcessed)
+		}
+	default:
+		return nil, fmt.Errorf("error derived objects not supported for saver: %s", name)
+	}
+	return objects, nil
+}
@@ -0,0 +1,82 @@
+package saver_test
+
+import (
+	"context"
+	"encoding/hex"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/btcd/txscript"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/node/obj/saver"
+	"github.com/memocash/index/ref/bitcoin/tx/script"
+	"github.com/memocash/index/ref/bitcoin/util/testing/test_tx"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"github.com/memocash/index/ref/dbi"
+	"testing"
+	"time"
+)
+
+func getTestDerivedTx(t *testing.T) dbi.Tx {
+	signature, _ := hex.DecodeString(test_tx.SellTokenSignatureString)
+	pkData, _ := hex.DecodeString(test_tx.SellTokenPkDataString)
+	sigScript, err := txscript.NewScriptBuilder().AddData(signature).AddData(pkData).Script()
+	if err != nil {
+		t.Fatalf("error building signature script; %v", err)
+	}
+	prevHash, err := chainhash.NewHash(test_tx.GenericTxHash0)
+	if err != nil {
+		t.Fatalf("error getting test prev hash; %v", err)
+	}
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, 0), sigScript))
+	for _, address := range []string{test_tx.Address1String, test_tx.Address2String} {
+		addr, err := wallet.GetAddrFromString(address)
+		if err != nil {
+			t.Fatalf("error getting test addr; %v", err)
+		}
+		pkScript, err := script.P2pkh{PkHash: addr.GetPkHash()}.Get()
+		if err != nil {
+			t.Fatalf("error getting test pk script; %v", err)
+		}
+		msgTx.AddTxOut(wire.NewTxOut(1000, pkScript))
+	}
+	return dbi.Tx{Hash: msgTx.TxHash(), Seen: time.Unix(1700000000, 0), MsgTx: msgTx}
+}
+
+func getObjectKeys(objects []db.Object) map[string]bool {
+	var keys = make(map[string]bool)
+	for _, object := range objects {
+		keys[object.GetTopic()+":"+hex.EncodeToString(object.GetUid())] = true
+	}
+	return keys
+}
+
+func TestGetDerivedObjectsAddress(t *testing.T) {
+	tx := getTestDerivedTx(t)
+	saved := saver.NewAddress(false).GetObjects(&dbi.Block{Transactions: []dbi.Tx{tx}})
+	if len(saved) != 3 {
+		t.Errorf("unexpected saved address object count: %d, expected: 3", len(saved))
+	}
+	derived, err := saver.GetDerivedObjects(context.Background(), saver.NameAddress, []dbi.Tx{tx})
+	if err != nil {
+		t.Fatalf("error getting derived address objects; %v", err)
+	}
+	savedKeys, derivedKeys := getObjectKeys(saved), getObjectKeys(derived)
+	if len(savedKeys) != len(derivedKeys) {
+		t.Errorf("unexpected derived object count: %d, saved: %d", len(derivedKeys), len(savedKeys))
+	}
+	for key := range savedKeys {
+		if !derivedKeys[key] {
+			t.Errorf("saved object not in derived objects: %s", key)
+		}
+	}
+}
+
+func TestGetDerivedObjectsOpReturn(t *testing.T) {
+	if saver.CanRemoveDerived(saver.NameOpReturn) {
+		t.Errorf("expected op return derived items not removable")
+	}
+	if _, err := saver.GetDerivedObjects(context.Background(), saver.NameOpReturn, nil); err == nil {
+		t.Errorf("expected error getting op return derived objects")
+	}
+}
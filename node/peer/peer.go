@@ -16,15 +16,33 @@ import (
 	"log"
 	"net"
 	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 const (
 	MaxHeightBack = 20
+
+	broadcastTimeout = 10 * time.Second
+	broadcastRecent  = 10 * time.Minute
 )
 
+// RejectError is returned by BroadcastTx when the node rejects the tx within the broadcast wait window.
+type RejectError struct {
+	Host   string
+	TxHash chainhash.Hash
+	Code   wire.RejectCode
+	Reason string
+}
+
+func (e *RejectError) Error() string {
+	return fmt.Sprintf("error tx rejected by node %s: %s (%s)", e.Host, e.Reason, e.Code)
+}
+
 type Peer struct {
 	peer        *peer.Peer
+	Host        string
 	HandleError func(error)
 	BlockSave   dbi.BlockSave
 	TxSave      dbi.TxSave
@@ -32,7 +50,30 @@ type Peer struct {
 	HasExisting bool
 	HeightBack  int64
 	SyncDone    bool
+	SyncWindow  int
 	Mempool     bool
+	Connected   bool
+	startHeight atomic.Int32
+	announced   atomic.Int32
+	lastInv     chainhash.Hash
+	misbehavior error
+	syncQueue   []chainhash.Hash
+	inFlight    map[chainhash.Hash]struct{}
+	headersFull bool
+	headersSent bool
+	headersDone bool
+
+	BroadcastWait time.Duration
+	// OnTxReject is called for rejects of recently broadcast txs received after the broadcast wait window.
+	OnTxReject     func(msg *wire.MsgReject)
+	broadcasts     map[chainhash.Hash]time.Time
+	broadcastWaits map[chainhash.Hash]chan *wire.MsgReject
+	broadcastMu    sync.Mutex
+}
+
+// GetHeight is the height the peer reported on connect plus new blocks it has announced since.
+func (p *Peer) GetHeight() int32 {
+	return p.startHeight.Load() + p.announced.Load()
 }
 
 func (p *Peer) Error(err error) {
@@ -45,11 +86,14 @@ func (p *Peer) Error(err error) {
 
 func (p *Peer) Connect() error {
 	SetBtcdLogLevel()
-	connectionString := config.GetNodeHost()
+	connectionString := p.Host
+	if connectionString == "" {
+		connectionString = config.GetNodeHost()
+	}
 	newPeer, err := peer.NewOutboundPeer(&peer.Config{
 		UserAgentName:    "memo-index",
 		UserAgentVersion: "0.3.0",
-		ChainParams:      wallet.GetMainNetParams(),
+		ChainParams:      wallet.GetNetParams(),
 		Listeners: peer.MessageListeners{
 			OnVerAck:      p.OnVerAck,
 			OnHeaders:     p.OnHeaders,
@@ -73,9 +117,19 @@ func (p *Peer) Connect() error {
 	}
 	newPeer.AssociateConnection(conn)
 	newPeer.WaitForDisconnect()
+	if p.misbehavior != nil {
+		return fmt.Errorf("error peer disconnected for misbehavior; %w", p.misbehavior)
+	}
 	return nil
 }
 
+// Misbehave disconnects a peer that sent invalid data, Connect returns the error once disconnected.
+func (p *Peer) Misbehave(err error) {
+	log.Printf("Disconnecting misbehaving peer: %s; %v\n", p.Host, err)
+	p.misbehavior = err
+	p.Disconnect()
+}
+
 func (p *Peer) Disconnect() {
 	if p != nil && p.peer != nil {
 		p.peer.Disconnect()
@@ -83,6 +137,7 @@ func (p *Peer) Disconnect() {
 }
 
 func (p *Peer) OnVerAck(_ *peer.Peer, _ *wire.MsgVerAck) {
+	p.Connected = true
 	if p.Mempool {
 		p.peer.QueueMessage(wire.NewMsgMemPool(), nil)
 		return
@@ -96,7 +151,7 @@ func (p *Peer) OnVerAck(_ *peer.Peer, _ *wire.MsgVerAck) {
 		p.Error(fmt.Errorf("error getting node block; %w", err))
 		return
 	}
-	if blockHash != nil && blockHash != wallet.GetGenesisBlock().Hash {
+	if blockHash != nil && *blockHash != *wallet.GetGenesisBlock().Hash {
 		p.HasExisting = true
 		msgGetHeaders.BlockLocatorHashes = append(msgGetHeaders.BlockLocatorHashes, blockHash)
 	}
@@ -139,18 +194,27 @@ func (p *Peer) OnHeaders(_ *peer.Peer, msg *wire.MsgHeaders) {
 	if jutil.IsNil(p.BlockSave) {
 		return
 	}
+	p.headersSent = false
 	if len(msg.Headers) == 0 {
-		if !p.SyncDone {
-			log.Printf("No headers received, disconnecting, sync done: %t\n", p.SyncDone)
-			p.SyncDone = true
-			p.Disconnect()
+		if len(p.syncQueue) > 0 || len(p.inFlight) > 0 {
+			// Headers were requested ahead of blocks still downloading, finish once they are received.
+			p.headersDone = true
+			return
 		}
+		p.finishSync()
 		return
 	}
-	msgGetData := wire.NewMsgGetData()
+	headerCheck, _ := p.BlockSave.(dbi.HeaderCheck)
+	var blockHashes []chainhash.Hash
 	for _, blockHeader := range msg.Headers {
 		blockHash := blockHeader.BlockHash()
-		if p.HasExisting && blockHash == *wallet.GetFirstBlock().Hash {
+		if headerCheck != nil {
+			if err := headerCheck.CheckHeader(*blockHeader); err != nil {
+				p.Misbehave(fmt.Errorf("error invalid header from peer: %s; %w", blockHash, err))
+				return
+			}
+		}
+		if p.HasExisting && blockHeader.PrevBlock == *wallet.GetGenesisBlock().Hash {
 			go func() {
 				time.Sleep(5 * time.Second)
 				p.HeightBack++
@@ -173,18 +237,69 @@ func (p *Peer) OnHeaders(_ *peer.Peer, msg *wire.MsgHeaders) {
 			return
 		}
 		p.HeightBack = 0
-		err := msgGetData.AddInvVect(&wire.InvVect{
+		blockHashes = append(blockHashes, blockHash)
+	}
+	p.LastBlock = &blockHashes[len(blockHashes)-1]
+	p.headersFull = len(msg.Headers) == wire.MaxBlockHeadersPerMsg
+	p.syncQueue = append(p.syncQueue, blockHashes...)
+	p.requestBlocks()
+}
+
+// requestBlocks requests blocks queued from headers, keeping at most SyncWindow blocks in flight (all at once if not
+// set). After a full headers message the next headers are requested once the queue drops below the window so block
+// downloads don't wait on a headers round trip.
+func (p *Peer) requestBlocks() {
+	if p.inFlight == nil {
+		p.inFlight = make(map[chainhash.Hash]struct{})
+	}
+	msgGetData := wire.NewMsgGetData()
+	for len(p.syncQueue) > 0 && (p.SyncWindow <= 0 || len(p.inFlight) < p.SyncWindow) {
+		blockHash := p.syncQueue[0]
+		p.syncQueue = p.syncQueue[1:]
+		if err := msgGetData.AddInvVect(&wire.InvVect{
 			Type: wire.InvTypeBlock,
-			Hash: blockHeader.BlockHash(),
-		})
-		if err != nil {
+			Hash: blockHash,
+		}); err != nil {
 			p.Error(fmt.Errorf("error adding block inventory vector from header; %w", err))
+			continue
 		}
+		p.inFlight[blockHash] = struct{}{}
 	}
 	if len(msgGetData.InvList) > 0 {
-		p.LastBlock = &msgGetData.InvList[len(msgGetData.InvList)-1].Hash
 		p.peer.QueueMessage(msgGetData, nil)
 	}
+	if p.SyncWindow > 0 && p.headersFull && !p.headersSent && len(p.syncQueue) < p.SyncWindow {
+		p.headersFull = false
+		p.requestHeaders(p.LastBlock)
+	}
+}
+
+// RequestBlock requests a single block by hash outside of sync, the block is passed to TxSave like any other block.
+func (p *Peer) RequestBlock(blockHash chainhash.Hash) error {
+	if p.peer == nil || !p.Connected {
+		return fmt.Errorf("error peer not connected for block request")
+	}
+	msgGetData := wire.NewMsgGetData()
+	if err := msgGetData.AddInvVect(wire.NewInvVect(wire.InvTypeBlock, &blockHash)); err != nil {
+		return fmt.Errorf("error adding block inventory vector for request; %w", err)
+	}
+	p.peer.QueueMessage(msgGetData, nil)
+	return nil
+}
+
+func (p *Peer) requestHeaders(blockHash *chainhash.Hash) {
+	msgGetHeaders := wire.NewMsgGetHeaders()
+	msgGetHeaders.BlockLocatorHashes = append(msgGetHeaders.BlockLocatorHashes, blockHash)
+	p.headersSent = true
+	p.peer.QueueMessage(msgGetHeaders, nil)
+}
+
+func (p *Peer) finishSync() {
+	if !p.SyncDone {
+		log.Printf("No headers received, disconnecting, sync done: %t\n", p.SyncDone)
+		p.SyncDone = true
+		p.Disconnect()
+	}
 }
 
 func (p *Peer) OnInv(_ *peer.Peer, msg *wire.MsgInv) {
@@ -204,6 +319,10 @@ func (p *Peer) OnInv(_ *peer.Peer, msg *wire.MsgInv) {
 				p.Error(fmt.Errorf("error adding tx inventory vector; %w", err))
 			}
 		case wire.InvTypeBlock:
+			if invItem.Hash != p.lastInv {
+				p.lastInv = invItem.Hash
+				p.announced.Add(1)
+			}
 			if jutil.IsNil(p.BlockSave) {
 				return
 			}
@@ -242,10 +361,20 @@ func (p *Peer) OnBlock(_ *peer.Peer, msg *wire.MsgBlock, _ []byte) {
 		}
 	}
 	blockHash := msg.BlockHash()
-	if blockHash.IsEqual(p.LastBlock) {
-		msgGetHeaders := wire.NewMsgGetHeaders()
-		msgGetHeaders.BlockLocatorHashes = append(msgGetHeaders.BlockLocatorHashes, &blockHash)
-		p.peer.QueueMessage(msgGetHeaders, nil)
+	if _, ok := p.inFlight[blockHash]; ok {
+		delete(p.inFlight, blockHash)
+		p.requestBlocks()
+	}
+	if len(p.syncQueue) > 0 || len(p.inFlight) > 0 {
+		return
+	}
+	if p.headersDone {
+		p.headersDone = false
+		p.finishSync()
+		return
+	}
+	if blockHash.IsEqual(p.LastBlock) && !p.headersSent {
+		p.requestHeaders(&blockHash)
 	}
 }
 
@@ -264,7 +393,24 @@ func (p *Peer) OnTx(_ *peer.Peer, msg *wire.MsgTx) {
 }
 
 func (p *Peer) OnReject(_ *peer.Peer, msg *wire.MsgReject) {
-	log.Printf("OnReject: %#v\n", msg)
+	log.Printf("OnReject: %s %s, code: %s, reason: %s\n", msg.Cmd, msg.Hash, msg.Code, msg.Reason)
+	if msg.Cmd != wire.CmdTx {
+		return
+	}
+	p.broadcastMu.Lock()
+	rejectChan, waiting := p.broadcastWaits[msg.Hash]
+	_, recent := p.broadcasts[msg.Hash]
+	p.broadcastMu.Unlock()
+	if waiting {
+		select {
+		case rejectChan <- msg:
+		default:
+		}
+		return
+	}
+	if recent && p.OnTxReject != nil {
+		p.OnTxReject(msg)
+	}
 }
 
 func (p *Peer) OnPing(_ *peer.Peer, msg *wire.MsgPing) {
@@ -279,19 +425,70 @@ func (p *Peer) OnMerkleBlock(_ *peer.Peer, msg *wire.MsgMerkleBlock) {
 
 func (p *Peer) OnVersion(_ *peer.Peer, msg *wire.MsgVersion) {
 	log.Printf("OnVersion: %s (last: %d)\n", msg.UserAgent, msg.LastBlock)
+	p.startHeight.Store(msg.LastBlock)
 }
 
+// BroadcastTx sends a tx to the node and waits up to BroadcastWait for a reject. The send times out separately from the
+// reject wait so any BroadcastWait is used in full. A RejectError is returned if the node rejects the tx, a duplicate
+// reject means the node already has the tx and is not an error.
 func (p *Peer) BroadcastTx(ctx context.Context, msgTx *wire.MsgTx) error {
+	txHash := msgTx.TxHash()
+	rejectChan := p.addBroadcast(txHash)
+	defer p.removeBroadcastWait(txHash)
 	var done = make(chan struct{})
 	p.peer.QueueMessage(msgTx, done)
-	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	sendCtx, cancel := context.WithTimeout(ctx, broadcastTimeout)
 	defer cancel()
 	select {
 	case <-done:
+	case <-sendCtx.Done():
+		return fmt.Errorf("error context timeout")
+	}
+	if p.BroadcastWait <= 0 {
 		return nil
+	}
+	timer := time.NewTimer(p.BroadcastWait)
+	defer timer.Stop()
+	select {
+	case msg := <-rejectChan:
+		if msg.Code == wire.RejectDuplicate {
+			return nil
+		}
+		return &RejectError{
+			Host:   p.Host,
+			TxHash: txHash,
+			Code:   msg.Code,
+			Reason: msg.Reason,
+		}
+	case <-timer.C:
 	case <-ctx.Done():
-		return fmt.Errorf("error context timeout")
 	}
+	return nil
+}
+
+// addBroadcast tracks a tx as recently broadcast so rejects can be correlated, older broadcasts are removed.
+func (p *Peer) addBroadcast(txHash chainhash.Hash) chan *wire.MsgReject {
+	p.broadcastMu.Lock()
+	defer p.broadcastMu.Unlock()
+	if p.broadcasts == nil {
+		p.broadcasts = make(map[chainhash.Hash]time.Time)
+		p.broadcastWaits = make(map[chainhash.Hash]chan *wire.MsgReject)
+	}
+	for hash, sent := range p.broadcasts {
+		if time.Since(sent) > broadcastRecent {
+			delete(p.broadcasts, hash)
+		}
+	}
+	p.broadcasts[txHash] = time.Now()
+	var rejectChan = make(chan *wire.MsgReject, 1)
+	p.broadcastWaits[txHash] = rejectChan
+	return rejectChan
+}
+
+func (p *Peer) removeBroadcastWait(txHash chainhash.Hash) {
+	p.broadcastMu.Lock()
+	defer p.broadcastMu.Unlock()
+	delete(p.broadcastWaits, txHash)
 }
 
 func NewConnection(txSave dbi.TxSave, blockSave dbi.BlockSave) *Peer {
@@ -0,0 +1,220 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/config"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	DefaultTimeout = 30 * time.Second
+
+	// Node JSON-RPC error codes used by callers.
+	ErrorCodeInvalidParameter     = -8
+	ErrorCodeVerify               = -25
+	ErrorCodeVerifyRejected       = -26
+	ErrorCodeVerifyAlreadyInChain = -27
+)
+
+// Error is an error returned by the node for a JSON-RPC request.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("error node rpc: %s (code: %d)", e.Message, e.Code)
+}
+
+// IsErrorCode checks if err is a node JSON-RPC error with the code.
+func IsErrorCode(err error, code int) bool {
+	var rpcError *Error
+	return errors.As(err, &rpcError) && rpcError.Code == code
+}
+
+type request struct {
+	JsonRpc string        `json:"jsonrpc"`
+	Id      int64         `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type response struct {
+	Result json.RawMessage `json:"result"`
+	Error  *Error          `json:"error"`
+	Id     int64           `json:"id"`
+}
+
+// Client calls a full node's JSON-RPC interface (bitcoind compatible) over HTTP.
+type Client struct {
+	Config config.RpcConfig
+	Http   *http.Client
+	id     atomic.Int64
+}
+
+func (c *Client) GetUrl() string {
+	return "http://" + net.JoinHostPort(c.Config.Host, strconv.Itoa(c.Config.Port))
+}
+
+// Call sends a JSON-RPC request and unmarshals the result into result, if not nil.
+func (c *Client) Call(ctx context.Context, method string, result interface{}, params ...interface{}) error {
+	if !c.Config.IsSet() {
+		return fmt.Errorf("error node rpc config; %w", config.NotSetError)
+	}
+	if params == nil {
+		params = []interface{}{}
+	}
+	reqBody, err := json.Marshal(request{
+		JsonRpc: "1.0",
+		Id:      c.id.Add(1),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return fmt.Errorf("error marshaling node rpc request: %s; %w", method, err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.GetUrl(), bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("error creating node rpc request: %s; %w", method, err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if c.Config.User != "" || c.Config.Password != "" {
+		httpReq.SetBasicAuth(c.Config.User, c.Config.Password)
+	}
+	httpResp, err := c.Http.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("error node rpc request failed: %s; %w", method, err)
+	}
+	defer httpResp.Body.Close()
+	if httpResp.StatusCode == http.StatusUnauthorized {
+		return fmt.Errorf("error node rpc request unauthorized, check rpc user and password: %s", method)
+	}
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading node rpc response body: %s; %w", method, err)
+	}
+	var resp response
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("error unmarshalling node rpc response: %s (status: %d); %w",
+			method, httpResp.StatusCode, err)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("error node rpc response: %s; %w", method, resp.Error)
+	}
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(resp.Result, result); err != nil {
+		return fmt.Errorf("error unmarshalling node rpc result: %s; %w", method, err)
+	}
+	return nil
+}
+
+func (c *Client) GetBlockCount(ctx context.Context) (int64, error) {
+	var count int64
+	if err := c.Call(ctx, "getblockcount", &count); err != nil {
+		return 0, fmt.Errorf("error getting block count; %w", err)
+	}
+	return count, nil
+}
+
+func (c *Client) GetBestBlockHash(ctx context.Context) (*chainhash.Hash, error) {
+	var hashString string
+	if err := c.Call(ctx, "getbestblockhash", &hashString); err != nil {
+		return nil, fmt.Errorf("error getting best block hash; %w", err)
+	}
+	hash, err := chainhash.NewHashFromStr(hashString)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing best block hash; %w", err)
+	}
+	return hash, nil
+}
+
+func (c *Client) GetBlockHash(ctx context.Context, height int64) (*chainhash.Hash, error) {
+	var hashString string
+	if err := c.Call(ctx, "getblockhash", &hashString, height); err != nil {
+		return nil, fmt.Errorf("error getting block hash for height: %d; %w", height, err)
+	}
+	hash, err := chainhash.NewHashFromStr(hashString)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing block hash for height: %d; %w", height, err)
+	}
+	return hash, nil
+}
+
+// GetBlock gets a raw block (verbosity 0) and deserializes it.
+func (c *Client) GetBlock(ctx context.Context, blockHash chainhash.Hash) (*wire.MsgBlock, error) {
+	var rawHex string
+	if err := c.Call(ctx, "getblock", &rawHex, blockHash.String(), 0); err != nil {
+		return nil, fmt.Errorf("error getting block: %s; %w", blockHash, err)
+	}
+	raw, err := hex.DecodeString(rawHex)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding raw block hex: %s; %w", blockHash, err)
+	}
+	var msgBlock wire.MsgBlock
+	if err := msgBlock.Deserialize(bytes.NewReader(raw)); err != nil {
+		return nil, fmt.Errorf("error deserializing raw block: %s; %w", blockHash, err)
+	}
+	return &msgBlock, nil
+}
+
+func (c *Client) GetRawMempool(ctx context.Context) ([]chainhash.Hash, error) {
+	var hashStrings []string
+	if err := c.Call(ctx, "getrawmempool", &hashStrings); err != nil {
+		return nil, fmt.Errorf("error getting raw mempool; %w", err)
+	}
+	var hashes = make([]chainhash.Hash, len(hashStrings))
+	for i := range hashStrings {
+		hash, err := chainhash.NewHashFromStr(hashStrings[i])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing raw mempool tx hash; %w", err)
+		}
+		hashes[i] = *hash
+	}
+	return hashes, nil
+}
+
+// GetRawTransaction gets a raw tx (non-verbose), txs not in the mempool require txindex on the node.
+func (c *Client) GetRawTransaction(ctx context.Context, txHash chainhash.Hash) (*wire.MsgTx, error) {
+	var rawHex string
+	if err := c.Call(ctx, "getrawtransaction", &rawHex, txHash.String(), false); err != nil {
+		return nil, fmt.Errorf("error getting raw transaction: %s; %w", txHash, err)
+	}
+	raw, err := hex.DecodeString(rawHex)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding raw transaction hex: %s; %w", txHash, err)
+	}
+	msgTx, err := memo.GetMsgFromRaw(raw)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing raw transaction: %s; %w", txHash, err)
+	}
+	return msgTx, nil
+}
+
+func (c *Client) SendRawTransaction(ctx context.Context, msgTx *wire.MsgTx) error {
+	if err := c.Call(ctx, "sendrawtransaction", nil, hex.EncodeToString(memo.GetRaw(msgTx))); err != nil {
+		return fmt.Errorf("error sending raw transaction: %s; %w", msgTx.TxHash(), err)
+	}
+	return nil
+}
+
+func NewClient(rpcConfig config.RpcConfig) *Client {
+	return &Client{
+		Config: rpcConfig,
+		Http:   &http.Client{Timeout: DefaultTimeout},
+	}
+}
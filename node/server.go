@@ -4,19 +4,20 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
-	"github.com/jchavannes/bchutil"
-	"github.com/jchavannes/btcd/chaincfg"
 	"github.com/jchavannes/btcd/peer"
 	"github.com/jchavannes/btcd/wire"
 	"github.com/memocash/index/db/item"
 	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/ref/bitcoin/wallet"
 	log2 "log"
 	"net"
+	"sync/atomic"
 	"time"
 )
 
 const (
-	DefaultPort = 8333
+	DefaultPort    = 8333
+	DefaultTimeout = time.Minute
 )
 
 func GetLocalhost() net.IP {
@@ -25,9 +26,13 @@ func GetLocalhost() net.IP {
 }
 
 type Server struct {
-	Peer *peer.Peer
-	Ip   []byte
-	Port uint16
+	Peer    *peer.Peer
+	Ip      []byte
+	Port    uint16
+	Timeout time.Duration
+	// Crawl disconnects once the peer sends its addrs.
+	Crawl   bool
+	crawled atomic.Bool
 }
 
 func (s *Server) GetAddr() error {
@@ -59,8 +64,7 @@ func (s *Server) SaveConnectionResult(success bool) error {
 }
 
 func (s *Server) Run() error {
-	params := &chaincfg.MainNetParams
-	params.Net = bchutil.MainnetMagic
+	params := wallet.GetNetParams()
 	var err error
 	connectionAddress := fmt.Sprintf("[%s]:%d", net.IP(s.Ip), s.Port)
 	log := func(msg string, params ...interface{}) {
@@ -96,6 +100,11 @@ func (s *Server) Run() error {
 				if err := db.Save(objects); err != nil {
 					log2.Printf("error saving peers; %v", err)
 				}
+				// A single addr is usually the peer announcing itself rather than a get addr response.
+				if s.Crawl && len(msg.AddrList) > 1 {
+					s.crawled.Store(true)
+					go s.Disconnect()
+				}
 			},
 			OnVerAck: func(p *peer.Peer, msg *wire.MsgVerAck) {
 				log("ver ack from peer\n")
@@ -139,6 +148,17 @@ func (s *Server) Run() error {
 			OnVersion: func(p *peer.Peer, msg *wire.MsgVersion) {
 				log("on version from peer\n")
 				log("version: %d, user agent: %s\n", msg.ProtocolVersion, msg.UserAgent)
+				if err := db.Save([]db.Object{&item.PeerInfo{
+					Ip:              s.Ip,
+					Port:            s.Port,
+					UserAgent:       msg.UserAgent,
+					ProtocolVersion: msg.ProtocolVersion,
+					Services:        uint64(msg.Services),
+					Height:          msg.LastBlock,
+					Time:            time.Now(),
+				}}); err != nil {
+					log2.Printf("error saving peer info; %v", err)
+				}
 			},
 		},
 	}, connectionAddress)
@@ -165,13 +185,16 @@ func (s *Server) Run() error {
 	disconnected := make(chan interface{})
 	go func() {
 		s.Peer.WaitForDisconnect()
-		<-disconnected
+		close(disconnected)
 	}()
 	select {
-	case <-time.NewTimer(1 * time.Minute).C:
+	case <-time.NewTimer(s.Timeout).C:
 		s.Disconnect()
 		return nil
 	case <-disconnected:
+		if s.crawled.Load() {
+			return nil
+		}
 		return fmt.Errorf("error node disconnected")
 	}
 }
@@ -184,7 +207,8 @@ func (s *Server) Disconnect() {
 
 func NewServer(ip []byte, port uint16) *Server {
 	return &Server{
-		Ip:   ip,
-		Port: port,
+		Ip:      ip,
+		Port:    port,
+		Timeout: DefaultTimeout,
 	}
 }
@@ -26,6 +26,7 @@ func IsFreeTx(outputs []*Output) bool {
 		OutputTypeMemoSetProfilePic,
 		OutputTypeMemoTopicMessage,
 		OutputTypeMemoReply,
+		OutputTypeMemoRepost,
 		OutputTypeMemoFollow,
 		OutputTypeMemoTopicFollow,
 		OutputTypeLinkRequest,
@@ -55,6 +55,7 @@ const (
 	OutputTypeLinkAccept
 	OutputTypeLinkRevoke
 	OutputTypeSetAlias
+	OutputTypeMemoRepost
 	OutputTypeUnknown
 	OutputTypeNone
 )
@@ -70,6 +71,7 @@ const (
 	StringMemoUnfollow      = "memo-unfollow"
 	StringMemoLike          = "memo-like"
 	StringMemoReply         = "memo-reply"
+	StringMemoRepost        = "memo-repost"
 	StringMemoSetProfile    = "memo-set-profile"
 	StringMemoSetProfilePic = "memo-set-profile-pic"
 	StringMemoSend          = "memo-send"
@@ -108,6 +110,7 @@ var outputTypeStringMap = map[OutputType]string{
 	OutputTypeMemoUnfollow:           StringMemoUnfollow,
 	OutputTypeMemoLike:               StringMemoLike,
 	OutputTypeMemoReply:              StringMemoReply,
+	OutputTypeMemoRepost:             StringMemoRepost,
 	OutputTypeMemoSetProfile:         StringMemoSetProfile,
 	OutputTypeMemoTopicMessage:       StringMemoTopicMessage,
 	OutputTypeMemoTopicFollow:        StringMemoTopicFollow,
@@ -146,6 +149,7 @@ var outputTypeStringMap = map[OutputType]string{
 var outputTypePrefixMap = map[OutputType][][]byte{
 	OutputTypeMemoMessage:       {PrefixPost},
 	OutputTypeMemoReply:         {PrefixReply},
+	OutputTypeMemoRepost:        {PrefixRepost},
 	OutputTypeMemoLike:          {PrefixLike},
 	OutputTypeMemoSetName:       {PrefixSetName},
 	OutputTypeMemoSetProfile:    {PrefixSetProfile},
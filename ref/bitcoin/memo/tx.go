@@ -12,6 +12,8 @@ type TxInput struct {
 	Value        int64
 	PrevOutHash  []byte
 	PrevOutIndex uint32
+	// RedeemScript is set for P2SH multisig inputs, PkHash is then the script hash.
+	RedeemScript []byte
 }
 
 func (t TxInput) GetHashIndexString() string {
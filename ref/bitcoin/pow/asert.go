@@ -0,0 +1,40 @@
+package pow
+
+import "math/big"
+
+// AsertAnchor is the block difficulty is anchored to for the aserti3-2d algorithm.
+type AsertAnchor struct {
+	Height     int64
+	Bits       uint32
+	ParentTime int64
+	HalfLife   int64
+}
+
+// IsAsert returns whether a block with the given parent height uses ASERT.
+func (p *Params) IsAsert(parentHeight int64) bool {
+	return p.Asert.Height > 0 && parentHeight >= p.Asert.Height
+}
+
+// NextAsertBits calculates the required bits of a block from its parent using aserti3-2d.
+func NextAsertBits(params *Params, parentHeight, parentTime int64) uint32 {
+	anchor := params.Asert
+	timeDiff := parentTime - anchor.ParentTime
+	heightDiff := parentHeight - anchor.Height
+	exponent := ((timeDiff - params.TargetSpacing*(heightDiff+1)) * 65536) / anchor.HalfLife
+	shifts := exponent >> 16
+	frac := uint64(uint16(exponent))
+	factor := 65536 + ((195766423245049*frac + 971821376*frac*frac + 5127*frac*frac*frac + (1 << 47)) >> 48)
+	target := new(big.Int).Mul(CompactToBig(anchor.Bits), new(big.Int).SetUint64(factor))
+	shifts -= 16
+	if shifts <= 0 {
+		target.Rsh(target, uint(-shifts))
+	} else {
+		target.Lsh(target, uint(shifts))
+	}
+	if target.Sign() == 0 {
+		target.SetInt64(1)
+	} else if target.Cmp(params.PowLimit) > 0 {
+		return params.PowLimitBits
+	}
+	return BigToCompact(target)
+}
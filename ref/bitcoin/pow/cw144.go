@@ -0,0 +1,51 @@
+package pow
+
+import "math/big"
+
+const Cw144Window = 144
+
+// IsDaa returns whether a block with the given parent height uses the cw-144 difficulty adjustment.
+func (p *Params) IsDaa(parentHeight int64) bool {
+	return p.DaaHeight > 0 && parentHeight >= p.DaaHeight && !p.IsAsert(parentHeight)
+}
+
+// NextCashWorkBits calculates the required bits of a block using cw-144. First and last are each three consecutive
+// nodes, the last ending at the parent and the first ending 144 blocks before it.
+func NextCashWorkBits(params *Params, first, last []*Node) uint32 {
+	firstNode, lastNode := getSuitableNode(first), getSuitableNode(last)
+	work := new(big.Int).Sub(lastNode.Work, firstNode.Work)
+	work.Mul(work, big.NewInt(params.TargetSpacing))
+	timespan := lastNode.GetTime() - firstNode.GetTime()
+	if minTimespan := Cw144Window / 2 * params.TargetSpacing; timespan < minTimespan {
+		timespan = minTimespan
+	} else if maxTimespan := Cw144Window * 2 * params.TargetSpacing; timespan > maxTimespan {
+		timespan = maxTimespan
+	}
+	work.Div(work, big.NewInt(timespan))
+	if work.Sign() <= 0 {
+		return params.PowLimitBits
+	}
+	target := new(big.Int).Lsh(big.NewInt(1), 256)
+	target.Sub(target, work)
+	target.Div(target, work)
+	if target.Cmp(params.PowLimit) > 0 {
+		return params.PowLimitBits
+	}
+	return BigToCompact(target)
+}
+
+// getSuitableNode returns the median by timestamp of three consecutive nodes, ordered oldest first. Uses the same
+// sorting network as node implementations so ties resolve to the same node.
+func getSuitableNode(nodes []*Node) *Node {
+	var sorted = [3]*Node{nodes[0], nodes[1], nodes[2]}
+	if sorted[0].GetTime() > sorted[2].GetTime() {
+		sorted[0], sorted[2] = sorted[2], sorted[0]
+	}
+	if sorted[0].GetTime() > sorted[1].GetTime() {
+		sorted[0], sorted[1] = sorted[1], sorted[0]
+	}
+	if sorted[1].GetTime() > sorted[2].GetTime() {
+		sorted[1], sorted[2] = sorted[2], sorted[1]
+	}
+	return sorted[1]
+}
@@ -0,0 +1,146 @@
+package pow
+
+import (
+	"fmt"
+	"github.com/jchavannes/btcd/blockchain"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"math/big"
+)
+
+// Params are the proof-of-work consensus rules for a network.
+type Params struct {
+	PowLimit           *big.Int
+	PowLimitBits       uint32
+	TargetSpacing      int64 // In seconds
+	DaaHeight          int64 // Blocks with a parent at or above this height use the cw-144 difficulty adjustment
+	Asert              AsertAnchor
+	AllowMinDifficulty bool // Blocks more than 2 target spacings after their parent may use the pow limit
+	NoRetargeting      bool
+}
+
+var mainNetParams = &Params{
+	PowLimit:      getPowLimit(224),
+	PowLimitBits:  0x1d00ffff,
+	TargetSpacing: 600,
+	DaaHeight:     504031,
+	Asert: AsertAnchor{
+		Height:     661647,
+		Bits:       0x1804dafe,
+		ParentTime: 1605447844,
+		HalfLife:   2 * 24 * 60 * 60,
+	},
+}
+
+var testNetParams = &Params{
+	PowLimit:      getPowLimit(224),
+	PowLimitBits:  0x1d00ffff,
+	TargetSpacing: 600,
+	DaaHeight:     1188697,
+	Asert: AsertAnchor{
+		Height:     1421481,
+		Bits:       0x1d00ffff,
+		ParentTime: 1605445400,
+		HalfLife:   60 * 60,
+	},
+	AllowMinDifficulty: true,
+}
+
+var chipNetParams = &Params{
+	PowLimit:      getPowLimit(224),
+	PowLimitBits:  0x1d00ffff,
+	TargetSpacing: 600,
+	DaaHeight:     3000,
+	Asert: AsertAnchor{
+		Height:     16844,
+		Bits:       0x1d00ffff,
+		ParentTime: 1605451779,
+		HalfLife:   60 * 60,
+	},
+	AllowMinDifficulty: true,
+}
+
+var regTestParams = &Params{
+	PowLimit:           getPowLimit(255),
+	PowLimitBits:       0x207fffff,
+	TargetSpacing:      600,
+	AllowMinDifficulty: true,
+	NoRetargeting:      true,
+}
+
+func getPowLimit(bits uint) *big.Int {
+	return new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits), big.NewInt(1))
+}
+
+func GetMainNetParams() *Params {
+	return mainNetParams
+}
+
+// GetNetworkParams returns the params for a network name as used by the NETWORK config, nil if unknown.
+func GetNetworkParams(network string) *Params {
+	switch network {
+	case "", wallet.NetworkMainnet:
+		return mainNetParams
+	case wallet.NetworkTestnet:
+		return testNetParams
+	case wallet.NetworkChipnet:
+		return chipNetParams
+	case wallet.NetworkRegtest:
+		return regTestParams
+	default:
+		return nil
+	}
+}
+
+// Node is a validated header along with its height and cumulative chainwork.
+type Node struct {
+	Hash   chainhash.Hash
+	Header wire.BlockHeader
+	Height int64
+	Work   *big.Int // Nil if the cumulative work is not known, e.g. blocks saved before chainwork was tracked
+}
+
+func (n *Node) GetTime() int64 {
+	return n.Header.Timestamp.Unix()
+}
+
+func NewNode(header wire.BlockHeader, height int64, parentWork *big.Int) *Node {
+	var node = &Node{
+		Hash:   header.BlockHash(),
+		Header: header,
+		Height: height,
+	}
+	if parentWork != nil {
+		node.Work = new(big.Int).Add(parentWork, CalcWork(header.Bits))
+	}
+	return node
+}
+
+func CalcWork(bits uint32) *big.Int {
+	return blockchain.CalcWork(bits)
+}
+
+func CompactToBig(bits uint32) *big.Int {
+	return blockchain.CompactToBig(bits)
+}
+
+func BigToCompact(target *big.Int) uint32 {
+	return blockchain.BigToCompact(target)
+}
+
+// CheckProofOfWork verifies the target is within the network limit and the header hash meets the target.
+func CheckProofOfWork(header wire.BlockHeader, params *Params) error {
+	target := CompactToBig(header.Bits)
+	if target.Sign() <= 0 {
+		return fmt.Errorf("error header target is not positive: %08x", header.Bits)
+	}
+	if target.Cmp(params.PowLimit) > 0 {
+		return fmt.Errorf("error header target above pow limit: %08x", header.Bits)
+	}
+	blockHash := header.BlockHash()
+	if blockchain.HashToBig(&blockHash).Cmp(target) > 0 {
+		return fmt.Errorf("error header hash above target: %s (bits: %08x)", blockHash, header.Bits)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package pow_test
+
+import (
+	"github.com/jchavannes/btcd/chaincfg"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/ref/bitcoin/pow"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestCalcWork(t *testing.T) {
+	if work := pow.CalcWork(0x1d00ffff); work.Cmp(big.NewInt(0x100010001)) != 0 {
+		t.Errorf("unexpected work for pow limit bits: %s", work)
+	}
+}
+
+func TestCheckProofOfWork(t *testing.T) {
+	header := chaincfg.MainNetParams.GenesisBlock.Header
+	if err := pow.CheckProofOfWork(header, pow.GetMainNetParams()); err != nil {
+		t.Errorf("error checking genesis proof of work; %v", err)
+	}
+	header.Nonce++
+	if err := pow.CheckProofOfWork(header, pow.GetMainNetParams()); err == nil {
+		t.Errorf("expected error for genesis with invalid nonce")
+	}
+	header.Bits = 0x1e00ffff
+	if err := pow.CheckProofOfWork(header, pow.GetMainNetParams()); err == nil {
+		t.Errorf("expected error for target above pow limit")
+	}
+}
+
+var asertTests = []struct {
+	Name       string
+	TimeOffset int64
+	Bits       uint32
+}{{
+	Name: "on schedule",
+	Bits: 0x1c00ffff,
+}, {
+	Name:       "one half life behind",
+	TimeOffset: 2 * 24 * 60 * 60,
+	Bits:       0x1c01fffe,
+}, {
+	Name:       "one half life ahead",
+	TimeOffset: -2 * 24 * 60 * 60,
+	Bits:       0x1b7fff80,
+}, {
+	Name:       "far behind",
+	TimeOffset: 100 * 24 * 60 * 60,
+	Bits:       0x1d00ffff,
+}}
+
+func TestNextAsertBits(t *testing.T) {
+	params := *pow.GetMainNetParams()
+	params.Asert = pow.AsertAnchor{
+		Height:     1000,
+		Bits:       0x1c00ffff,
+		ParentTime: 1600000000,
+		HalfLife:   2 * 24 * 60 * 60,
+	}
+	for _, test := range asertTests {
+		const parentHeight = 1100
+		parentTime := params.Asert.ParentTime + (parentHeight-params.Asert.Height+1)*params.TargetSpacing +
+			test.TimeOffset
+		if bits := pow.NextAsertBits(&params, parentHeight, parentTime); bits != test.Bits {
+			t.Errorf("%s: unexpected asert bits: %08x, expected: %08x", test.Name, bits, test.Bits)
+		}
+	}
+}
+
+func TestNextCashWorkBits(t *testing.T) {
+	const bits = 0x1802cc47
+	params := pow.GetMainNetParams()
+	var nodes []*pow.Node
+	var work = new(big.Int)
+	for i := 0; i <= pow.Cw144Window+2; i++ {
+		node := pow.NewNode(wire.BlockHeader{
+			Bits:      bits,
+			Timestamp: time.Unix(1600000000+int64(i)*params.TargetSpacing, 0),
+		}, int64(i), work)
+		work = node.Work
+		nodes = append(nodes, node)
+	}
+	last := nodes[len(nodes)-3:]
+	first := nodes[len(nodes)-3-pow.Cw144Window : len(nodes)-pow.Cw144Window]
+	if nextBits := pow.NextCashWorkBits(params, first, last); nextBits != bits {
+		t.Errorf("unexpected cw-144 bits for steady blocks: %08x, expected: %08x", nextBits, bits)
+	}
+}
@@ -0,0 +1,26 @@
+package build
+
+import (
+	"fmt"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/tx/script"
+)
+
+type RepostRequest struct {
+	Wallet  Wallet
+	TxHash  []byte
+	Message string
+}
+
+func Repost(request RepostRequest) ([]*memo.Tx, error) {
+	txs, err := Simple(request.Wallet, []*memo.Output{{
+		Script: &script.Repost{
+			TxHash:  request.TxHash,
+			Message: request.Message,
+		},
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("error building repost tx; %w", err)
+	}
+	return txs, nil
+}
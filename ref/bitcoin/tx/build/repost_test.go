@@ -0,0 +1,49 @@
+package build_test
+
+import (
+	"github.com/memocash/index/ref/bitcoin/tx/build"
+	"github.com/memocash/index/ref/bitcoin/util/testing/test_tx"
+	"testing"
+)
+
+type RepostTest struct {
+	Request  build.RepostRequest
+	Error    error
+	TxHashes []test_tx.TxHash
+}
+
+func (tst RepostTest) Test(t *testing.T) {
+	txs, err := build.Repost(tst.Request)
+	test_tx.Checker{
+		Txs:      txs,
+		Error:    tst.Error,
+		TxHashes: tst.TxHashes,
+	}.Check(err, t)
+}
+
+func TestRepostSimple(t *testing.T) {
+	RepostTest{
+		Request: build.RepostRequest{
+			Wallet: test_tx.GetAddress1WalletSingle100k(),
+			TxHash: test_tx.HashEmptyTx,
+		},
+		TxHashes: []test_tx.TxHash{{
+			TxHash: "3dad93e64a09813b558415b76d720a86c69e1d5164a61a8a37460a656f36e32a",
+			TxRaw:  "0100000001290c9e545233529c68f1efac662cb3370df17d08cdbaa7e63e04284e670ffef4000000006b483045022100a18ac3a403fedee6a9b2218ba53dd111552297a11047c2cc700cbf3ad0d2ab84022076e627c9a0daab39b4e1a572306af8078df547c056074e3f79a14587d0e1decc412103065e9c67d6ef37c1b08f88d74a4b2090aa8d69f2e6ab5c116f60f05a78f2ededffffffff020000000000000000256a026d0b2043ec7a579f5561a42a7e9637ad4156672735a658be2752181801f723ba3316d2b2850100000000001976a914fc393e225549da044ed2c0011fd6c8a799806b6288ac00000000",
+		}},
+	}.Test(t)
+}
+
+func TestRepostQuote(t *testing.T) {
+	RepostTest{
+		Request: build.RepostRequest{
+			Wallet:  test_tx.GetAddress1WalletSingle100k(),
+			TxHash:  test_tx.HashEmptyTx,
+			Message: "Repost quote",
+		},
+		TxHashes: []test_tx.TxHash{{
+			TxHash: "5ae4148bca26ec5b9a1c485e1b10b29a1ee0f6162d9d907a3188f5d8cc4a850e",
+			TxRaw:  "0100000001290c9e545233529c68f1efac662cb3370df17d08cdbaa7e63e04284e670ffef4000000006b483045022100d102abdfeeab93ff10f824e044f76efb293b0c79c0a702124a883bc0f7580f0f022030eb879209f03922845824ec26484d81d9219a50fd10e24c5e7afb268e31f68c412103065e9c67d6ef37c1b08f88d74a4b2090aa8d69f2e6ab5c116f60f05a78f2ededffffffff020000000000000000326a026d0b2043ec7a579f5561a42a7e9637ad4156672735a658be2752181801f723ba3316d20c5265706f73742071756f7465a5850100000000001976a914fc393e225549da044ed2c0011fd6c8a799806b6288ac00000000",
+		}},
+	}.Test(t)
+}
@@ -31,10 +31,13 @@ func Send(request SendRequest) (*memo.Tx, error) {
 		}}, outputs...)
 	}
 	tx, err := gen.Tx(gen.TxRequest{
-		Getter:  request.Wallet.Getter,
-		Outputs: outputs,
-		Change:  request.Wallet.GetChange(),
-		KeyRing: request.Wallet.KeyRing,
+		Getter:   request.Wallet.Getter,
+		Outputs:  outputs,
+		Change:   request.Wallet.GetChange(),
+		KeyRing:  request.Wallet.KeyRing,
+		Selector: request.Wallet.Selector,
+		FeeRate:  request.Wallet.FeeRate,
+		MaxFee:   request.Wallet.MaxFee,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error building send tx; %w", err)
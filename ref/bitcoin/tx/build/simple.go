@@ -19,6 +19,9 @@ func SimpleWithInputs(w Wallet, outputs []*memo.Output, inputs []memo.UTXO) ([]*
 		Change:       w.GetChange(),
 		KeyRing:      w.KeyRing,
 		InputsToUse:  inputs,
+		Selector:     w.Selector,
+		FeeRate:      w.FeeRate,
+		MaxFee:       w.MaxFee,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error building simple tx; %w", err)
@@ -28,10 +31,13 @@ func SimpleWithInputs(w Wallet, outputs []*memo.Output, inputs []memo.UTXO) ([]*
 
 func SimpleSingle(w Wallet, outputs []*memo.Output) (*memo.Tx, error) {
 	tx, err := gen.Tx(gen.TxRequest{
-		Outputs: outputs,
-		Getter:  w.Getter,
-		Change:  w.GetChange(),
-		KeyRing: w.KeyRing,
+		Outputs:  outputs,
+		Getter:   w.Getter,
+		Change:   w.GetChange(),
+		KeyRing:  w.KeyRing,
+		Selector: w.Selector,
+		FeeRate:  w.FeeRate,
+		MaxFee:   w.MaxFee,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error building simple tx; %w", err)
@@ -13,6 +13,10 @@ type Wallet struct {
 	Address      wallet.Address
 	SlpAddress   wallet.Address
 	OldAddress   wallet.Address
+	// Selector, FeeRate and MaxFee are passed to the generator for simple and send txs.
+	Selector gen.CoinSelector
+	FeeRate  int64
+	MaxFee   int64
 }
 
 func (w Wallet) GetPkHash() []byte {
@@ -0,0 +1,108 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"sort"
+)
+
+const (
+	// ConsolidateMaxInputs keeps consolidation txs below the 100kB standard tx size.
+	ConsolidateMaxInputs = 500
+	ConsolidateMinInputs = 2
+)
+
+type ConsolidateRequest struct {
+	Getter  InputGetter
+	Address wallet.Address
+	KeyRing wallet.KeyRing
+	FeeRate int64
+	// MaxInputs per tx, defaults to ConsolidateMaxInputs.
+	MaxInputs int
+	// MinInputs for a tx to be worth making, defaults to ConsolidateMinInputs.
+	MinInputs int
+	// MaxValue only consolidates UTXOs below this value, zero for all UTXOs.
+	MaxValue int64
+}
+
+// Consolidate combines the getter's UTXOs into as few outputs to the address as possible, smallest first, with up to
+// MaxInputs inputs per tx. UTXOs worth less than the fee to spend them are left alone.
+func Consolidate(request ConsolidateRequest) ([]*memo.Tx, error) {
+	if jutil.IsNil(request.Getter) {
+		return nil, fmt.Errorf("error getter not set for consolidate; %w", NilInputGetterError)
+	}
+	var maxInputs, minInputs = request.MaxInputs, request.MinInputs
+	if maxInputs <= 0 {
+		maxInputs = ConsolidateMaxInputs
+	}
+	if minInputs <= 0 {
+		minInputs = ConsolidateMinInputs
+	}
+	var feeRate = TxRequest{FeeRate: request.FeeRate}.GetFeeRate()
+	request.Getter.NewTx()
+	utxos, err := getConsolidateUTXOs(request.Getter, memo.InputFeeP2PKH*feeRate, request.MaxValue)
+	if err != nil {
+		return nil, fmt.Errorf("error getting utxos to consolidate; %w", err)
+	}
+	sort.SliceStable(utxos, func(i, j int) bool {
+		return utxos[i].Input.Value < utxos[j].Input.Value
+	})
+	var memoTxs []*memo.Tx
+	for len(utxos) >= minInputs {
+		inputs := utxos[:jutil.MinInt(maxInputs, len(utxos))]
+		utxos = utxos[len(inputs):]
+		var value int64
+		for _, input := range inputs {
+			value += input.Input.Value
+		}
+		var fee = (memo.BaseTxFee + int64(len(inputs))*memo.InputFeeP2PKH + memo.OutputFeeP2PKH) * feeRate
+		if value-fee < memo.DustMinimumOutput {
+			continue
+		}
+		memoTx, err := Tx(TxRequest{
+			Getter:      request.Getter,
+			InputsToUse: inputs,
+			Outputs:     []*memo.Output{GetAddressOutput(request.Address, value-fee)},
+			Change:      wallet.GetChange(request.Address),
+			KeyRing:     request.KeyRing,
+			FeeRate:     feeRate,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error generating consolidate tx; %w", err)
+		}
+		memoTxs = append(memoTxs, memoTx)
+	}
+	return memoTxs, nil
+}
+
+// getConsolidateUTXOs gets UTXOs from the getter until it has no new ones, skipping token and sell inputs, UTXOs not
+// worth spending at the input fee and UTXOs at or above the max value.
+func getConsolidateUTXOs(getter InputGetter, inputFee, maxValue int64) ([]memo.UTXO, error) {
+	var utxos []memo.UTXO
+	var seen = make(map[string]bool)
+	for {
+		moreUTXOs, err := getter.GetUTXOs(nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting utxos from getter; %w", err)
+		}
+		var found bool
+		for _, utxo := range moreUTXOs {
+			key := fmt.Sprintf("%x:%d", utxo.Input.PrevOutHash, utxo.Input.PrevOutIndex)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			found = true
+			if utxo.IsSlp() || utxo.IsSellTokenInput() || utxo.Input.Value <= inputFee ||
+				(maxValue > 0 && utxo.Input.Value >= maxValue) {
+				continue
+			}
+			utxos = append(utxos, utxo)
+		}
+		if !found {
+			return utxos, nil
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package gen_test
+
+import (
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/tx/gen"
+	"github.com/memocash/index/ref/bitcoin/util/testing/test_tx"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"testing"
+)
+
+func TestConsolidate(t *testing.T) {
+	test_tx.ResetUTXOIndex()
+	var utxos []memo.UTXO
+	for i := 0; i < 1200; i++ {
+		utxo := test_tx.GetUTXO(memo.DustMinimumOutput)
+		utxo.Input.PkScript = test_tx.UnsignedTxTestAddress1PkScript
+		utxos = append(utxos, utxo)
+	}
+	// Not worth spending at the input fee
+	utxos = append(utxos, test_tx.GetUTXO(100))
+	memoTxs, err := gen.Consolidate(gen.ConsolidateRequest{
+		Getter:  gen.GetWrapper(&test_tx.TestGetter{UTXOs: utxos}, test_tx.Address1pkHash),
+		Address: test_tx.Address1,
+		KeyRing: wallet.GetSingleKeyRing(test_tx.Address1key),
+	})
+	if err != nil {
+		t.Fatalf("error consolidating; %v", err)
+	}
+	var expectedInputs = []int{500, 500, 200}
+	if len(memoTxs) != len(expectedInputs) {
+		t.Fatalf("consolidate tx count %d does not match expected %d", len(memoTxs), len(expectedInputs))
+	}
+	for i, memoTx := range memoTxs {
+		if len(memoTx.Inputs) != expectedInputs[i] || len(memoTx.Outputs) != 1 {
+			t.Errorf("consolidate tx %d inputs/outputs %d/%d do not match expected %d/1",
+				i, len(memoTx.Inputs), len(memoTx.Outputs), expectedInputs[i])
+			continue
+		}
+		var fee = memo.BaseTxFee + int64(expectedInputs[i])*memo.InputFeeP2PKH + memo.OutputFeeP2PKH
+		var expectedValue = int64(expectedInputs[i])*memo.DustMinimumOutput - fee
+		if memoTx.Outputs[0].Amount != expectedValue {
+			t.Errorf("consolidate tx %d output value %d does not match expected %d",
+				i, memoTx.Outputs[0].Amount, expectedValue)
+		}
+		if size := int64(memoTx.MsgTx.SerializeSize()); size > fee {
+			t.Errorf("consolidate tx %d size %d larger than fee %d", i, size, fee)
+		}
+	}
+}
@@ -57,6 +57,10 @@ func (c *Create) Build() (*wire.MsgTx, error) {
 		}
 		c.PotentialInputs = append(c.PotentialInputs, moreUTXOs...)
 	}
+	if c.Request.MaxFee > 0 && c.getFee() > c.Request.MaxFee {
+		return nil, fmt.Errorf("error tx fee above max (fee: %d, max: %d, inputs: %d); %w",
+			c.getFee(), c.Request.MaxFee, len(c.InputsToUse), MaxFeeExceededError)
+	}
 	wireTx, err := c.getWireTx()
 	if err != nil {
 		return nil, fmt.Errorf("error getting wire tx; %w", err)
@@ -43,16 +43,25 @@ func (c Create) getInputValue() int64 {
 	return totalInputValue
 }
 
+func (c Create) getInputsSize() int64 {
+	var size int64
+	for _, input := range c.InputsToUse {
+		size += GetInputSize(input.Input)
+	}
+	return size
+}
+
 func (c Create) getMinInput() (int64, error) {
-	var fee = memo.BaseTxFee + int64(len(c.InputsToUse))*memo.InputFeeP2PKH
+	var feeRate = c.Request.GetFeeRate()
+	var fee = (memo.BaseTxFee + c.getInputsSize()) * feeRate
 	var outputValues int64
 	for _, output := range c.Outputs {
 		switch output.Script.(type) {
 		case *script.P2pkh:
-			fee += memo.OutputFeeP2PKH
+			fee += memo.OutputFeeP2PKH * feeRate
 			outputValues += output.Amount
 		default:
-			outputFee, err := output.GetValuePlusFee()
+			outputFee, err := GetOutputValuePlusFee(output, feeRate)
 			if err != nil {
 				return -1, fmt.Errorf("error getting memo output fee; %w", err)
 			}
@@ -63,6 +72,15 @@ func (c Create) getMinInput() (int64, error) {
 	return minInput, nil
 }
 
+// getFee returns the fee paid by the inputs and outputs currently in the tx.
+func (c Create) getFee() int64 {
+	var fee = c.getInputValue()
+	for _, output := range c.Outputs {
+		fee -= output.Amount
+	}
+	return fee
+}
+
 func (c Create) getTokenInputValue() uint64 {
 	tokenSendOutput := c.Request.GetTokenSendOutput()
 	if tokenSendOutput == nil {
@@ -95,7 +113,7 @@ func (c Create) isCorrectValue() bool {
 		return false
 	}
 	totalInputValue := c.getInputValue()
-	return totalInputValue >= minInput && totalInputValue-minInput < memo.OutputFeeP2PKH
+	return totalInputValue >= minInput && totalInputValue-minInput < memo.OutputFeeP2PKH*c.Request.GetFeeRate()
 }
 
 func (c Create) isCorrectSlpValue() bool {
@@ -108,9 +126,10 @@ func (c Create) isEnoughInputValue() (bool, error) {
 		return false, fmt.Errorf("error getting min input; %w", err)
 	}
 	totalInputValue := c.getInputValue()
-	var additionInputForChange = memo.OutputFeeP2PKH + memo.DustMinimumOutput
+	var outputFee = memo.OutputFeeP2PKH * c.Request.GetFeeRate()
+	var additionInputForChange = outputFee + memo.DustMinimumOutput
 	var hasEnoughInputValue = totalInputValue >= (minInput+additionInputForChange) ||
-		(totalInputValue >= minInput && totalInputValue <= minInput+memo.OutputFeeP2PKH)
+		(totalInputValue >= minInput && totalInputValue <= minInput+outputFee)
 	return hasEnoughInputValue, nil
 }
 
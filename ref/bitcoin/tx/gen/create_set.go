@@ -16,6 +16,12 @@ func (c *Create) setInputs() error {
 	if hasEnoughInputValue {
 		return nil
 	}
+	if c.Request.Selector != nil {
+		if err := c.setSelectedInputs(); err != nil {
+			return fmt.Errorf("error setting selected inputs; %w", err)
+		}
+		return nil
+	}
 	for i := 0; i < len(c.PotentialInputs); i++ {
 		var potentialInput = c.PotentialInputs[i]
 		if potentialInput.IsSlp() || potentialInput.IsSellTokenInput() {
@@ -35,16 +41,56 @@ func (c *Create) setInputs() error {
 	return nil
 }
 
+// setSelectedInputs adds the inputs chosen by the request selector from the potential inputs. If the selector can't
+// cover the tx with the potential inputs so far nothing is added and more are fetched from the getter.
+func (c *Create) setSelectedInputs() error {
+	var candidates []memo.UTXO
+	for _, potentialInput := range c.PotentialInputs {
+		if potentialInput.IsSlp() || potentialInput.IsSellTokenInput() {
+			continue
+		}
+		candidates = append(candidates, potentialInput)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	minInput, err := c.getMinInput()
+	if err != nil {
+		return fmt.Errorf("error getting min input for selection; %w", err)
+	}
+	var feeRate = c.Request.GetFeeRate()
+	selected := c.Request.Selector.Select(SelectRequest{
+		Candidates: candidates,
+		Target:     minInput - c.getInputValue(),
+		FeeRate:    feeRate,
+		OutputFee:  memo.OutputFeeP2PKH * feeRate,
+	})
+loop:
+	for _, selectedInput := range selected {
+		for i := range c.PotentialInputs {
+			if c.PotentialInputs[i].IsEqual(selectedInput) {
+				c.InputsToUse = append(c.InputsToUse, c.PotentialInputs[i])
+				c.PotentialInputs = append(c.PotentialInputs[:i], c.PotentialInputs[i+1:]...)
+				continue loop
+			}
+		}
+		return fmt.Errorf("error selector returned unknown potential input")
+	}
+	return nil
+}
+
 func (c *Create) setChange() error {
 	if !c.isEnoughSlpValue() {
 		// Don't set change until SLP is complete
 		return nil
 	}
+	var feeRate = c.Request.GetFeeRate()
+	var outputFee = memo.OutputFeeP2PKH * feeRate
 	var totalInputValue = c.getInputValue()
-	var inputAndBaseFee = memo.BaseTxFee + int64(len(c.InputsToUse))*memo.InputFeeP2PKH
+	var inputAndBaseFee = (memo.BaseTxFee + c.getInputsSize()) * feeRate
 	var outputValueRequired int64
 	for _, spendOutput := range c.Outputs {
-		outputValuePlusFee, err := spendOutput.GetValuePlusFee()
+		outputValuePlusFee, err := GetOutputValuePlusFee(spendOutput, feeRate)
 		if err != nil {
 			return fmt.Errorf("error getting memo output fee; %w", err)
 		}
@@ -54,9 +100,9 @@ func (c *Create) setChange() error {
 	if change <= 0 {
 		// No change yet
 		return nil
-	} else if change < memo.DustMinimumOutput+memo.OutputFeeP2PKH {
+	} else if change < memo.DustMinimumOutput+outputFee {
 		// Not enough change for new output, add to existing output if possible
-		if change < memo.OutputFeeP2PKH {
+		if change < outputFee {
 			for _, output := range c.Outputs {
 				outputAddress := script.GetAddress(output.Script)
 				if output.Amount > memo.DustMinimumOutput && outputAddress.IsSame(c.Request.Change.Main) {
@@ -69,7 +115,7 @@ func (c *Create) setChange() error {
 		if !c.Request.Change.Main.IsSet() {
 			return fmt.Errorf("change address not set")
 		}
-		change -= memo.OutputFeeP2PKH
+		change -= outputFee
 		c.Outputs = append(c.Outputs, GetAddressOutput(c.Request.Change.Main, change))
 	}
 	return nil
@@ -7,6 +7,7 @@ import (
 	"github.com/memocash/index/ref/bitcoin/memo"
 	"github.com/memocash/index/ref/bitcoin/tx/script"
 	"github.com/memocash/index/ref/bitcoin/wallet"
+	"sort"
 )
 
 func FaucetTx(pkHash []byte, faucetGetter InputGetter, faucetKey wallet.PrivateKey) (*memo.Tx, memo.UTXO, error) {
@@ -21,9 +22,17 @@ func FaucetTx(pkHash []byte, faucetGetter InputGetter, faucetKey wallet.PrivateK
 	if len(utxos) == 0 {
 		return nil, memo.UTXO{}, fmt.Errorf("insufficient funds in faucet; %w", NotEnoughValueError)
 	}
+	// Spend the largest UTXOs first and only as many as needed, a fund can be at most half the inputs.
+	sort.SliceStable(utxos, func(i, j int) bool {
+		return utxos[i].Input.Value > utxos[j].Input.Value
+	})
 	var amount int64
-	for _, utxo := range utxos {
-		amount += utxo.Input.Value
+	for i := range utxos {
+		amount += utxos[i].Input.Value
+		if amount > memo.MaxFundAmount*2 {
+			utxos = utxos[:i+1]
+			break
+		}
 	}
 	var fee = memo.FeeP2pkh1In1OutTx + int64(len(utxos)-1)*memo.InputFeeP2PKH
 	if amount > memo.MaxFundAmount {
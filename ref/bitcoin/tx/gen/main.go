@@ -9,4 +9,5 @@ var (
 	NotEnoughValueError      = fmt.Errorf("error unable to find enough value to spend")
 	NotEnoughTokenValueError = fmt.Errorf("error unable to find enough token value to spend")
 	BelowDustLimitError      = fmt.Errorf("error output below dust limit")
+	MaxFeeExceededError      = fmt.Errorf("error tx fee above max fee")
 )
@@ -17,6 +17,9 @@ type MultiRequest struct {
 	InputsToUse  []memo.UTXO
 	KeyRing      wallet.KeyRing
 	Change       wallet.Change
+	Selector     CoinSelector
+	FeeRate      int64
+	MaxFee       int64
 }
 
 func Multi(request MultiRequest) ([]*memo.Tx, error) {
@@ -26,6 +29,9 @@ func Multi(request MultiRequest) ([]*memo.Tx, error) {
 		Change:      request.Change,
 		InputsToUse: request.InputsToUse,
 		KeyRing:     request.KeyRing,
+		Selector:    request.Selector,
+		FeeRate:     request.FeeRate,
+		MaxFee:      request.MaxFee,
 	})
 	if err == nil {
 		return []*memo.Tx{memoTx}, nil
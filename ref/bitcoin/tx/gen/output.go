@@ -1,6 +1,7 @@
 package gen
 
 import (
+	"fmt"
 	"github.com/memocash/index/ref/bitcoin/memo"
 	"github.com/memocash/index/ref/bitcoin/tx/script"
 	"github.com/memocash/index/ref/bitcoin/wallet"
@@ -19,3 +20,15 @@ func GetAddressOutput(address wallet.Address, quantity int64) *memo.Output {
 		}
 	}
 }
+
+// GetOutputValuePlusFee returns the output amount plus the fee for the output size at the fee rate.
+func GetOutputValuePlusFee(output *memo.Output, feeRate int64) (int64, error) {
+	if output.Script == nil {
+		return -1, fmt.Errorf("error getting memo output fee, script not set")
+	}
+	outputSize, err := memo.GetOutputSize(output.Script)
+	if err != nil {
+		return 0, fmt.Errorf("error getting output size; %w", err)
+	}
+	return outputSize*feeRate + output.Amount, nil
+}
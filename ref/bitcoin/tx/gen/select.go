@@ -0,0 +1,198 @@
+package gen
+
+import (
+	"bytes"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"sort"
+)
+
+// DefaultFeeRate is the fee rate in satoshis per byte used when a request doesn't set one.
+const DefaultFeeRate int64 = 1
+
+// BranchAndBoundMaxTries limits the search for an exact match when BranchAndBound.MaxTries isn't set.
+const BranchAndBoundMaxTries = 100000
+
+type SelectRequest struct {
+	Candidates []memo.UTXO
+	// Target is the value needed from selected inputs, not including the fees for the selected inputs.
+	Target int64
+	// FeeRate is the fee in satoshis per byte, each selected input adds a fee for its size.
+	FeeRate int64
+	// OutputFee is the fee for a change output. Excess below this is left as fee instead of adding change.
+	OutputFee int64
+}
+
+// GetInputFee returns the fee to spend a UTXO, P2SH multisig inputs are larger than P2PKH inputs.
+func (r SelectRequest) GetInputFee(utxo memo.UTXO) int64 {
+	return GetInputSize(utxo.Input) * r.FeeRate
+}
+
+// GetEffectiveValue returns the value of the UTXOs less the fees to spend them.
+func (r SelectRequest) GetEffectiveValue(utxos []memo.UTXO) int64 {
+	var value int64
+	for _, utxo := range utxos {
+		value += utxo.Input.Value - r.GetInputFee(utxo)
+	}
+	return value
+}
+
+// IsEnough returns whether the UTXOs cover the target either without change, or with enough excess for a change
+// output above the dust limit.
+func (r SelectRequest) IsEnough(utxos []memo.UTXO) bool {
+	var excess = r.GetEffectiveValue(utxos) - r.Target
+	return excess >= r.OutputFee+memo.DustMinimumOutput || (excess >= 0 && excess < r.OutputFee)
+}
+
+// getEconomical returns the candidates worth more than the fee to spend them.
+func (r SelectRequest) getEconomical() []memo.UTXO {
+	var utxos []memo.UTXO
+	for _, utxo := range r.Candidates {
+		if utxo.Input.Value > r.GetInputFee(utxo) {
+			utxos = append(utxos, utxo)
+		}
+	}
+	return utxos
+}
+
+// CoinSelector chooses which candidates to spend. Returning nil has the generator fetch more candidates from the
+// getter and select again.
+type CoinSelector interface {
+	Select(request SelectRequest) []memo.UTXO
+}
+
+// selectInOrder adds UTXOs in order until there is enough, nil if there never is.
+func selectInOrder(request SelectRequest, utxos []memo.UTXO) []memo.UTXO {
+	for i := range utxos {
+		if request.IsEnough(utxos[:i+1]) {
+			return utxos[:i+1]
+		}
+	}
+	return nil
+}
+
+// LargestFirst spends the largest UTXOs first, using the fewest inputs and lowest fee.
+type LargestFirst struct{}
+
+func (LargestFirst) Select(request SelectRequest) []memo.UTXO {
+	utxos := request.getEconomical()
+	sort.SliceStable(utxos, func(i, j int) bool {
+		return utxos[i].Input.Value > utxos[j].Input.Value
+	})
+	return selectInOrder(request, utxos)
+}
+
+// SmallestFirst spends the smallest UTXOs first, cleaning up small UTXOs at the cost of a higher fee.
+type SmallestFirst struct{}
+
+func (SmallestFirst) Select(request SelectRequest) []memo.UTXO {
+	utxos := request.getEconomical()
+	sort.SliceStable(utxos, func(i, j int) bool {
+		return utxos[i].Input.Value < utxos[j].Input.Value
+	})
+	return selectInOrder(request, utxos)
+}
+
+// BranchAndBound searches for inputs that match the target closely enough to not need a change output, falling back
+// to another selector if there is no match.
+type BranchAndBound struct {
+	// Fallback selector if there is no exact match, defaults to LargestFirst.
+	Fallback CoinSelector
+	// MaxTries limits the search, defaults to BranchAndBoundMaxTries.
+	MaxTries int
+}
+
+func (b BranchAndBound) Select(request SelectRequest) []memo.UTXO {
+	if match := b.findMatch(request); match != nil {
+		return match
+	}
+	if b.Fallback != nil {
+		return b.Fallback.Select(request)
+	}
+	return LargestFirst{}.Select(request)
+}
+
+// findMatch does a depth first search of including or excluding each UTXO, largest first, for the selection with the
+// least excess below the change output fee.
+func (b BranchAndBound) findMatch(request SelectRequest) []memo.UTXO {
+	utxos := request.getEconomical()
+	sort.SliceStable(utxos, func(i, j int) bool {
+		return utxos[i].Input.Value > utxos[j].Input.Value
+	})
+	var remaining = make([]int64, len(utxos)+1)
+	for i := len(utxos) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + utxos[i].Input.Value - request.GetInputFee(utxos[i])
+	}
+	var maxTries = b.MaxTries
+	if maxTries <= 0 {
+		maxTries = BranchAndBoundMaxTries
+	}
+	var tries int
+	var best []memo.UTXO
+	var bestExcess = request.OutputFee
+	var selected []memo.UTXO
+	var search func(i int, value int64)
+	search = func(i int, value int64) {
+		if tries >= maxTries || bestExcess == 0 {
+			return
+		}
+		tries++
+		if excess := value - request.Target; excess >= 0 {
+			if excess < bestExcess {
+				best = append([]memo.UTXO{}, selected...)
+				bestExcess = excess
+			}
+			return
+		}
+		if i == len(utxos) || value+remaining[i] < request.Target {
+			return
+		}
+		selected = append(selected, utxos[i])
+		search(i+1, value+utxos[i].Input.Value-request.GetInputFee(utxos[i]))
+		selected = selected[:len(selected)-1]
+		search(i+1, value)
+	}
+	search(0, 0)
+	if len(best) == 0 {
+		return nil
+	}
+	return best
+}
+
+// AvoidAddressReuse spends all UTXOs of an address together so no value is left on an address whose public key has
+// been revealed, and adds the fewest addresses needed, largest first, to limit linking addresses together.
+type AvoidAddressReuse struct{}
+
+func (AvoidAddressReuse) Select(request SelectRequest) []memo.UTXO {
+	type addressUTXOs struct {
+		PkHash []byte
+		UTXOs  []memo.UTXO
+		Value  int64
+	}
+	var groups []*addressUTXOs
+candidateLoop:
+	for _, utxo := range request.Candidates {
+		for _, group := range groups {
+			if bytes.Equal(group.PkHash, utxo.Input.PkHash) {
+				group.UTXOs = append(group.UTXOs, utxo)
+				group.Value += utxo.Input.Value - request.GetInputFee(utxo)
+				continue candidateLoop
+			}
+		}
+		groups = append(groups, &addressUTXOs{
+			PkHash: utxo.Input.PkHash,
+			UTXOs:  []memo.UTXO{utxo},
+			Value:  utxo.Input.Value - request.GetInputFee(utxo),
+		})
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].Value > groups[j].Value
+	})
+	var selected []memo.UTXO
+	for _, group := range groups {
+		selected = append(selected, group.UTXOs...)
+		if request.IsEnough(selected) {
+			return selected
+		}
+	}
+	return nil
+}
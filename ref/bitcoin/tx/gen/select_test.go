@@ -0,0 +1,171 @@
+package gen_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/tx/gen"
+	"github.com/memocash/index/ref/bitcoin/util/testing/test_tx"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"reflect"
+	"testing"
+)
+
+type SelectTest struct {
+	Name     string
+	UTXOs    []memo.UTXO
+	Selector gen.CoinSelector
+	FeeRate  int64
+	MaxFee   int64
+	Inputs   []int64
+	Fee      int64
+	Error    error
+}
+
+func (tst SelectTest) Test(t *testing.T) {
+	memoTx, err := gen.TxUnsigned(gen.TxRequest{
+		Getter:   gen.GetWrapperMultiKey(&test_tx.TestGetter{UTXOs: tst.UTXOs}, [][]byte{test_tx.Address1pkHash, test_tx.Address2pkHash}),
+		Outputs:  []*memo.Output{gen.GetAddressOutput(test_tx.Address2, 10000)},
+		Change:   wallet.GetChange(test_tx.Address1),
+		Selector: tst.Selector,
+		FeeRate:  tst.FeeRate,
+		MaxFee:   tst.MaxFee,
+	})
+	if err != nil {
+		if tst.Error == nil || !errors.Is(err, tst.Error) {
+			t.Error(fmt.Errorf("%s: error generating tx; %w", tst.Name, err))
+		}
+		return
+	} else if tst.Error != nil {
+		t.Errorf("%s: expected error: %v", tst.Name, tst.Error)
+		return
+	}
+	var inputs []int64
+	var fee int64
+	for _, input := range memoTx.Inputs {
+		inputs = append(inputs, input.Value)
+		fee += input.Value
+	}
+	for _, output := range memoTx.Outputs {
+		fee -= output.Amount
+	}
+	if !reflect.DeepEqual(inputs, tst.Inputs) {
+		t.Errorf("%s: inputs %v do not match expected %v", tst.Name, inputs, tst.Inputs)
+	}
+	if tst.Fee != 0 && fee != tst.Fee {
+		t.Errorf("%s: fee %d does not match expected %d", tst.Name, fee, tst.Fee)
+	}
+}
+
+func getSelectUTXOs(pkHash []byte, values ...int64) []memo.UTXO {
+	var utxos []memo.UTXO
+	for _, value := range values {
+		utxo := test_tx.GetUTXO(value)
+		utxo.Input.PkHash = pkHash
+		utxos = append(utxos, utxo)
+	}
+	return utxos
+}
+
+func TestSelect(t *testing.T) {
+	test_tx.ResetUTXOIndex()
+	// Spending 10,000 with change needs 10,044 plus 148 per input
+	var utxos = getSelectUTXOs(test_tx.Address1pkHash, 8000, 2000, 20000, 3000, 7340)
+	var reuseUTXOs = append(getSelectUTXOs(test_tx.Address1pkHash, 12000, 11000),
+		getSelectUTXOs(test_tx.Address2pkHash, 12500, 300)...)
+	for _, tst := range []SelectTest{{
+		Name:   "Getter order",
+		UTXOs:  utxos,
+		Inputs: []int64{8000, 2000, 20000},
+	}, {
+		Name:     "Largest first",
+		UTXOs:    utxos,
+		Selector: gen.LargestFirst{},
+		Inputs:   []int64{20000},
+		Fee:      226,
+	}, {
+		Name:     "Smallest first",
+		UTXOs:    utxos,
+		Selector: gen.SmallestFirst{},
+		Inputs:   []int64{2000, 3000, 7340},
+	}, {
+		Name:     "Branch and bound exact match",
+		UTXOs:    utxos,
+		Selector: gen.BranchAndBound{},
+		Inputs:   []int64{7340, 3000},
+		Fee:      340,
+	}, {
+		Name:     "Branch and bound fallback",
+		UTXOs:    getSelectUTXOs(test_tx.Address1pkHash, 2000, 30000, 9000),
+		Selector: gen.BranchAndBound{Fallback: gen.SmallestFirst{}},
+		Inputs:   []int64{2000, 9000},
+	}, {
+		Name:     "Avoid address reuse",
+		UTXOs:    reuseUTXOs,
+		Selector: gen.AvoidAddressReuse{},
+		Inputs:   []int64{12000, 11000},
+	}, {
+		Name:     "Avoid address reuse spends whole address",
+		UTXOs:    append(getSelectUTXOs(test_tx.Address1pkHash, 11000), reuseUTXOs[2:]...),
+		Selector: gen.AvoidAddressReuse{},
+		Inputs:   []int64{12500, 300},
+	}, {
+		Name:     "Fee rate",
+		UTXOs:    utxos,
+		Selector: gen.LargestFirst{},
+		FeeRate:  3,
+		Inputs:   []int64{20000},
+		Fee:      678,
+	}, {
+		Name:     "Max fee",
+		UTXOs:    utxos,
+		Selector: gen.SmallestFirst{},
+		MaxFee:   500,
+		Error:    gen.MaxFeeExceededError,
+	}, {
+		Name:     "Not enough value",
+		UTXOs:    getSelectUTXOs(test_tx.Address1pkHash, 3000, 100),
+		Selector: gen.LargestFirst{},
+		Error:    gen.NotEnoughValueError,
+	}} {
+		tst.Test(t)
+	}
+}
+
+func TestSelectInputFee(t *testing.T) {
+	var publicKeys []wallet.PublicKey
+	for _, keyString := range []string{test_tx.Key1String, test_tx.Key2String, test_tx.Key3String} {
+		publicKeys = append(publicKeys, test_tx.GetPrivateKey(keyString).GetPublicKey())
+	}
+	multiSig, err := wallet.NewMultiSig(2, publicKeys)
+	if err != nil {
+		t.Fatalf("error creating multisig; %v", err)
+	}
+	redeemScript, err := multiSig.GetRedeemScript()
+	if err != nil {
+		t.Fatalf("error getting multisig redeem script; %v", err)
+	}
+	test_tx.ResetUTXOIndex()
+	p2pkhUTXO := getSelectUTXOs(test_tx.Address1pkHash, 10400)[0]
+	p2shUTXO := getSelectUTXOs(test_tx.Address1pkHash, 10400)[0]
+	p2shUTXO.Input.RedeemScript = redeemScript
+	// Enough for the P2PKH input without change, not for the larger P2SH input
+	var request = gen.SelectRequest{
+		Candidates: []memo.UTXO{p2shUTXO, p2pkhUTXO},
+		Target:     10400 - memo.InputFeeP2PKH - 10,
+		FeeRate:    1,
+		OutputFee:  memo.OutputFeeP2PKH,
+	}
+	if fee := request.GetInputFee(p2shUTXO); fee != gen.GetInputSize(p2shUTXO.Input) || fee <= memo.InputFeeP2PKH {
+		t.Errorf("unexpected p2sh input fee: %d", fee)
+	}
+	if !request.IsEnough([]memo.UTXO{p2pkhUTXO}) {
+		t.Errorf("expected p2pkh input to be enough")
+	}
+	if request.IsEnough([]memo.UTXO{p2shUTXO}) {
+		t.Errorf("expected p2sh input to not be enough with its input fee")
+	}
+	if selected := (gen.LargestFirst{}).Select(request); len(selected) != 2 {
+		t.Errorf("unexpected selected input count: %d, expected: 2", len(selected))
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/jchavannes/btcd/txscript"
 	"github.com/jchavannes/btcd/wire"
 	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/tx/sign"
 	"github.com/memocash/index/ref/bitcoin/wallet"
 )
 
@@ -13,6 +14,12 @@ func Sign(msg *wire.MsgTx, inputs []memo.TxInput, keyRing wallet.KeyRing) error
 		if len(msg.TxIn[i].SignatureScript) > 0 {
 			continue
 		}
+		if len(inputs[i].RedeemScript) > 0 {
+			if err := SignMultiSigInput(msg, i, inputs[i], keyRing); err != nil {
+				return fmt.Errorf("error signing multisig input; %w", err)
+			}
+			continue
+		}
 		sig, err := InputSignature(msg, i, keyRing, inputs)
 		if err != nil {
 			return fmt.Errorf("error signing input signature; %w", err)
@@ -31,6 +38,25 @@ func Sign(msg *wire.MsgTx, inputs []memo.TxInput, keyRing wallet.KeyRing) error
 	return nil
 }
 
+// SignMultiSigInput signs a P2SH multisig input, the key ring must have enough of the keys to complete it. Use
+// TxPartial to collect signatures from cosigners.
+func SignMultiSigInput(msg *wire.MsgTx, index int, input memo.TxInput, keyRing wallet.KeyRing) error {
+	var partialInput = &sign.PartialInput{
+		PkScript:     input.PkScript,
+		Value:        input.Value,
+		RedeemScript: input.RedeemScript,
+	}
+	if err := partialInput.Sign(msg, index, keyRing); err != nil {
+		return fmt.Errorf("error signing multisig partial input: %s; %w", input.GetHashIndexString(), err)
+	}
+	sigScript, err := partialInput.GetSignatureScript()
+	if err != nil {
+		return fmt.Errorf("error getting multisig signature script: %s; %w", input.GetHashIndexString(), err)
+	}
+	msg.TxIn[index].SignatureScript = sigScript
+	return nil
+}
+
 func InputSignature(tx *wire.MsgTx, index int, keyRing wallet.KeyRing, spendOuts []memo.TxInput) ([]byte, error) {
 	if len(spendOuts[index].PkScript) == 0 {
 		return nil, fmt.Errorf("error no pk script for input signature: %s", spendOuts[index].GetHashIndexString())
@@ -1,6 +1,9 @@
 package gen
 
-import "github.com/memocash/index/ref/bitcoin/memo"
+import (
+	"github.com/jchavannes/btcd/txscript"
+	"github.com/memocash/index/ref/bitcoin/memo"
+)
 
 func GetNonPointerTxInputs(pointerTxInputs []*memo.TxInput) []memo.TxInput {
 	var inputs = make([]memo.TxInput, len(pointerTxInputs))
@@ -9,3 +12,28 @@ func GetNonPointerTxInputs(pointerTxInputs []*memo.TxInput) []memo.TxInput {
 	}
 	return inputs
 }
+
+// GetInputSize returns the estimated signed size of an input. A P2SH multisig input has an OP_0, a signature for
+// each required key and the redeem script.
+func GetInputSize(input memo.TxInput) int64 {
+	if len(input.RedeemScript) == 0 {
+		return memo.InputFeeP2PKH
+	}
+	_, required, _ := txscript.CalcMultiSigStats(input.RedeemScript)
+	var redeemSize = int64(len(input.RedeemScript))
+	var scriptSize = 1 + int64(required)*(1+72) + redeemSize
+	switch {
+	case redeemSize < txscript.OP_PUSHDATA1:
+		scriptSize += 1
+	case redeemSize <= 0xff:
+		scriptSize += 2
+	default:
+		scriptSize += 3
+	}
+	// Outpoint, sequence and the script length
+	var size = 32 + 4 + 4 + scriptSize + 1
+	if scriptSize >= 0xfd {
+		size += 2
+	}
+	return size
+}
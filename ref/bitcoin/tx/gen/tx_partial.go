@@ -0,0 +1,36 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/memocash/index/ref/bitcoin/tx/sign"
+)
+
+// TxPartial builds a tx and signs what it can with the request key ring, returning a partial tx for cosigners to add
+// signatures to. Spent inputs and change aren't marked on the getter since the tx hash changes once signed.
+func TxPartial(request TxRequest) (*sign.PartialTx, error) {
+	create := Create{
+		Request:     request,
+		InputsToUse: request.InputsToUse,
+		Outputs:     request.Outputs,
+	}
+	msgTx, err := create.Build()
+	if err != nil {
+		return nil, fmt.Errorf("error building partial tx; %w", err)
+	}
+	var inputs = make([]*sign.PartialInput, len(create.InputsToUse))
+	for i, input := range create.InputsToUse {
+		inputs[i] = &sign.PartialInput{
+			PkScript:     input.Input.PkScript,
+			Value:        input.Input.Value,
+			RedeemScript: input.Input.RedeemScript,
+		}
+	}
+	partialTx, err := sign.NewPartialTx(msgTx, inputs)
+	if err != nil {
+		return nil, fmt.Errorf("error creating partial tx; %w", err)
+	}
+	if err := partialTx.Sign(request.KeyRing); err != nil {
+		return nil, fmt.Errorf("error signing partial tx; %w", err)
+	}
+	return partialTx, nil
+}
@@ -145,6 +145,19 @@ type TxRequest struct {
 	Outputs     []*memo.Output
 	Change      wallet.Change
 	KeyRing     wallet.KeyRing
+	// Selector chooses inputs from the getter UTXOs, nil to use them in the order returned.
+	Selector CoinSelector
+	// FeeRate in satoshis per byte, defaults to DefaultFeeRate.
+	FeeRate int64
+	// MaxFee fails the tx with MaxFeeExceededError if the fee is higher, zero for no limit.
+	MaxFee int64
+}
+
+func (r TxRequest) GetFeeRate() int64 {
+	if r.FeeRate <= 0 {
+		return DefaultFeeRate
+	}
+	return r.FeeRate
 }
 
 func (r TxRequest) GetTokenSendOutput() *script.TokenSend {
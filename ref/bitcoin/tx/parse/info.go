@@ -148,7 +148,7 @@ func GetTxInfo(tx *memo.Tx) TxInfo {
 		if err != nil {
 			return TxInfo{Error: fmt.Errorf("error disassembling lockScript; %w", err)}
 		}
-		scriptClass, addresses, sigCount, err := txscript.ExtractPkScriptAddrs(out.PkScript, wallet.GetMainNetParams())
+		scriptClass, addresses, sigCount, err := txscript.ExtractPkScriptAddrs(out.PkScript, wallet.GetNetParams())
 		var txInfoAddress TxInfoAddress
 		if out.Value > 0 {
 			var addressString string
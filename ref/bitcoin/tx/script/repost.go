@@ -0,0 +1,35 @@
+package script
+
+import (
+	"fmt"
+	"github.com/memocash/index/ref/bitcoin/memo"
+)
+
+type Repost struct {
+	TxHash  []byte
+	Message string
+}
+
+func (r Repost) Get() ([]byte, error) {
+	if len(r.TxHash) != memo.TxHashLength {
+		return nil, fmt.Errorf("invalid repost tx hash length: %d", len(r.TxHash))
+	}
+	if len(r.Message) > memo.MaxPostSize {
+		return nil, fmt.Errorf("repost message too large")
+	}
+	builder := memo.GetBaseOpReturn().
+		AddData(memo.PrefixRepost).
+		AddData(r.TxHash)
+	if len(r.Message) > 0 {
+		builder = builder.AddData([]byte(r.Message))
+	}
+	pkScript, err := builder.Script()
+	if err != nil {
+		return nil, fmt.Errorf("error creating memo repost output; %w", err)
+	}
+	return pkScript, nil
+}
+
+func (r Repost) Type() memo.OutputType {
+	return memo.OutputTypeMemoRepost
+}
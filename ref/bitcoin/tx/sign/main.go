@@ -0,0 +1,12 @@
+package sign
+
+import (
+	"fmt"
+)
+
+var (
+	InvalidSignatureError     = fmt.Errorf("error invalid signature")
+	NotEnoughSignaturesError  = fmt.Errorf("error not enough signatures")
+	RedeemScriptMismatchError = fmt.Errorf("error redeem script does not match p2sh pk script")
+	TxMismatchError           = fmt.Errorf("error partial txs do not match")
+)
@@ -0,0 +1,377 @@
+package sign
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/jchavannes/btcd/txscript"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/jchavannes/btcutil"
+	"github.com/memocash/index/ref/bitcoin/tx/script"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+)
+
+type PartialSignature struct {
+	PublicKey []byte
+	Signature []byte
+}
+
+// PartialInput is the output spent by a tx input and the signatures collected for it. RedeemScript is set for P2SH
+// multisig inputs, otherwise the input is P2PKH.
+type PartialInput struct {
+	PkScript     []byte
+	Value        int64
+	RedeemScript []byte
+	Signatures   []PartialSignature
+}
+
+func (i PartialInput) IsMultiSig() bool {
+	return len(i.RedeemScript) > 0
+}
+
+// GetSubScript returns the script signatures commit to.
+func (i PartialInput) GetSubScript() []byte {
+	if i.IsMultiSig() {
+		return i.RedeemScript
+	}
+	return i.PkScript
+}
+
+// CheckRedeemScript verifies the redeem script of a multisig input hashes to the P2SH pk script of the spent output.
+func (i PartialInput) CheckRedeemScript() error {
+	if !i.IsMultiSig() {
+		return nil
+	}
+	pkScript, err := script.P2sh{ScriptHash: btcutil.Hash160(i.RedeemScript)}.Get()
+	if err != nil {
+		return fmt.Errorf("error getting p2sh pk script for redeem script; %w", err)
+	}
+	if !bytes.Equal(pkScript, i.PkScript) {
+		return RedeemScriptMismatchError
+	}
+	return nil
+}
+
+func (i PartialInput) GetMultiSig() (*wallet.MultiSig, error) {
+	if !i.IsMultiSig() {
+		return nil, fmt.Errorf("error input is not multisig")
+	}
+	if err := i.CheckRedeemScript(); err != nil {
+		return nil, fmt.Errorf("error checking partial input redeem script; %w", err)
+	}
+	multiSig, err := wallet.GetMultiSigFromRedeemScript(i.RedeemScript)
+	if err != nil {
+		return nil, fmt.Errorf("error getting multisig from partial input redeem script; %w", err)
+	}
+	return multiSig, nil
+}
+
+// GetSigners returns the public key hashes that can sign the input and the number of signatures required.
+func (i PartialInput) GetSigners() ([][]byte, int, error) {
+	if !i.IsMultiSig() {
+		address, err := wallet.GetAddressFromPkScript(i.PkScript)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error getting address from partial input pk script; %w", err)
+		}
+		return [][]byte{address.GetPkHash()}, 1, nil
+	}
+	multiSig, err := i.GetMultiSig()
+	if err != nil {
+		return nil, 0, fmt.Errorf("error getting multisig for partial input signers; %w", err)
+	}
+	var pkHashes = make([][]byte, len(multiSig.PublicKeys))
+	for j, publicKey := range multiSig.PublicKeys {
+		pkHashes[j] = publicKey.GetPkHash()
+	}
+	return pkHashes, multiSig.Required, nil
+}
+
+func (i PartialInput) HasSignature(publicKey []byte) bool {
+	for _, signature := range i.Signatures {
+		if bytes.Equal(signature.PublicKey, publicKey) {
+			return true
+		}
+	}
+	return false
+}
+
+func (i PartialInput) IsComplete() bool {
+	_, required, err := i.GetSigners()
+	return err == nil && len(i.Signatures) >= required
+}
+
+// AddSignature verifies and adds a signature for the input at the index of the tx, existing signatures are ignored.
+func (i *PartialInput) AddSignature(tx *wire.MsgTx, index int, publicKey, signature []byte) error {
+	if i.HasSignature(publicKey) {
+		return nil
+	}
+	pkHashes, _, err := i.GetSigners()
+	if err != nil {
+		return fmt.Errorf("error getting signers for partial input; %w", err)
+	}
+	publicKeyObj, err := wallet.GetPublicKey(publicKey)
+	if err != nil {
+		return fmt.Errorf("error getting public key for partial signature; %w", err)
+	}
+	var isSigner bool
+	for _, pkHash := range pkHashes {
+		if bytes.Equal(pkHash, publicKeyObj.GetPkHash()) {
+			isSigner = true
+			break
+		}
+	}
+	if !isSigner {
+		return fmt.Errorf("error public key is not a signer for input: %d; %w", index, InvalidSignatureError)
+	}
+	if err := VerifyInputSignature(tx, index, i.GetSubScript(), i.Value, publicKey, signature); err != nil {
+		return fmt.Errorf("error verifying partial signature; %w", err)
+	}
+	i.Signatures = append(i.Signatures, PartialSignature{
+		PublicKey: publicKey,
+		Signature: signature,
+	})
+	return nil
+}
+
+// Sign adds signatures for the input from any signer keys in the key ring, stopping once complete.
+func (i *PartialInput) Sign(tx *wire.MsgTx, index int, keyRing wallet.KeyRing) error {
+	pkHashes, _, err := i.GetSigners()
+	if err != nil {
+		return fmt.Errorf("error getting signers for partial input sign; %w", err)
+	}
+	for _, pkHash := range pkHashes {
+		if i.IsComplete() {
+			break
+		}
+		privateKey := keyRing.GetKey(pkHash)
+		if !privateKey.IsSet() {
+			continue
+		}
+		signature, err := txscript.RawTxInECDSASignature(tx, index, i.GetSubScript(),
+			txscript.SigHashAll|wallet.SigHashForkID, privateKey.GetBtcEcPrivateKey(), i.Value)
+		if err != nil {
+			return fmt.Errorf("error signing partial input: %d; %w", index, err)
+		}
+		if err := i.AddSignature(tx, index, privateKey.GetPublicKey().GetSerialized(), signature); err != nil {
+			return fmt.Errorf("error adding signature to partial input; %w", err)
+		}
+	}
+	return nil
+}
+
+// GetSignatureScript returns the unlock script, for multisig the signatures are in redeem script key order after the
+// OP_0 required by OP_CHECKMULTISIG.
+func (i PartialInput) GetSignatureScript() ([]byte, error) {
+	if err := i.CheckRedeemScript(); err != nil {
+		return nil, fmt.Errorf("error checking partial input redeem script for signature script; %w", err)
+	}
+	if !i.IsComplete() {
+		return nil, fmt.Errorf("error partial input has %d signatures; %w", len(i.Signatures), NotEnoughSignaturesError)
+	}
+	if !i.IsMultiSig() {
+		sigScript, err := txscript.NewScriptBuilder().
+			AddData(i.Signatures[0].Signature).
+			AddData(i.Signatures[0].PublicKey).
+			Script()
+		if err != nil {
+			return nil, fmt.Errorf("error building p2pkh signature script; %w", err)
+		}
+		return sigScript, nil
+	}
+	multiSig, err := i.GetMultiSig()
+	if err != nil {
+		return nil, fmt.Errorf("error getting multisig for signature script; %w", err)
+	}
+	builder := txscript.NewScriptBuilder().AddOp(txscript.OP_0)
+	var added int
+	for _, publicKey := range multiSig.PublicKeys {
+		for _, signature := range i.Signatures {
+			if added < multiSig.Required && bytes.Equal(signature.PublicKey, publicKey.GetSerialized()) {
+				builder.AddData(signature.Signature)
+				added++
+			}
+		}
+	}
+	sigScript, err := builder.AddData(i.RedeemScript).Script()
+	if err != nil {
+		return nil, fmt.Errorf("error building multisig signature script; %w", err)
+	}
+	return sigScript, nil
+}
+
+// PartialTx is an unsigned tx with the signatures collected so far, serialized to pass between cosigners.
+type PartialTx struct {
+	Tx     *wire.MsgTx
+	Inputs []*PartialInput
+}
+
+func NewPartialTx(tx *wire.MsgTx, inputs []*PartialInput) (*PartialTx, error) {
+	if len(tx.TxIn) != len(inputs) {
+		return nil, fmt.Errorf("error partial tx input count does not match tx (%d %d)", len(inputs), len(tx.TxIn))
+	}
+	for i, input := range inputs {
+		if err := input.CheckRedeemScript(); err != nil {
+			return nil, fmt.Errorf("error checking partial tx input %d; %w", i, err)
+		}
+	}
+	var unsignedTx = tx.Copy()
+	for _, txIn := range unsignedTx.TxIn {
+		txIn.SignatureScript = nil
+	}
+	return &PartialTx{
+		Tx:     unsignedTx,
+		Inputs: inputs,
+	}, nil
+}
+
+func (p *PartialTx) Sign(keyRing wallet.KeyRing) error {
+	for i, input := range p.Inputs {
+		if err := input.Sign(p.Tx, i, keyRing); err != nil {
+			return fmt.Errorf("error signing partial tx input; %w", err)
+		}
+	}
+	return nil
+}
+
+// Combine adds the signatures from another copy of the same partial tx, verifying each one.
+func (p *PartialTx) Combine(other *PartialTx) error {
+	if p.Tx.TxHash() != other.Tx.TxHash() || len(p.Inputs) != len(other.Inputs) {
+		return fmt.Errorf("error combining partial tx %s with %s; %w", p.Tx.TxHash(), other.Tx.TxHash(),
+			TxMismatchError)
+	}
+	for i, input := range p.Inputs {
+		if !bytes.Equal(input.PkScript, other.Inputs[i].PkScript) ||
+			!bytes.Equal(input.RedeemScript, other.Inputs[i].RedeemScript) || input.Value != other.Inputs[i].Value {
+			return fmt.Errorf("error combining partial tx input %d; %w", i, TxMismatchError)
+		}
+		for _, signature := range other.Inputs[i].Signatures {
+			if err := input.AddSignature(p.Tx, i, signature.PublicKey, signature.Signature); err != nil {
+				return fmt.Errorf("error adding combined signature; %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (p PartialTx) IsComplete() bool {
+	for _, input := range p.Inputs {
+		if !input.IsComplete() {
+			return false
+		}
+	}
+	return true
+}
+
+// GetSigned returns the tx with signature scripts from the collected signatures, verified against the spent outputs.
+func (p PartialTx) GetSigned() (*wire.MsgTx, error) {
+	var signedTx = p.Tx.Copy()
+	var outputs = make([]*Output, len(p.Inputs))
+	for i, input := range p.Inputs {
+		sigScript, err := input.GetSignatureScript()
+		if err != nil {
+			return nil, fmt.Errorf("error getting signature script for input %d; %w", i, err)
+		}
+		signedTx.TxIn[i].SignatureScript = sigScript
+		outputs[i] = &Output{
+			PkScript: input.PkScript,
+			Value:    input.Value,
+		}
+	}
+	if err := VerifyWithOutputs(signedTx, outputs); err != nil {
+		return nil, fmt.Errorf("error verifying signed partial tx; %w", err)
+	}
+	return signedTx, nil
+}
+
+type partialTxJson struct {
+	Tx     string             `json:"tx"`
+	Inputs []partialInputJson `json:"inputs"`
+}
+
+type partialInputJson struct {
+	PkScript     string                 `json:"pk_script"`
+	Value        int64                  `json:"value"`
+	RedeemScript string                 `json:"redeem_script,omitempty"`
+	Signatures   []partialSignatureJson `json:"signatures,omitempty"`
+}
+
+type partialSignatureJson struct {
+	PublicKey string `json:"public_key"`
+	Signature string `json:"signature"`
+}
+
+// Serialize encodes the partial tx as JSON with hex fields.
+func (p PartialTx) Serialize() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := p.Tx.Serialize(&buf); err != nil {
+		return nil, fmt.Errorf("error serializing partial tx wire tx; %w", err)
+	}
+	var partialJson = partialTxJson{Tx: hex.EncodeToString(buf.Bytes())}
+	for _, input := range p.Inputs {
+		var inputJson = partialInputJson{
+			PkScript:     hex.EncodeToString(input.PkScript),
+			Value:        input.Value,
+			RedeemScript: hex.EncodeToString(input.RedeemScript),
+		}
+		for _, signature := range input.Signatures {
+			inputJson.Signatures = append(inputJson.Signatures, partialSignatureJson{
+				PublicKey: hex.EncodeToString(signature.PublicKey),
+				Signature: hex.EncodeToString(signature.Signature),
+			})
+		}
+		partialJson.Inputs = append(partialJson.Inputs, inputJson)
+	}
+	data, err := json.Marshal(partialJson)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling partial tx json; %w", err)
+	}
+	return data, nil
+}
+
+// ParsePartialTx decodes a serialized partial tx, verifying any signatures.
+func ParsePartialTx(data []byte) (*PartialTx, error) {
+	var partialJson partialTxJson
+	if err := json.Unmarshal(data, &partialJson); err != nil {
+		return nil, fmt.Errorf("error unmarshalling partial tx json; %w", err)
+	}
+	txRaw, err := hex.DecodeString(partialJson.Tx)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding partial tx wire tx hex; %w", err)
+	}
+	var tx = new(wire.MsgTx)
+	if err := tx.Deserialize(bytes.NewReader(txRaw)); err != nil {
+		return nil, fmt.Errorf("error deserializing partial tx wire tx; %w", err)
+	}
+	var inputs = make([]*PartialInput, len(partialJson.Inputs))
+	for i, inputJson := range partialJson.Inputs {
+		var input = &PartialInput{Value: inputJson.Value}
+		if input.PkScript, err = hex.DecodeString(inputJson.PkScript); err != nil {
+			return nil, fmt.Errorf("error decoding partial input pk script hex; %w", err)
+		}
+		if input.RedeemScript, err = hex.DecodeString(inputJson.RedeemScript); err != nil {
+			return nil, fmt.Errorf("error decoding partial input redeem script hex; %w", err)
+		}
+		inputs[i] = input
+	}
+	partialTx, err := NewPartialTx(tx, inputs)
+	if err != nil {
+		return nil, fmt.Errorf("error creating parsed partial tx; %w", err)
+	}
+	for i, inputJson := range partialJson.Inputs {
+		for _, signatureJson := range inputJson.Signatures {
+			publicKey, err := hex.DecodeString(signatureJson.PublicKey)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding partial signature public key hex; %w", err)
+			}
+			signature, err := hex.DecodeString(signatureJson.Signature)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding partial signature hex; %w", err)
+			}
+			if err := inputs[i].AddSignature(partialTx.Tx, i, publicKey, signature); err != nil {
+				return nil, fmt.Errorf("error adding parsed partial signature; %w", err)
+			}
+		}
+	}
+	return partialTx, nil
+}
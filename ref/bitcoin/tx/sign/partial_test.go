@@ -0,0 +1,161 @@
+package sign_test
+
+import (
+	"errors"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/tx/gen"
+	"github.com/memocash/index/ref/bitcoin/tx/script"
+	"github.com/memocash/index/ref/bitcoin/tx/sign"
+	"github.com/memocash/index/ref/bitcoin/util/testing/test_tx"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"testing"
+)
+
+func getMultiSigRequest(t *testing.T, keyRing wallet.KeyRing) gen.TxRequest {
+	multiSig, err := wallet.NewMultiSig(2, []wallet.PublicKey{
+		test_tx.Address1key.GetPublicKey(),
+		test_tx.Address2key.GetPublicKey(),
+		test_tx.Address3key.GetPublicKey(),
+	})
+	if err != nil {
+		t.Fatalf("error creating multisig; %v", err)
+	}
+	address, err := multiSig.GetAddress()
+	if err != nil {
+		t.Fatalf("error getting multisig address; %v", err)
+	}
+	redeemScript, err := multiSig.GetRedeemScript()
+	if err != nil {
+		t.Fatalf("error getting multisig redeem script; %v", err)
+	}
+	pkScript, err := script.P2sh{ScriptHash: address.ScriptAddress()}.Get()
+	if err != nil {
+		t.Fatalf("error getting multisig pk script; %v", err)
+	}
+	return gen.TxRequest{
+		InputsToUse: []memo.UTXO{{Input: memo.TxInput{
+			PkScript:     pkScript,
+			PkHash:       address.ScriptAddress(),
+			Value:        100000,
+			PrevOutHash:  test_tx.HashEmptyTx,
+			RedeemScript: redeemScript,
+		}}},
+		Outputs: []*memo.Output{gen.GetAddressOutput(test_tx.Address4, 50000)},
+		Change:  wallet.GetChange(*address),
+		KeyRing: keyRing,
+	}
+}
+
+func TestPartialMultiSig(t *testing.T) {
+	partialTx, err := gen.TxPartial(getMultiSigRequest(t, wallet.GetSingleKeyRing(test_tx.Address1key)))
+	if err != nil {
+		t.Fatalf("error creating partial tx; %v", err)
+	}
+	if partialTx.IsComplete() {
+		t.Fatal("partial tx complete with 1 of 2 signatures")
+	}
+	if _, err := partialTx.GetSigned(); !errors.Is(err, sign.NotEnoughSignaturesError) {
+		t.Errorf("expected not enough signatures error; %v", err)
+	}
+	serialized, err := partialTx.Serialize()
+	if err != nil {
+		t.Fatalf("error serializing partial tx; %v", err)
+	}
+	cosignerTx, err := sign.ParsePartialTx(serialized)
+	if err != nil {
+		t.Fatalf("error parsing partial tx; %v", err)
+	}
+	if err := cosignerTx.Sign(wallet.GetSingleKeyRing(test_tx.Address3key)); err != nil {
+		t.Fatalf("error cosigning partial tx; %v", err)
+	}
+	if err := partialTx.Combine(cosignerTx); err != nil {
+		t.Fatalf("error combining partial txs; %v", err)
+	}
+	if !partialTx.IsComplete() {
+		t.Fatal("partial tx not complete after combining")
+	}
+	signedTx, err := partialTx.GetSigned()
+	if err != nil {
+		t.Fatalf("error getting signed partial tx; %v", err)
+	}
+	var size = int64(signedTx.SerializeSize())
+	var fee = partialTx.Inputs[0].Value
+	for _, txOut := range signedTx.TxOut {
+		fee -= txOut.Value
+	}
+	if fee < size {
+		t.Errorf("fee %d below signed tx size %d", fee, size)
+	}
+}
+
+func TestPartialCombineInvalid(t *testing.T) {
+	partialTx, err := gen.TxPartial(getMultiSigRequest(t, wallet.KeyRing{}))
+	if err != nil {
+		t.Fatalf("error creating partial tx; %v", err)
+	}
+	otherTx, err := gen.TxPartial(getMultiSigRequest(t, wallet.GetSingleKeyRing(test_tx.Address2key)))
+	if err != nil {
+		t.Fatalf("error creating other partial tx; %v", err)
+	}
+	otherTx.Inputs[0].Signatures[0].Signature[10]++
+	if err := partialTx.Combine(otherTx); !errors.Is(err, sign.InvalidSignatureError) {
+		t.Errorf("expected invalid signature error combining; %v", err)
+	}
+	otherTx.Tx.TxOut[0].Value++
+	if err := partialTx.Combine(otherTx); !errors.Is(err, sign.TxMismatchError) {
+		t.Errorf("expected tx mismatch error combining; %v", err)
+	}
+}
+
+func TestSignMultiSig(t *testing.T) {
+	memoTx, err := gen.Tx(getMultiSigRequest(t, wallet.KeyRing{Keys: []wallet.PrivateKey{
+		test_tx.Address2key,
+		test_tx.Address3key,
+	}}))
+	if err != nil {
+		t.Fatalf("error generating multisig tx; %v", err)
+	}
+	if err := sign.VerifyWithOutputs(memoTx.MsgTx, []*sign.Output{{
+		PkScript: memoTx.Inputs[0].PkScript,
+		Value:    memoTx.Inputs[0].Value,
+	}}); err != nil {
+		t.Errorf("error verifying multisig tx; %v", err)
+	}
+	if _, err := gen.Tx(getMultiSigRequest(t, wallet.GetSingleKeyRing(test_tx.Address2key))); !errors.Is(err,
+		sign.NotEnoughSignaturesError) {
+		t.Errorf("expected not enough signatures error for single key; %v", err)
+	}
+}
+
+func TestPartialRedeemScriptMismatch(t *testing.T) {
+	partialTx, err := gen.TxPartial(getMultiSigRequest(t, wallet.KeyRing{}))
+	if err != nil {
+		t.Fatalf("error creating partial tx; %v", err)
+	}
+	otherMultiSig, err := wallet.NewMultiSig(1, []wallet.PublicKey{
+		test_tx.Address1key.GetPublicKey(),
+		test_tx.Address2key.GetPublicKey(),
+	})
+	if err != nil {
+		t.Fatalf("error creating other multisig; %v", err)
+	}
+	otherRedeemScript, err := otherMultiSig.GetRedeemScript()
+	if err != nil {
+		t.Fatalf("error getting other multisig redeem script; %v", err)
+	}
+	partialTx.Inputs[0].RedeemScript = otherRedeemScript
+	if err := partialTx.Sign(wallet.GetSingleKeyRing(test_tx.Address1key)); !errors.Is(err,
+		sign.RedeemScriptMismatchError) {
+		t.Errorf("expected redeem script mismatch error signing; %v", err)
+	}
+	if _, err := partialTx.Inputs[0].GetSignatureScript(); !errors.Is(err, sign.RedeemScriptMismatchError) {
+		t.Errorf("expected redeem script mismatch error getting signature script; %v", err)
+	}
+	serialized, err := partialTx.Serialize()
+	if err != nil {
+		t.Fatalf("error serializing partial tx; %v", err)
+	}
+	if _, err := sign.ParsePartialTx(serialized); !errors.Is(err, sign.RedeemScriptMismatchError) {
+		t.Errorf("expected redeem script mismatch error parsing; %v", err)
+	}
+}
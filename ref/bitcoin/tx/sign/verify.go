@@ -2,6 +2,7 @@ package sign
 
 import (
 	"fmt"
+	"github.com/jchavannes/btcd/btcec"
 	"github.com/jchavannes/btcd/txscript"
 	"github.com/jchavannes/btcd/wire"
 )
@@ -64,3 +65,28 @@ func VerifySignature(pkScript []byte, spendTx *wire.MsgTx, index int, amount int
 	}
 	return nil
 }
+
+// VerifyInputSignature checks a signature with its sighash type byte for an input, subScript is the redeem script for
+// P2SH inputs and the pk script otherwise.
+func VerifyInputSignature(tx *wire.MsgTx, index int, subScript []byte, value int64, publicKey, signature []byte) error {
+	if len(signature) == 0 {
+		return fmt.Errorf("error empty signature")
+	}
+	hashType := txscript.SigHashType(signature[len(signature)-1])
+	hash, err := txscript.CalcSignatureHash(subScript, txscript.NewTxSigHashes(tx), hashType, tx, index, value, true)
+	if err != nil {
+		return fmt.Errorf("error calculating signature hash for input: %d; %w", index, err)
+	}
+	sig, err := btcec.ParseDERSignature(signature[:len(signature)-1], btcec.S256())
+	if err != nil {
+		return fmt.Errorf("error parsing signature for input: %d; %w", index, err)
+	}
+	pubKey, err := btcec.ParsePubKey(publicKey, btcec.S256())
+	if err != nil {
+		return fmt.Errorf("error parsing public key for input: %d; %w", index, err)
+	}
+	if !sig.Verify(hash, pubKey) {
+		return fmt.Errorf("error signature does not verify for input: %d; %w", index, InvalidSignatureError)
+	}
+	return nil
+}
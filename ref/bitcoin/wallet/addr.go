@@ -15,10 +15,19 @@ const (
 	AddrVersionP2SH  = 5
 )
 
+// Addr is a legacy address, always stored with mainnet version bytes so indexed addresses don't depend on network.
 type Addr [25]byte
 
+// String encodes the address with the version byte of the configured network.
 func (a Addr) String() string {
-	return base58.Encode(a[:])
+	if GetNetwork() == NetworkMainnet {
+		return base58.Encode(a[:])
+	}
+	version := GetNetParams().PubKeyHashAddrID
+	if a.IsP2SH() {
+		version = GetNetParams().ScriptHashAddrID
+	}
+	return base58.CheckEncode(a.fingerPrint(), version)
 }
 
 func (a Addr) IsP2SH() bool {
@@ -60,6 +69,21 @@ func GetAddrFromString(addrString string) (*Addr, error) {
 		return nil, fmt.Errorf("error decoding base58 address, invalid address length found")
 	}
 	copy(addr[:], d)
+	if GetNetwork() == NetworkMainnet {
+		return addr, nil
+	}
+	if !bytes.Equal(chainhash.DoubleHashB(addr[0:21])[:4], addr[21:]) {
+		return nil, fmt.Errorf("error decoding base58 address, invalid checksum")
+	}
+	switch addr[0] {
+	case GetNetParams().PubKeyHashAddrID:
+		addr[0] = AddrVersionP2PKH
+	case GetNetParams().ScriptHashAddrID:
+		addr[0] = AddrVersionP2SH
+	default:
+		return nil, fmt.Errorf("error decoding base58 address, version not for network: %d", addr[0])
+	}
+	copy(addr[21:], chainhash.DoubleHashB(addr[0:21])[:4])
 	return addr, nil
 }
 
@@ -24,12 +24,12 @@ func GetAddress(pubKey []byte) Address {
 	if len(pubKey) == 0 {
 		return Address{}
 	}
-	addr, err := btcutil.NewAddressPubKey(pubKey, GetMainNetParams())
+	addr, err := btcutil.NewAddressPubKey(pubKey, GetNetParams())
 	if err != nil {
 		//fmt.Println(fmt.Errorf("error getting address; %w", err))
 		return Address{}
 	}
-	address, err := btcutil.DecodeAddress(addr.EncodeAddress(), GetMainNetParams())
+	address, err := btcutil.DecodeAddress(addr.EncodeAddress(), GetNetParams())
 	if err != nil {
 		//fmt.Printf("error decoding address: %v\n", err)
 		return Address{}
@@ -50,26 +50,33 @@ func GetAddressFromString(addressString string) Address {
 	return *address
 }
 
+// GetSlpAddrPrefix returns the SLP address prefix of the configured network, e.g. slptest on chipnet.
+func GetSlpAddrPrefix() string {
+	if prefix, ok := bchutil.SlpPrefixes[GetNetParams().Name]; ok {
+		return prefix
+	}
+	return SlpAddrPrefix
+}
+
 func GetAddressFromStringErr(addressString string) (*Address, error) {
-	address, err := btcutil.DecodeAddress(addressString, GetMainNetParams())
+	address, err := btcutil.DecodeAddress(addressString, GetNetParams())
 	if err != nil {
 		if len(addressString) > 0 {
-			address, err = bchutil.DecodeAddress(addressString, GetMainNetParams())
+			address, err = bchutil.DecodeAddress(addressString, GetNetParams())
 			if err != nil && !strings.Contains(addressString, ":") {
-				address, err = bchutil.DecodeAddress(SlpAddrPrefix+":"+addressString, GetMainNetParams())
+				address, err = bchutil.DecodeAddress(GetSlpAddrPrefix()+":"+addressString, GetNetParams())
 			}
 		}
 		if err != nil {
 			return nil, fmt.Errorf("error decoding address: %s; %w", addressString, err)
 		}
-		if strings.HasPrefix(addressString, "p") || strings.HasPrefix(addressString, "simpleledger:p") ||
-			strings.HasPrefix(addressString, "bitcoincash:p") || strings.HasPrefix(addressString, "bitcoin:p") {
-			address, err = btcutil.NewAddressScriptHashFromHash(address.ScriptAddress(), GetMainNetParams())
+		if strings.HasPrefix(addressString[strings.Index(addressString, ":")+1:], "p") {
+			address, err = btcutil.NewAddressScriptHashFromHash(address.ScriptAddress(), GetNetParams())
 			if err != nil {
 				return nil, fmt.Errorf("error getting p2sh address: %s; %w", addressString, err)
 			}
 		} else {
-			address, err = btcutil.NewAddressPubKeyHash(address.ScriptAddress(), GetMainNetParams())
+			address, err = btcutil.NewAddressPubKeyHash(address.ScriptAddress(), GetNetParams())
 			if err != nil {
 				return nil, fmt.Errorf("error getting btc address from bch address: %s; %w", addressString, err)
 			}
@@ -81,12 +88,12 @@ func GetAddressFromStringErr(addressString string) (*Address, error) {
 }
 
 func GetAddressFromPkHash(pkHash []byte) Address {
-	addr, err := btcutil.NewAddressPubKeyHash(pkHash, GetMainNetParams())
+	addr, err := btcutil.NewAddressPubKeyHash(pkHash, GetNetParams())
 	if err != nil {
 		//fmt.Println(fmt.Errorf("error getting address; %w", err))
 		return Address{}
 	}
-	address, err := btcutil.DecodeAddress(addr.EncodeAddress(), GetMainNetParams())
+	address, err := btcutil.DecodeAddress(addr.EncodeAddress(), GetNetParams())
 	if err != nil {
 		//fmt.Printf("error decoding address: %v\n", err)
 		return Address{}
@@ -97,11 +104,11 @@ func GetAddressFromPkHash(pkHash []byte) Address {
 }
 
 func GetAddressFromPkHashNew(pkHash []byte) (Address, error) {
-	addr, err := btcutil.NewAddressPubKeyHash(pkHash, GetMainNetParams())
+	addr, err := btcutil.NewAddressPubKeyHash(pkHash, GetNetParams())
 	if err != nil {
 		return Address{}, fmt.Errorf("error getting address; %w", err)
 	}
-	address, err := btcutil.DecodeAddress(addr.EncodeAddress(), GetMainNetParams())
+	address, err := btcutil.DecodeAddress(addr.EncodeAddress(), GetNetParams())
 	if err != nil {
 		return Address{}, fmt.Errorf("error decoding address; %w", err)
 	}
@@ -146,7 +153,7 @@ func IsAddressQuantityError(err error) bool {
 }
 
 func GetAddressFromPkScript(pkScript []byte) (*Address, error) {
-	_, addresses, _, err := txscript.ExtractPkScriptAddrs(pkScript, GetMainNetParams())
+	_, addresses, _, err := txscript.ExtractPkScriptAddrs(pkScript, GetNetParams())
 	if err != nil {
 		return nil, fmt.Errorf("error extracting addresses from pk script; %w", err)
 	}
@@ -161,7 +168,7 @@ func GetAddressFromPkScript(pkScript []byte) (*Address, error) {
 }
 
 func GetAddressStringFromPkScript(pkScript []byte) string {
-	scriptClass, addresses, _, err := txscript.ExtractPkScriptAddrs(pkScript, GetMainNetParams())
+	scriptClass, addresses, _, err := txscript.ExtractPkScriptAddrs(pkScript, GetNetParams())
 	if err != nil {
 		return "error: " + scriptClass.String()
 	}
@@ -174,7 +181,7 @@ func GetAddressStringFromPkScript(pkScript []byte) string {
 }
 
 func GetAddressFromRedeemScript(redeemScript []byte) (*Address, error) {
-	address, err := btcutil.NewAddressScriptHash(redeemScript, GetMainNetParams())
+	address, err := btcutil.NewAddressScriptHash(redeemScript, GetNetParams())
 	if err != nil {
 		return nil, fmt.Errorf("error getting address script hash from redeem script; %w", err)
 	}
@@ -192,7 +199,7 @@ func GetAddressFromScriptHash(scriptHash []byte) Address {
 }
 
 func GetAddressFromScriptHashNew(scriptHash []byte) (*Address, error) {
-	address, err := btcutil.NewAddressScriptHashFromHash(scriptHash, GetMainNetParams())
+	address, err := btcutil.NewAddressScriptHashFromHash(scriptHash, GetNetParams())
 	if err != nil {
 		return nil, fmt.Errorf("error getting address script hash from hash; %w", err)
 	}
@@ -252,12 +259,12 @@ func (a Address) GetCashAddrString() string {
 		return ""
 	}
 	if a.IsP2SH() {
-		cashAddr, err := bchutil.NewCashAddressScriptHashFromHash(a.GetPkHash(), GetMainNetParams())
+		cashAddr, err := bchutil.NewCashAddressScriptHashFromHash(a.GetPkHash(), GetNetParams())
 		if err == nil {
 			return cashAddr.String()
 		}
 	} else {
-		cashAddr, err := bchutil.NewCashAddressPubKeyHash(a.GetPkHash(), GetMainNetParams())
+		cashAddr, err := bchutil.NewCashAddressPubKeyHash(a.GetPkHash(), GetNetParams())
 		if err == nil {
 			return cashAddr.String()
 		}
@@ -269,17 +276,17 @@ func (a Address) GetSlpAddrString() string {
 	var addr string
 	if a.address != nil {
 		if a.IsP2SH() {
-			slpAddr, err := bchutil.NewSlpAddressScriptHashFromHash(a.GetPkHash(), GetMainNetParams())
+			slpAddr, err := bchutil.NewSlpAddressScriptHashFromHash(a.GetPkHash(), GetNetParams())
 			if err == nil {
 				addr = slpAddr.String()
 			}
 		} else {
-			slpAddr, err := bchutil.NewSlpAddressPubKeyHash(a.GetPkHash(), GetMainNetParams())
+			slpAddr, err := bchutil.NewSlpAddressPubKeyHash(a.GetPkHash(), GetNetParams())
 			if err == nil {
 				addr = slpAddr.String()
 			}
 		}
-		addr = strings.TrimPrefix(addr, SlpAddrPrefix+":")
+		addr = strings.TrimPrefix(addr, GetSlpAddrPrefix()+":")
 	}
 	return addr
 }
@@ -297,7 +304,11 @@ func (a Address) GetPkHash() []byte {
 }
 
 func (a Address) GetAddr() Addr {
-	b := append([]byte{0x00}, a.GetPkHash()...)
+	var version byte = AddrVersionP2PKH
+	if a.IsP2SH() {
+		version = AddrVersionP2SH
+	}
+	b := append([]byte{version}, a.GetPkHash()...)
 	var r [25]byte
 	copy(r[:], append(b, chainhash.DoubleHashB(b)[:4]...))
 	return r
@@ -10,7 +10,15 @@ type Block struct {
 var _genesisBlock *Block
 var _firstBlock *Block
 
+// GetGenesisBlock returns the genesis block of the configured network.
 func GetGenesisBlock() Block {
+	if GetNetwork() != NetworkMainnet {
+		params := GetNetParams()
+		return Block{
+			Hash:       params.GenesisHash,
+			MerkleRoot: &params.GenesisBlock.Header.MerkleRoot,
+		}
+	}
 	if _genesisBlock == nil {
 		hash, _ := chainhash.NewHashFromStr("000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f")
 		merkleRoot, _ := chainhash.NewHashFromStr("4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b")
@@ -4,7 +4,6 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
-	"github.com/jchavannes/btcd/chaincfg"
 	"github.com/jchavannes/btcutil/hdkeychain"
 	"github.com/jchavannes/go-mnemonic/bip39"
 	"github.com/memocash/index/ref/bitcoin/util"
@@ -26,7 +25,7 @@ func (m Mnemonic) IsSet() bool {
 func (m *Mnemonic) GetPathExtended(path string) (*hdkeychain.ExtendedKey, error) {
 	sentence, err := m.Mnemonic.GetSentence()
 	seed := bip39.NewSeed(sentence, "")
-	masterKey, err := hdkeychain.NewMaster(seed, &chaincfg.MainNetParams)
+	masterKey, err := hdkeychain.NewMaster(seed, GetNetParams())
 	if err != nil {
 		return nil, fmt.Errorf("error getting master key from mnemonic; %w", err)
 	}
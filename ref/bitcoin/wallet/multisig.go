@@ -0,0 +1,107 @@
+package wallet
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/jchavannes/btcd/txscript"
+	"sort"
+)
+
+// MaxMultiSigKeys keeps the redeem script of compressed keys within the 520 byte push limit.
+const MaxMultiSigKeys = 15
+
+type MultiSig struct {
+	Required   int
+	PublicKeys []PublicKey
+}
+
+// NewMultiSig creates an m-of-n multisig with the public keys sorted (BIP67) so cosigners derive the same address
+// regardless of key order.
+func NewMultiSig(required int, publicKeys []PublicKey) (*MultiSig, error) {
+	if len(publicKeys) == 0 || len(publicKeys) > MaxMultiSigKeys {
+		return nil, fmt.Errorf("error invalid multisig public key count: %d (max: %d)", len(publicKeys), MaxMultiSigKeys)
+	}
+	if required < 1 || required > len(publicKeys) {
+		return nil, fmt.Errorf("error invalid multisig required signatures: %d of %d", required, len(publicKeys))
+	}
+	var sorted = make([]PublicKey, len(publicKeys))
+	copy(sorted, publicKeys)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].GetSerialized(), sorted[j].GetSerialized()) < 0
+	})
+	for i := 1; i < len(sorted); i++ {
+		if bytes.Equal(sorted[i-1].GetSerialized(), sorted[i].GetSerialized()) {
+			return nil, fmt.Errorf("error duplicate multisig public key: %s", sorted[i].GetSerializedString())
+		}
+	}
+	return &MultiSig{
+		Required:   required,
+		PublicKeys: sorted,
+	}, nil
+}
+
+// GetMultiSigFromRedeemScript parses a multisig redeem script, keeping the script's key order.
+func GetMultiSigFromRedeemScript(redeemScript []byte) (*MultiSig, error) {
+	if txscript.GetScriptClass(redeemScript) != txscript.MultiSigTy {
+		return nil, fmt.Errorf("error redeem script is not multisig")
+	}
+	_, required, err := txscript.CalcMultiSigStats(redeemScript)
+	if err != nil {
+		return nil, fmt.Errorf("error getting multisig stats from redeem script; %w", err)
+	}
+	pushes, err := txscript.PushedData(redeemScript)
+	if err != nil {
+		return nil, fmt.Errorf("error getting pushed data from multisig redeem script; %w", err)
+	}
+	var multiSig = &MultiSig{Required: required}
+	for _, push := range pushes {
+		if len(push) < 33 {
+			// Small int pushes for the key counts
+			continue
+		}
+		publicKey, err := GetPublicKey(push)
+		if err != nil {
+			return nil, fmt.Errorf("error getting multisig public key from redeem script; %w", err)
+		}
+		multiSig.PublicKeys = append(multiSig.PublicKeys, publicKey)
+	}
+	return multiSig, nil
+}
+
+func (m MultiSig) GetRedeemScript() ([]byte, error) {
+	builder := txscript.NewScriptBuilder().AddInt64(int64(m.Required))
+	for _, publicKey := range m.PublicKeys {
+		builder.AddData(publicKey.GetSerialized())
+	}
+	redeemScript, err := builder.
+		AddInt64(int64(len(m.PublicKeys))).
+		AddOp(txscript.OP_CHECKMULTISIG).
+		Script()
+	if err != nil {
+		return nil, fmt.Errorf("error building multisig redeem script; %w", err)
+	}
+	return redeemScript, nil
+}
+
+// GetAddress returns the P2SH address of the redeem script.
+func (m MultiSig) GetAddress() (*Address, error) {
+	redeemScript, err := m.GetRedeemScript()
+	if err != nil {
+		return nil, fmt.Errorf("error getting redeem script for multisig address; %w", err)
+	}
+	address, err := GetAddressFromRedeemScript(redeemScript)
+	if err != nil {
+		return nil, fmt.Errorf("error getting multisig address from redeem script; %w", err)
+	}
+	return address, nil
+}
+
+// GetKeyIndex returns the index of the public key in the multisig, -1 if not found.
+func (m MultiSig) GetKeyIndex(publicKey []byte) int {
+	for i := range m.PublicKeys {
+		if bytes.Equal(m.PublicKeys[i].GetSerialized(), publicKey) {
+			return i
+		}
+	}
+	return -1
+}
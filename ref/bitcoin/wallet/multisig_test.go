@@ -0,0 +1,64 @@
+package wallet_test
+
+import (
+	"bytes"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"testing"
+)
+
+func getTestPublicKeys(t *testing.T, wifs ...string) []wallet.PublicKey {
+	var publicKeys []wallet.PublicKey
+	for _, wif := range wifs {
+		key, err := wallet.ImportPrivateKey(wif)
+		if err != nil {
+			t.Fatalf("error importing test key; %v", err)
+		}
+		publicKeys = append(publicKeys, key.GetPublicKey())
+	}
+	return publicKeys
+}
+
+func TestMultiSig(t *testing.T) {
+	publicKeys := getTestPublicKeys(t,
+		"L4y4WGjmK9pJSqPL34voH4KqQzZn2RnW1tgsGTKuWPkphzNbdVHu",
+		"Kz79JZc5eiXAgyo6yThGVJbxbXmAXCQ7awt6LvUKwo1AQuwYPups",
+		"L49uYMVKno5qLAvJKrgfkZpjWdqAfwdSqwz565djym7J1HeRyX8F")
+	multiSig, err := wallet.NewMultiSig(2, publicKeys)
+	if err != nil {
+		t.Fatalf("error creating multisig; %v", err)
+	}
+	reversed, err := wallet.NewMultiSig(2, []wallet.PublicKey{publicKeys[2], publicKeys[1], publicKeys[0]})
+	if err != nil {
+		t.Fatalf("error creating reversed multisig; %v", err)
+	}
+	address, err := multiSig.GetAddress()
+	if err != nil {
+		t.Fatalf("error getting multisig address; %v", err)
+	}
+	reversedAddress, err := reversed.GetAddress()
+	if err != nil {
+		t.Fatalf("error getting reversed multisig address; %v", err)
+	}
+	if !address.IsP2SH() || address.GetEncoded() != reversedAddress.GetEncoded() {
+		t.Errorf("multisig addresses not p2sh or do not match for key order: %s %s",
+			address.GetEncoded(), reversedAddress.GetEncoded())
+	}
+	redeemScript, err := multiSig.GetRedeemScript()
+	if err != nil {
+		t.Fatalf("error getting redeem script; %v", err)
+	}
+	parsed, err := wallet.GetMultiSigFromRedeemScript(redeemScript)
+	if err != nil {
+		t.Fatalf("error parsing redeem script; %v", err)
+	}
+	parsedRedeemScript, _ := parsed.GetRedeemScript()
+	if parsed.Required != 2 || len(parsed.PublicKeys) != 3 || !bytes.Equal(parsedRedeemScript, redeemScript) {
+		t.Errorf("parsed multisig does not match (required: %d, keys: %d)", parsed.Required, len(parsed.PublicKeys))
+	}
+	if _, err := wallet.NewMultiSig(4, publicKeys); err == nil {
+		t.Error("expected error for more required signatures than keys")
+	}
+	if _, err := wallet.NewMultiSig(2, []wallet.PublicKey{publicKeys[0], publicKeys[0]}); err == nil {
+		t.Error("expected error for duplicate keys")
+	}
+}
@@ -1,11 +1,38 @@
 package wallet
 
 import (
+	"fmt"
 	"github.com/jchavannes/bchutil"
 	"github.com/jchavannes/btcd/chaincfg"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
 	"github.com/jchavannes/btcd/txscript"
+	"github.com/jchavannes/btcd/wire"
+	"sync"
+	"time"
 )
 
+const (
+	NetworkMainnet = "mainnet"
+	NetworkTestnet = "testnet"
+	NetworkChipnet = "chipnet"
+	NetworkRegtest = "regtest"
+
+	Testnet4Magic wire.BitcoinNet = 0xafdab7e2
+
+	testnet4GenesisHash      = "000000001dd410c49a788668ce26751718cc797474d3152a5fc073dd44fd9f7b"
+	testnet4GenesisTimestamp = 1597811185
+	testnet4GenesisNonce     = 114152193
+)
+
+// getTestnet4GenesisBlock returns the testnet4 genesis block, also used by chipnet. It has the same coinbase tx and
+// merkle root as the mainnet and testnet3 genesis blocks with a different time and nonce.
+func getTestnet4GenesisBlock() *wire.MsgBlock {
+	genesisBlock := *chaincfg.TestNet3Params.GenesisBlock
+	genesisBlock.Header.Timestamp = time.Unix(testnet4GenesisTimestamp, 0)
+	genesisBlock.Header.Nonce = testnet4GenesisNonce
+	return &genesisBlock
+}
+
 var _mainNetParams *chaincfg.Params
 
 func GetMainNetParams() *chaincfg.Params {
@@ -17,6 +44,57 @@ func GetMainNetParams() *chaincfg.Params {
 	return _mainNetParams
 }
 
+var _network = NetworkMainnet
+var _netParams map[string]*chaincfg.Params
+var _netParamsOnce sync.Once
+
+func getNetParams(network string) (*chaincfg.Params, bool) {
+	_netParamsOnce.Do(func() {
+		testNet := chaincfg.TestNet3Params
+		testNet.Net = bchutil.TestnetMagic
+		chipNet := chaincfg.TestNet3Params
+		chipNet.Name = NetworkChipnet
+		chipNet.Net = Testnet4Magic
+		chipNet.DefaultPort = "48333"
+		chipNet.GenesisBlock = getTestnet4GenesisBlock()
+		chipNet.GenesisHash, _ = chainhash.NewHashFromStr(testnet4GenesisHash)
+		regTest := chaincfg.RegressionNetParams
+		regTest.Net = bchutil.Regtestmagic
+		bchutil.Prefixes[chipNet.Name] = "bchtest"
+		bchutil.SlpPrefixes[chipNet.Name] = "slptest"
+		bchutil.SlpPrefixes[regTest.Name] = "slpreg"
+		_netParams = map[string]*chaincfg.Params{
+			NetworkMainnet: GetMainNetParams(),
+			NetworkTestnet: &testNet,
+			NetworkChipnet: &chipNet,
+			NetworkRegtest: &regTest,
+		}
+	})
+	params, ok := _netParams[network]
+	return params, ok
+}
+
+// GetNetParams returns the chain params of the configured network, used for address encoding and peers.
+func GetNetParams() *chaincfg.Params {
+	params, _ := getNetParams(_network)
+	return params
+}
+
+func GetNetwork() string {
+	return _network
+}
+
+func SetNetwork(network string) error {
+	if network == "" {
+		network = NetworkMainnet
+	}
+	if _, ok := getNetParams(network); !ok {
+		return fmt.Errorf("error unknown network: %s", network)
+	}
+	_network = network
+	return nil
+}
+
 const SigHashForkID txscript.SigHashType = 0x40
 
 const (
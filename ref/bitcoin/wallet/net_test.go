@@ -0,0 +1,93 @@
+package wallet_test
+
+import (
+	"fmt"
+	"github.com/jchavannes/btcd/chaincfg"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"strings"
+	"testing"
+)
+
+func TestRegtestAddr(t *testing.T) {
+	const mainnetAddress = "1QCBiyfwdjXDsHghBEr5U2KxUpM2BmmJVt"
+	mainnetAddr, err := wallet.GetAddrFromString(mainnetAddress)
+	if err != nil {
+		t.Fatal(fmt.Errorf("error parsing mainnet addr; %w", err))
+	}
+	if err := wallet.SetNetwork(wallet.NetworkRegtest); err != nil {
+		t.Fatal(fmt.Errorf("error setting regtest network; %w", err))
+	}
+	defer wallet.SetNetwork(wallet.NetworkMainnet)
+	regtestAddress := mainnetAddr.String()
+	if !strings.HasPrefix(regtestAddress, "m") && !strings.HasPrefix(regtestAddress, "n") {
+		t.Errorf("regtest addr unexpected encoding: %s", regtestAddress)
+	}
+	regtestAddr, err := wallet.GetAddrFromString(regtestAddress)
+	if err != nil {
+		t.Fatal(fmt.Errorf("error parsing regtest addr; %w", err))
+	}
+	if *regtestAddr != *mainnetAddr {
+		t.Errorf("regtest addr does not match mainnet addr: %x %x", regtestAddr[:], mainnetAddr[:])
+	}
+	if _, err := wallet.GetAddrFromString(mainnetAddress); err == nil {
+		t.Errorf("expected error parsing mainnet address on regtest")
+	}
+	cashAddr := regtestAddr.OldAddress().GetCashAddrString()
+	if !strings.HasPrefix(cashAddr, "bchreg:") {
+		t.Errorf("regtest cash addr unexpected prefix: %s", cashAddr)
+	}
+	address, err := wallet.GetAddressFromStringErr(cashAddr)
+	if err != nil {
+		t.Fatal(fmt.Errorf("error parsing regtest cash addr; %w", err))
+	}
+	if address.GetAddr() != *mainnetAddr {
+		t.Errorf("regtest cash addr does not match mainnet addr: %s", cashAddr)
+	}
+}
+
+func TestChipnetGenesis(t *testing.T) {
+	if err := wallet.SetNetwork(wallet.NetworkChipnet); err != nil {
+		t.Fatal(fmt.Errorf("error setting chipnet network; %w", err))
+	}
+	defer wallet.SetNetwork(wallet.NetworkMainnet)
+	params := wallet.GetNetParams()
+	if blockHash := params.GenesisBlock.BlockHash(); blockHash != *params.GenesisHash {
+		t.Errorf("chipnet genesis block hash %s does not match genesis hash %s", blockHash, params.GenesisHash)
+	}
+	if merkleRoot := params.GenesisBlock.Transactions[0].TxHash(); merkleRoot != params.GenesisBlock.Header.MerkleRoot {
+		t.Errorf("chipnet genesis merkle root %s does not match coinbase tx %s",
+			params.GenesisBlock.Header.MerkleRoot, merkleRoot)
+	}
+	if params.GenesisBlock == chaincfg.TestNet3Params.GenesisBlock ||
+		*chaincfg.TestNet3Params.GenesisHash == *params.GenesisHash {
+		t.Errorf("chipnet genesis block uses testnet3 genesis")
+	}
+}
+
+func TestChipnetSlpAddress(t *testing.T) {
+	const (
+		slpAddress    = "qrlxs6um926cng7txd5dqgs3egdfhz92gguyuavv99"
+		legacyAddress = "n4i922kvSkxUeQAJtopTHwYHLowj7pHZqL"
+	)
+	if err := wallet.SetNetwork(wallet.NetworkChipnet); err != nil {
+		t.Fatal(fmt.Errorf("error setting chipnet network; %w", err))
+	}
+	defer wallet.SetNetwork(wallet.NetworkMainnet)
+	if prefix := wallet.GetSlpAddrPrefix(); prefix != "slptest" {
+		t.Errorf("unexpected chipnet slp prefix: %s", prefix)
+	}
+	for _, inputAddress := range []string{slpAddress, "slptest:" + slpAddress} {
+		address, err := wallet.GetAddressFromStringErr(inputAddress)
+		if err != nil {
+			t.Error(fmt.Errorf("error parsing chipnet slp address: %s; %w", inputAddress, err))
+			continue
+		}
+		if address.GetEncoded() != legacyAddress {
+			t.Errorf("chipnet slp address %s legacy address (%s) doesn't match expected (%s)",
+				inputAddress, address.GetEncoded(), legacyAddress)
+		}
+		if address.GetSlpAddrString() != slpAddress {
+			t.Errorf("chipnet slp address (%s) doesn't match expected (%s)", address.GetSlpAddrString(), slpAddress)
+		}
+	}
+}
@@ -56,11 +56,11 @@ func (k PrivateKey) GetBinaryString() string {
 }
 
 func (k PrivateKey) GetBase58() string {
-	return base58.CheckEncode(k.Secret, 128)
+	return base58.CheckEncode(k.Secret, GetNetParams().PrivateKeyID)
 }
 
 func (k PrivateKey) GetBase58Compressed() string {
-	return base58.CheckEncode(append(k.Secret, 0x01), 128)
+	return base58.CheckEncode(append(k.Secret, 0x01), GetNetParams().PrivateKeyID)
 }
 
 func (k PrivateKey) GetHex() string {
@@ -0,0 +1,387 @@
+package lead
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/jchavannes/jgo/jfmt"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/pow"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"github.com/memocash/index/ref/config"
+	"github.com/memocash/index/ref/dbi"
+	"io"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	ImportDefaultParallel = 8
+	importProgressBlocks  = 100
+	importMaxBlockSize    = 256 * 1024 * 1024
+)
+
+var blkFileRegex = regexp.MustCompile(`^blk\d+\.dat$`)
+
+type importBlockLocation struct {
+	File   string
+	Offset int64
+	Size   int64
+	Hex    bool
+	Header wire.BlockHeader
+}
+
+type importResult struct {
+	Block *wire.MsgBlock
+	Err   error
+}
+
+// Import loads blocks from node blk*.dat files or a directory of raw serialized blocks, ordered by the header chain
+// starting after INIT_BLOCK_PARENT. Up to Parallel blocks are read ahead and blocks are saved with the sync pipeline,
+// so shard txs for up to the SYNC PARALLEL config blocks are saved concurrently. Progress is saved to the import sync
+// status so a run can be resumed.
+type Import struct {
+	Processor *Processor
+	Dir       string
+	Parallel  int
+	Restart   bool
+	Blocks    int
+	Txs       int
+	locations map[chainhash.Hash]*importBlockLocation
+	children  map[chainhash.Hash][]chainhash.Hash
+	readAhead chan struct{}
+	errorMu   sync.Mutex
+	err       error
+}
+
+func (i *Import) Run() error {
+	if err := i.Processor.ConnectClients(); err != nil {
+		return fmt.Errorf("error connecting cluster clients for import; %w", err)
+	}
+	go func() {
+		for err := range i.Processor.ErrorChan {
+			i.setError(err)
+		}
+	}()
+	if err := i.indexDir(); err != nil {
+		return fmt.Errorf("error indexing import dir; %w", err)
+	}
+	chain, err := i.getBestChain()
+	if err != nil {
+		return fmt.Errorf("error getting best chain for import; %w", err)
+	}
+	startHeight := int64(config.GetInitBlockHeight())
+	var skip int
+	if !i.Restart {
+		syncStatus, err := item.GetSyncStatus(item.SyncStatusImport)
+		if err != nil && !client.IsEntryNotFoundError(err) {
+			return fmt.Errorf("error getting import sync status; %w", err)
+		}
+		if syncStatus != nil && syncStatus.Height >= startHeight {
+			skip = int(syncStatus.Height - startHeight + 1)
+		}
+	}
+	if skip > len(chain) {
+		skip = len(chain)
+	}
+	log.Printf("Importing %s blocks (height %d to %d, skipping %s already imported)\n",
+		jfmt.AddCommasInt(len(chain)-skip), startHeight+int64(skip), startHeight+int64(len(chain))-1,
+		jfmt.AddCommasInt(skip))
+	parallel := i.Parallel
+	if parallel <= 0 {
+		parallel = ImportDefaultParallel
+	}
+	i.readAhead = make(chan struct{}, parallel)
+	pipeline := NewPipeline(i.Processor)
+	defer pipeline.Stop()
+	results := i.readBlocks(chain[skip:])
+	for j, resultChan := range results {
+		result := <-resultChan
+		<-i.readAhead
+		if result.Err != nil {
+			return fmt.Errorf("error reading block for import; %w", result.Err)
+		}
+		height := startHeight + int64(skip+j)
+		if err := i.saveBlock(pipeline, dbi.WireBlockToBlock(result.Block)); err != nil {
+			return fmt.Errorf("error saving import block: %s (height %d); %w", result.Block.BlockHash(), height, err)
+		}
+		i.Blocks++
+		i.Txs += len(result.Block.Transactions)
+		if i.Blocks%importProgressBlocks == 0 || j == len(results)-1 {
+			pipeline.Flush()
+			if err := i.getPipelineError(pipeline); err != nil {
+				return fmt.Errorf("error saving import blocks up to: %s (height %d); %w",
+					result.Block.BlockHash(), height, err)
+			}
+			if err := db.Save([]db.Object{&item.SyncStatus{
+				Name:   item.SyncStatusImport,
+				Height: height,
+			}}); err != nil {
+				return fmt.Errorf("error saving import sync status; %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// saveBlock adds a block to the pipeline so shard txs for multiple blocks are saved in parallel, blocks the pipeline
+// can't take are processed directly after earlier blocks are committed.
+func (i *Import) saveBlock(pipeline *Pipeline, block *dbi.Block) error {
+	if pipeline.Add(block) {
+		return nil
+	}
+	pipeline.Flush()
+	if err := i.getPipelineError(pipeline); err != nil {
+		return fmt.Errorf("error with import pipeline; %w", err)
+	}
+	if !i.Processor.ProcessBlock(block, "import") {
+		return fmt.Errorf("error processing import block")
+	}
+	if err := i.getError(); err != nil {
+		return fmt.Errorf("error saving import block shards; %w", err)
+	}
+	pipeline.Reset()
+	return nil
+}
+
+func (i *Import) getPipelineError(pipeline *Pipeline) error {
+	if err := i.getError(); err != nil {
+		return err
+	}
+	if pipeline.Failed() {
+		return fmt.Errorf("error import pipeline failed to save a block")
+	}
+	return nil
+}
+
+func (i *Import) setError(err error) {
+	i.errorMu.Lock()
+	defer i.errorMu.Unlock()
+	if i.err == nil {
+		i.err = err
+	}
+}
+
+func (i *Import) getError() error {
+	i.errorMu.Lock()
+	defer i.errorMu.Unlock()
+	return i.err
+}
+
+// readBlocks reads and deserializes blocks with a bounded number of workers, results are returned in chain order.
+// Each result holds a slot in readAhead until it is processed so waiting blocks don't use unbounded memory.
+func (i *Import) readBlocks(chain []chainhash.Hash) []chan importResult {
+	var results = make([]chan importResult, len(chain))
+	for j := range results {
+		results[j] = make(chan importResult, 1)
+	}
+	go func() {
+		for j, blockHash := range chain {
+			i.readAhead <- struct{}{}
+			go func(j int, location *importBlockLocation) {
+				block, err := readBlock(location)
+				results[j] <- importResult{Block: block, Err: err}
+			}(j, i.locations[blockHash])
+		}
+	}()
+	return results
+}
+
+func readBlock(location *importBlockLocation) (*wire.MsgBlock, error) {
+	if location.Hex {
+		raw, err := os.ReadFile(location.File)
+		if err != nil {
+			return nil, fmt.Errorf("error reading hex block file; %w", err)
+		}
+		if raw, err = hex.DecodeString(string(bytes.TrimSpace(raw))); err != nil {
+			return nil, fmt.Errorf("error decoding hex block file: %s; %w", location.File, err)
+		}
+		return parseBlock(location, raw)
+	}
+	file, err := os.Open(location.File)
+	if err != nil {
+		return nil, fmt.Errorf("error opening block file; %w", err)
+	}
+	defer file.Close()
+	var raw = make([]byte, location.Size)
+	if _, err := file.ReadAt(raw, location.Offset); err != nil {
+		return nil, fmt.Errorf("error reading block from file: %s; %w", location.File, err)
+	}
+	return parseBlock(location, raw)
+}
+
+func parseBlock(location *importBlockLocation, raw []byte) (*wire.MsgBlock, error) {
+	block, err := memo.GetBlockFromRaw(raw)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing block from file: %s; %w", location.File, err)
+	}
+	return block, nil
+}
+
+func (i *Import) indexDir() error {
+	entries, err := os.ReadDir(i.Dir)
+	if err != nil {
+		return fmt.Errorf("error reading import dir; %w", err)
+	}
+	i.locations = make(map[chainhash.Hash]*importBlockLocation)
+	i.children = make(map[chainhash.Hash][]chainhash.Hash)
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			files = append(files, entry.Name())
+		}
+	}
+	sort.Strings(files)
+	for _, name := range files {
+		path := filepath.Join(i.Dir, name)
+		var locations []*importBlockLocation
+		if blkFileRegex.MatchString(name) {
+			locations, err = indexBlkFile(path)
+		} else if !strings.HasPrefix(name, "rev") && name != "index" {
+			locations, err = indexRawFile(path)
+		}
+		if err != nil {
+			return fmt.Errorf("error indexing import file: %s; %w", name, err)
+		}
+		for _, location := range locations {
+			blockHash := location.Header.BlockHash()
+			if _, ok := i.locations[blockHash]; ok {
+				continue
+			}
+			i.locations[blockHash] = location
+			i.children[location.Header.PrevBlock] = append(i.children[location.Header.PrevBlock], blockHash)
+		}
+	}
+	log.Printf("Indexed %s blocks from %d files\n", jfmt.AddCommasInt(len(i.locations)), len(files))
+	return nil
+}
+
+// indexBlkFile reads the header of each block in a node blk*.dat file, entries are network magic, size, block.
+func indexBlkFile(path string) ([]*importBlockLocation, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening blk file; %w", err)
+	}
+	defer file.Close()
+	magic := uint32(wallet.GetNetParams().Net)
+	var locations []*importBlockLocation
+	var offset int64
+	var prefix = make([]byte, 8)
+	var header = make([]byte, memo.BlockHeaderLength)
+	for {
+		if _, err := file.ReadAt(prefix, offset); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("error reading blk entry prefix; %w", err)
+		}
+		if binary.LittleEndian.Uint32(prefix[:4]) != magic {
+			// Node pre-allocates blk files, remaining space is zero filled.
+			break
+		}
+		size := int64(binary.LittleEndian.Uint32(prefix[4:]))
+		if size < memo.BlockHeaderLength || size > importMaxBlockSize {
+			return nil, fmt.Errorf("error invalid blk entry size: %d (offset %d)", size, offset)
+		}
+		if _, err := file.ReadAt(header, offset+8); err != nil {
+			return nil, fmt.Errorf("error reading blk entry header; %w", err)
+		}
+		blockHeader, err := memo.GetBlockHeaderFromRaw(header)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing blk entry header; %w", err)
+		}
+		locations = append(locations, &importBlockLocation{
+			File:   path,
+			Offset: offset + 8,
+			Size:   size,
+			Header: *blockHeader,
+		})
+		offset += 8 + size
+	}
+	return locations, nil
+}
+
+// indexRawFile indexes a file containing a single serialized block, either binary or hex encoded.
+func indexRawFile(path string) ([]*importBlockLocation, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading raw block file; %w", err)
+	}
+	var isHex bool
+	if decoded, err := hex.DecodeString(string(bytes.TrimSpace(raw))); err == nil {
+		raw = decoded
+		isHex = true
+	}
+	if len(raw) < memo.BlockHeaderLength {
+		return nil, nil
+	}
+	blockHeader, err := memo.GetBlockHeaderFromRaw(raw[:memo.BlockHeaderLength])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing raw block header; %w", err)
+	}
+	return []*importBlockLocation{{
+		File:   path,
+		Size:   int64(len(raw)),
+		Hex:    isHex,
+		Header: *blockHeader,
+	}}, nil
+}
+
+// getBestChain follows blocks from the configured init block parent and returns the branch with the most cumulative
+// proof of work, which may not be the branch with the most blocks.
+func (i *Import) getBestChain() ([]chainhash.Hash, error) {
+	initBlockParent, err := chainhash.NewHashFromStr(config.GetInitBlockParent())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing init block parent; %w", err)
+	}
+	if len(i.children[*initBlockParent]) == 0 {
+		return nil, fmt.Errorf("error import files do not contain the child of init block parent: %s",
+			initBlockParent)
+	}
+	var depths = make(map[chainhash.Hash]int)
+	var works = make(map[chainhash.Hash]*big.Int)
+	var queue = append([]chainhash.Hash{}, i.children[*initBlockParent]...)
+	for _, blockHash := range queue {
+		depths[blockHash] = 0
+		works[blockHash] = pow.CalcWork(i.locations[blockHash].Header.Bits)
+	}
+	var tip = queue[0]
+	for len(queue) > 0 {
+		blockHash := queue[0]
+		queue = queue[1:]
+		if works[blockHash].Cmp(works[tip]) > 0 {
+			tip = blockHash
+		}
+		for _, child := range i.children[blockHash] {
+			depths[child] = depths[blockHash] + 1
+			works[child] = new(big.Int).Add(works[blockHash], pow.CalcWork(i.locations[child].Header.Bits))
+			queue = append(queue, child)
+		}
+	}
+	var chain = make([]chainhash.Hash, depths[tip]+1)
+	for blockHash, j := tip, depths[tip]; j >= 0; j-- {
+		chain[j] = blockHash
+		blockHash = i.locations[blockHash].Header.PrevBlock
+	}
+	return chain, nil
+}
+
+func NewImport(dir string, parallel int, restart, verbose bool) *Import {
+	return &Import{
+		Processor: NewProcessor(verbose),
+		Dir:       dir,
+		Parallel:  parallel,
+		Restart:   restart,
+	}
+}
@@ -2,40 +2,62 @@ package lead
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/btcd/wire"
 	"github.com/memocash/index/node/obj/saver"
 	"github.com/memocash/index/node/peer"
+	"github.com/memocash/index/ref/config"
 	"github.com/memocash/index/ref/dbi"
-	"log"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type Node struct {
-	Off      bool
-	Peer     *peer.Peer
-	NewBlock chan *dbi.Block
-	SyncDone chan struct{}
-	Verbose  bool
+	Host       string
+	NewBlock   chan *dbi.Block
+	Verbose    bool
+	off        atomic.Bool
+	peerConn   *peer.Peer
+	peerMu     sync.Mutex
+	stalled    atomic.Bool
+	sending    atomic.Bool
+	lastActive atomic.Int64
+	fetches    map[chainhash.Hash]chan *dbi.Block
+	fetchMu    sync.Mutex
 }
 
 func (n *Node) SaveTxs(ctx context.Context, b *dbi.Block) error {
-	if n.Off {
+	if n.off.Load() {
 		return nil
 	}
+	if dbi.BlockHeaderSet(b.Header) {
+		if err := n.CheckHeader(b.Header); err != nil {
+			n.GetPeer().Misbehave(fmt.Errorf("error invalid block from peer: %s; %w", b.Header.BlockHash(), err))
+			return nil
+		}
+		if n.deliverFetch(b) {
+			return nil
+		}
+	}
+	n.sending.Store(true)
 	n.NewBlock <- b
+	n.sending.Store(false)
+	n.setActive()
 	return nil
 }
 
 func (n *Node) SaveBlock(dbi.BlockInfo) error {
-	if n.Off {
+	if n.off.Load() {
 		return nil
 	}
 	return nil
 }
 
 func (n *Node) GetBlock(heightBack int64) (*chainhash.Hash, error) {
-	if n.Off {
+	if n.off.Load() {
 		return nil, nil
 	}
 	hash, err := saver.NewBlock(n.Verbose).GetBlock(heightBack + 1)
@@ -45,37 +67,139 @@ func (n *Node) GetBlock(heightBack int64) (*chainhash.Hash, error) {
 	return hash, nil
 }
 
-func (n *Node) Start(memPool, syncDone bool) {
-	go func() {
-		for {
-			n.Peer = peer.NewConnection(n, n)
-			n.Peer.SyncDone = syncDone
-			n.Peer.Mempool = memPool
-			n.Off = false
-			if err := n.Peer.Connect(); err != nil {
-				log.Fatalf("fatal error connecting to peer; %v", err)
-			}
-			log.Printf("node peer disconnected\n")
-			n.Off = true
-			if n.Peer.SyncDone {
-				n.SyncDone <- struct{}{}
-				break
-			}
-			const sleepSeconds = 5
-			log.Printf("reconnecting node peer after %d seconds\n", sleepSeconds)
-			time.Sleep(time.Second * sleepSeconds)
-		}
+// CheckHeader rejects headers failing consensus rules. Headers with an unknown parent are allowed, blocks for them
+// are saved without a height.
+func (n *Node) CheckHeader(header wire.BlockHeader) error {
+	headerChain, err := saver.GetHeaderChain()
+	if err != nil {
+		return fmt.Errorf("error getting header chain for lead node; %w", err)
+	}
+	if _, err := headerChain.Check(header); err != nil && !errors.Is(err, saver.HeaderParentNotFoundError) {
+		return fmt.Errorf("error checking header for lead node; %w", err)
+	}
+	return nil
+}
+
+// FetchBlock requests a block by hash and waits for it, the block is returned instead of sent on NewBlock.
+func (n *Node) FetchBlock(ctx context.Context, blockHash chainhash.Hash) (*dbi.Block, error) {
+	var blockChan = make(chan *dbi.Block, 1)
+	n.fetchMu.Lock()
+	if n.fetches == nil {
+		n.fetches = make(map[chainhash.Hash]chan *dbi.Block)
+	}
+	n.fetches[blockHash] = blockChan
+	n.fetchMu.Unlock()
+	defer func() {
+		n.fetchMu.Lock()
+		delete(n.fetches, blockHash)
+		n.fetchMu.Unlock()
 	}()
+	nodePeer := n.getConnectedPeer()
+	if nodePeer == nil {
+		return nil, fmt.Errorf("error node not connected for fetch block: %s", n.Host)
+	}
+	if err := nodePeer.RequestBlock(blockHash); err != nil {
+		return nil, fmt.Errorf("error requesting block from peer; %w", err)
+	}
+	select {
+	case block := <-blockChan:
+		return block, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("error context done waiting for fetched block: %s; %w", blockHash, ctx.Err())
+	}
+}
+
+func (n *Node) deliverFetch(b *dbi.Block) bool {
+	n.fetchMu.Lock()
+	defer n.fetchMu.Unlock()
+	blockChan, ok := n.fetches[b.Header.BlockHash()]
+	if !ok {
+		return false
+	}
+	select {
+	case blockChan <- b:
+	default:
+	}
+	return true
+}
+
+func (n *Node) setActive() {
+	n.lastActive.Store(time.Now().UnixNano())
+}
+
+// GetWaiting returns how long the node has been waiting on the peer for a block, time spent sending a block to a
+// slow consumer is not counted.
+func (n *Node) GetWaiting() time.Duration {
+	if n.sending.Load() {
+		return 0
+	}
+	return time.Since(time.Unix(0, n.lastActive.Load()))
+}
+
+func (n *Node) SetStalled() {
+	n.stalled.Store(true)
+}
+
+func (n *Node) IsStalled() bool {
+	return n.stalled.Load()
+}
+
+// GetPeer returns the peer connection of the node, set when the node connects. Nil if the node has not connected.
+func (n *Node) GetPeer() *peer.Peer {
+	n.peerMu.Lock()
+	defer n.peerMu.Unlock()
+	return n.peerConn
+}
+
+// getConnectedPeer returns the peer connection if the node is connected, otherwise nil.
+func (n *Node) getConnectedPeer() *peer.Peer {
+	nodePeer := n.GetPeer()
+	if n.off.Load() || nodePeer == nil || !nodePeer.Connected {
+		return nil
+	}
+	return nodePeer
+}
+
+// Connect blocks until the peer disconnects and returns whether the peer reported the block sync as done.
+func (n *Node) Connect(memPool, syncDone bool) (bool, error) {
+	nodePeer := peer.NewConnection(n, n)
+	nodePeer.Host = n.Host
+	nodePeer.SyncDone = syncDone
+	nodePeer.SyncWindow = config.GetSyncConfig().Window
+	nodePeer.Mempool = memPool
+	nodePeer.BroadcastWait = config.GetBroadcastWait()
+	nodePeer.OnTxReject = func(msg *wire.MsgReject) {
+		saveTxReject(n.Host, msg)
+	}
+	n.peerMu.Lock()
+	n.peerConn = nodePeer
+	n.peerMu.Unlock()
+	n.off.Store(false)
+	n.stalled.Store(false)
+	n.setActive()
+	err := nodePeer.Connect()
+	n.off.Store(true)
+	if err != nil {
+		return false, fmt.Errorf("error connecting to node peer: %s; %w", n.Host, err)
+	}
+	return nodePeer.SyncDone, nil
+}
+
+func (n *Node) IsConnected() bool {
+	return n.getConnectedPeer() != nil
 }
 
 func (n *Node) Stop() {
-	n.Off = true
-	n.Peer.Disconnect()
+	n.off.Store(true)
+	if nodePeer := n.GetPeer(); nodePeer != nil {
+		nodePeer.Disconnect()
+	}
 }
 
-func NewNode() *Node {
+func NewNode(host string, newBlock chan *dbi.Block, verbose bool) *Node {
 	return &Node{
-		NewBlock: make(chan *dbi.Block),
-		SyncDone: make(chan struct{}),
+		Host:     host,
+		NewBlock: newBlock,
+		Verbose:  verbose,
 	}
 }
@@ -0,0 +1,530 @@
+package lead
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item"
+	"github.com/memocash/index/db/item/chain"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/node/peer"
+	"github.com/memocash/index/ref/config"
+	"github.com/memocash/index/ref/dbi"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	peerRetry         = 5 * time.Second
+	peerHealthHistory = 20
+	peerSeenLimit     = 100000
+)
+
+// PeerHealth is the score of an upstream peer, loaded from and saved to peer connection history.
+type PeerHealth struct {
+	Host  string
+	Ip    []byte
+	Port  uint16
+	Score int
+}
+
+func (h *PeerHealth) Add(status item.PeerConnectionStatus) {
+	switch status {
+	case item.PeerConnectionStatusSuccess:
+		h.Score++
+	case item.PeerConnectionStatusFail:
+		h.Score--
+	case item.PeerConnectionStatusStall:
+		h.Score -= 2
+	}
+}
+
+// Peers keeps connections to a set of upstream peers. Blocks are downloaded from a single peer, chosen by best
+// announced tip and health, and replaced when it fails or stalls. Mempool inventory is merged from all peers.
+type Peers struct {
+	Verbose      bool
+	Health       []*PeerHealth
+	BlockNode    *Node
+	MemPoolNodes map[string]*Node
+	NewBlock     chan *dbi.Block
+	MemPool      chan *dbi.Block
+	SyncDone     chan struct{}
+	Done         chan struct{}
+	memPoolIn    chan *dbi.Block
+	seen         map[chainhash.Hash]bool
+	seenOrder    []chainhash.Hash
+	stopped      bool
+	mutex        sync.Mutex
+}
+
+func (p *Peers) Load() error {
+	peersConfig := config.GetPeersConfig()
+	hosts := peersConfig.GetHosts()
+	if peersConfig.UseFound {
+		foundHosts, err := getFoundHosts(peersConfig.Max)
+		if err != nil {
+			return fmt.Errorf("error getting found peer hosts; %w", err)
+		}
+		hosts = append(hosts, foundHosts...)
+	}
+	var seenHosts = make(map[string]bool)
+	for _, host := range hosts {
+		if seenHosts[host] {
+			continue
+		}
+		seenHosts[host] = true
+		health, err := getPeerHealth(host)
+		if err != nil {
+			log.Printf("error getting peer health, skipping peer: %s; %v", host, err)
+			continue
+		}
+		p.Health = append(p.Health, health)
+	}
+	if len(p.Health) == 0 {
+		return fmt.Errorf("error no usable upstream peers configured")
+	}
+	return nil
+}
+
+func getPeerHealth(host string) (*PeerHealth, error) {
+	hostName, portString, err := net.SplitHostPort(host)
+	if err != nil {
+		return nil, fmt.Errorf("error splitting peer host port; %w", err)
+	}
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing peer port; %w", err)
+	}
+	ips, err := net.LookupIP(hostName)
+	if err != nil || len(ips) == 0 {
+		return nil, fmt.Errorf("error looking up peer ip; %w", err)
+	}
+	var health = &PeerHealth{
+		Host: host,
+		Ip:   ips[0].To16(),
+		Port: uint16(port),
+	}
+	peerConnections, err := item.GetPeerConnectionsRecent(health.Ip, health.Port, peerHealthHistory)
+	if err != nil && !client.IsEntryNotFoundError(err) {
+		return nil, fmt.Errorf("error getting recent peer connections for health; %w", err)
+	}
+	for _, peerConnection := range peerConnections {
+		health.Add(peerConnection.Status)
+	}
+	return health, nil
+}
+
+// getFoundHosts returns peers found by the node group whose last connection succeeded.
+func getFoundHosts(max int) ([]string, error) {
+	var hosts []string
+	for _, shardConfig := range config.GetQueueShards() {
+		peers, err := item.GetPeers(shardConfig.Shard, nil)
+		if err != nil && !client.IsEntryNotFoundError(err) {
+			return nil, fmt.Errorf("error getting peers for shard: %d; %w", shardConfig.Shard, err)
+		}
+		for _, peer := range peers {
+			peerConnections, err := item.GetPeerConnectionsRecent(peer.Ip, peer.Port, 1)
+			if err != nil && !client.IsEntryNotFoundError(err) {
+				return nil, fmt.Errorf("error getting recent peer connection for found peer; %w", err)
+			}
+			if len(peerConnections) == 0 || peerConnections[0].Status != item.PeerConnectionStatusSuccess {
+				continue
+			}
+			hosts = append(hosts, net.JoinHostPort(net.IP(peer.Ip).String(), strconv.Itoa(int(peer.Port))))
+			if len(hosts) >= max {
+				return hosts, nil
+			}
+		}
+	}
+	return hosts, nil
+}
+
+// getHeight returns the best tip announced by a host on any of its connections.
+func (p *Peers) getHeight(host string) int32 {
+	p.mutex.Lock()
+	blockNode := p.BlockNode
+	p.mutex.Unlock()
+	var height int32
+	for _, node := range append(p.getMemPoolNodes(), blockNode) {
+		if node == nil || node.Host != host {
+			continue
+		}
+		if nodePeer := node.getConnectedPeer(); nodePeer != nil && nodePeer.GetHeight() > height {
+			height = nodePeer.GetHeight()
+		}
+	}
+	return height
+}
+
+// GetBlockHeight returns the tip announced by the block node, 0 if not connected.
+func (p *Peers) GetBlockHeight() int32 {
+	p.mutex.Lock()
+	blockNode := p.BlockNode
+	p.mutex.Unlock()
+	if blockNode == nil {
+		return 0
+	}
+	nodePeer := blockNode.getConnectedPeer()
+	if nodePeer == nil {
+		return 0
+	}
+	return nodePeer.GetHeight()
+}
+
+// GetBlock fetches an indexed block by height from the block node, used to re-fetch historical blocks. Heights not
+// yet indexed can't be fetched since P2P only serves blocks by hash.
+func (p *Peers) GetBlock(ctx context.Context, height int64) (*dbi.Block, error) {
+	heightBlock, err := chain.GetHeightBlockSingle(height)
+	if err != nil {
+		return nil, fmt.Errorf("error getting height block for peer block fetch: %d; %w", height, err)
+	}
+	p.mutex.Lock()
+	blockNode := p.BlockNode
+	p.mutex.Unlock()
+	if blockNode == nil || !blockNode.IsConnected() {
+		return nil, fmt.Errorf("error no connected block peer for block fetch")
+	}
+	block, err := blockNode.FetchBlock(ctx, heightBlock.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching block from block peer: %s; %w", blockNode.Host, err)
+	}
+	return block, nil
+}
+
+func (p *Peers) GetNewBlock() chan *dbi.Block {
+	return p.NewBlock
+}
+
+func (p *Peers) GetMemPool() chan *dbi.Block {
+	return p.MemPool
+}
+
+func (p *Peers) GetSyncDone() chan struct{} {
+	return p.SyncDone
+}
+
+func (p *Peers) GetDone() chan struct{} {
+	return p.Done
+}
+
+// GetBest orders peers by announced tip then health score, skipping the last failed host if there are others.
+func (p *Peers) GetBest(skip string) *PeerHealth {
+	p.mutex.Lock()
+	var candidates = make([]*PeerHealth, 0, len(p.Health))
+	for _, health := range p.Health {
+		if health.Host != skip || len(p.Health) == 1 {
+			candidates = append(candidates, health)
+		}
+	}
+	p.mutex.Unlock()
+	var heights = make(map[string]int32)
+	for _, candidate := range candidates {
+		heights[candidate.Host] = p.getHeight(candidate.Host)
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if heights[candidates[i].Host] != heights[candidates[j].Host] {
+			return heights[candidates[i].Host] > heights[candidates[j].Host]
+		}
+		return candidates[i].Score > candidates[j].Score
+	})
+	return candidates[0]
+}
+
+func (p *Peers) saveResult(health *PeerHealth, status item.PeerConnectionStatus) {
+	p.mutex.Lock()
+	health.Add(status)
+	p.mutex.Unlock()
+	if err := db.Save([]db.Object{&item.PeerConnection{
+		Ip:     health.Ip,
+		Port:   health.Port,
+		Time:   time.Now(),
+		Status: status,
+	}}); err != nil {
+		log.Printf("error saving peer connection result for lead peer: %s; %v", health.Host, err)
+	}
+}
+
+func (p *Peers) isStopped() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.stopped
+}
+
+func getConnectionStatus(node *Node, err error) item.PeerConnectionStatus {
+	if node.IsStalled() {
+		return item.PeerConnectionStatusStall
+	} else if nodePeer := node.GetPeer(); err != nil || nodePeer == nil || !nodePeer.Connected {
+		return item.PeerConnectionStatusFail
+	}
+	return item.PeerConnectionStatusSuccess
+}
+
+// watchStall disconnects a block node that hasn't delivered a block within the stall timeout while syncing. Time
+// blocked sending a block to the processor is not counted.
+func (p *Peers) watchStall(node *Node, done chan struct{}) {
+	stallTimeout := config.GetPeersConfig().GetStallTimeout()
+	if stallTimeout <= 0 {
+		return
+	}
+	ticker := time.NewTicker(stallTimeout / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if nodePeer := node.GetPeer(); nodePeer == nil || nodePeer.SyncDone || node.GetWaiting() < stallTimeout {
+				continue
+			}
+			log.Printf("Block peer stalled, disconnecting: %s\n", node.Host)
+			node.SetStalled()
+			node.Stop()
+			return
+		}
+	}
+}
+
+func (p *Peers) StartBlock(synced bool) {
+	go func() {
+		var skip string
+		for !p.isStopped() {
+			health := p.GetBest(skip)
+			node := NewNode(health.Host, p.NewBlock, p.Verbose)
+			p.mutex.Lock()
+			p.BlockNode = node
+			p.mutex.Unlock()
+			log.Printf("Using block peer: %s (score: %d)\n", health.Host, health.Score)
+			var done = make(chan struct{})
+			go p.watchStall(node, done)
+			syncDone, err := node.Connect(false, synced)
+			close(done)
+			status := getConnectionStatus(node, err)
+			p.saveResult(health, status)
+			if err != nil {
+				log.Printf("error with block peer: %s; %v", health.Host, err)
+			}
+			log.Printf("block peer disconnected: %s (%s)\n", health.Host, status)
+			if syncDone && !node.IsStalled() {
+				p.SyncDone <- struct{}{}
+				return
+			}
+			if status != item.PeerConnectionStatusSuccess {
+				skip = health.Host
+			}
+			log.Printf("reconnecting block peer after %s\n", peerRetry)
+			time.Sleep(peerRetry)
+		}
+	}()
+}
+
+func (p *Peers) StartMemPool() {
+	p.mutex.Lock()
+	var healths = append([]*PeerHealth{}, p.Health...)
+	p.mutex.Unlock()
+	sort.SliceStable(healths, func(i, j int) bool {
+		return healths[i].Score > healths[j].Score
+	})
+	if max := config.GetPeersConfig().Max; max > 0 && len(healths) > max {
+		healths = healths[:max]
+	}
+	go p.dedupeMemPool()
+	for _, health := range healths {
+		go func(health *PeerHealth) {
+			for !p.isStopped() {
+				node := NewNode(health.Host, p.memPoolIn, p.Verbose)
+				p.mutex.Lock()
+				p.MemPoolNodes[health.Host] = node
+				p.mutex.Unlock()
+				_, err := node.Connect(true, true)
+				status := getConnectionStatus(node, err)
+				p.saveResult(health, status)
+				if err != nil {
+					log.Printf("error with mempool peer: %s; %v", health.Host, err)
+				}
+				log.Printf("mempool peer disconnected, reconnecting after %s: %s\n", peerRetry, health.Host)
+				time.Sleep(peerRetry)
+			}
+		}(health)
+	}
+}
+
+func (p *Peers) getMemPoolNodes() []*Node {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	var nodes = make([]*Node, 0, len(p.MemPoolNodes))
+	for _, node := range p.MemPoolNodes {
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// markSeen returns false if every block or tx in the message has already been received from another peer.
+func (p *Peers) markSeen(block *dbi.Block) bool {
+	var hashes []chainhash.Hash
+	if block.HasHeader() {
+		hashes = append(hashes, block.Header.BlockHash())
+	} else {
+		for _, tx := range block.Transactions {
+			hashes = append(hashes, tx.Hash)
+		}
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	var isNew bool
+	for _, hash := range hashes {
+		if p.seen[hash] {
+			continue
+		}
+		isNew = true
+		p.seen[hash] = true
+		p.seenOrder = append(p.seenOrder, hash)
+	}
+	if len(p.seenOrder) > peerSeenLimit {
+		for _, hash := range p.seenOrder[:len(p.seenOrder)-peerSeenLimit] {
+			delete(p.seen, hash)
+		}
+		p.seenOrder = append([]chainhash.Hash{}, p.seenOrder[len(p.seenOrder)-peerSeenLimit:]...)
+	}
+	return isNew
+}
+
+func (p *Peers) dedupeMemPool() {
+	for {
+		select {
+		case <-p.Done:
+			return
+		case block := <-p.memPoolIn:
+			if !p.markSeen(block) {
+				continue
+			}
+			select {
+			case p.MemPool <- block:
+			case <-p.Done:
+				return
+			}
+		}
+	}
+}
+
+// BroadcastTx sends a tx to every connected upstream peer, succeeding if any peer accepts it. The outcome is saved as a
+// broadcast result, a RejectError is returned if every peer that received the tx rejected it.
+func (p *Peers) BroadcastTx(ctx context.Context, msgTx *wire.MsgTx) error {
+	if p == nil {
+		return fmt.Errorf("error upstream peers not started")
+	}
+	p.mutex.Lock()
+	var nodes = []*Node{p.BlockNode}
+	p.mutex.Unlock()
+	nodes = append(nodes, p.getMemPoolNodes()...)
+	var wg sync.WaitGroup
+	var errs = make([]error, len(nodes))
+	for i, node := range nodes {
+		if node == nil || !node.IsConnected() {
+			errs[i] = fmt.Errorf("error no connected peers for broadcast")
+			continue
+		}
+		wg.Add(1)
+		go func(i int, node *Node) {
+			defer wg.Done()
+			nodePeer := node.getConnectedPeer()
+			if nodePeer == nil {
+				errs[i] = fmt.Errorf("error peer disconnected before broadcast: %s", node.Host)
+				return
+			}
+			if err := nodePeer.BroadcastTx(ctx, msgTx); err != nil {
+				errs[i] = fmt.Errorf("error broadcasting tx to peer: %s; %w", node.Host, err)
+			}
+		}(i, node)
+	}
+	wg.Wait()
+	var broadcastResult = &item.BroadcastResult{
+		TxHash: msgTx.TxHash(),
+		Time:   time.Now(),
+	}
+	var lastErr error
+	for i, err := range errs {
+		if err == nil {
+			broadcastResult.Status = item.BroadcastResultStatusAccepted
+			broadcastResult.Host = nodes[i].Host
+			broadcastResult.Code = 0
+			broadcastResult.Reason = ""
+			break
+		}
+		var rejectError *peer.RejectError
+		if errors.As(err, &rejectError) {
+			broadcastResult.Status = item.BroadcastResultStatusRejected
+			broadcastResult.Code = uint8(rejectError.Code)
+			broadcastResult.Reason = rejectError.Reason
+			broadcastResult.Host = rejectError.Host
+			lastErr = err
+		} else if lastErr == nil {
+			lastErr = err
+		}
+	}
+	if broadcastResult.Status == 0 {
+		return lastErr
+	}
+	if err := db.Save([]db.Object{broadcastResult}); err != nil {
+		return fmt.Errorf("error saving broadcast result; %w", err)
+	}
+	if broadcastResult.Status == item.BroadcastResultStatusRejected {
+		return lastErr
+	}
+	return nil
+}
+
+// saveTxReject saves a reject received for a recent broadcast after the broadcast wait window.
+func saveTxReject(host string, msg *wire.MsgReject) {
+	if err := db.Save([]db.Object{&item.BroadcastResult{
+		TxHash: msg.Hash,
+		Status: item.BroadcastResultStatusRejected,
+		Code:   uint8(msg.Code),
+		Reason: msg.Reason,
+		Host:   host,
+		Time:   time.Now(),
+	}}); err != nil {
+		log.Printf("error saving broadcast result for late tx reject; %v", err)
+	}
+}
+
+func (p *Peers) Stop() {
+	p.mutex.Lock()
+	if p.stopped {
+		p.mutex.Unlock()
+		return
+	}
+	p.stopped = true
+	close(p.Done)
+	var nodes = []*Node{p.BlockNode}
+	for _, node := range p.MemPoolNodes {
+		nodes = append(nodes, node)
+	}
+	p.mutex.Unlock()
+	for _, node := range nodes {
+		if node != nil && node.GetPeer() != nil {
+			node.Stop()
+		}
+	}
+}
+
+func NewPeers(verbose bool) *Peers {
+	return &Peers{
+		Verbose:      verbose,
+		MemPoolNodes: make(map[string]*Node),
+		NewBlock:     make(chan *dbi.Block),
+		MemPool:      make(chan *dbi.Block),
+		SyncDone:     make(chan struct{}),
+		Done:         make(chan struct{}),
+		memPoolIn:    make(chan *dbi.Block),
+		seen:         make(map[chainhash.Hash]bool),
+	}
+}
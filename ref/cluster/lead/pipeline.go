@@ -0,0 +1,348 @@
+package lead
+
+import (
+	"fmt"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/btcd/txscript"
+	"github.com/jchavannes/jgo/jutil"
+	"github.com/memocash/index/db/item"
+	"github.com/memocash/index/db/item/chain"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/db/metric"
+	"github.com/memocash/index/node/obj/saver"
+	"github.com/memocash/index/ref/bitcoin/pow"
+	"github.com/memocash/index/ref/cluster/proto/cluster_pb"
+	"github.com/memocash/index/ref/config"
+	"github.com/memocash/index/ref/dbi"
+	"log"
+	"sync"
+	"time"
+)
+
+const pipelineProgressInterval = 5 * time.Second
+
+type pipelineBlock struct {
+	Block  *dbi.Block
+	Node   *pow.Node
+	Seen   time.Time
+	Shards map[uint32]*cluster_pb.Block
+	Saved  bool
+}
+
+// Pipeline saves blocks during initial sync. Blocks can be received out of order, shard txs for up to Parallel
+// blocks are saved concurrently and block records are committed in height order. A block waits for earlier blocks
+// still saving when it spends or references one of their txs, so savers always see the txs they depend on.
+// Unlike ProcessBlock, which saves the block record before its txs, shard txs are saved before the block record is
+// committed, so the saved height never covers a block with unsaved txs and a restart resumes after the last commit.
+// The pipeline stops if a block fails to save, no later blocks are committed.
+type Pipeline struct {
+	Processor *Processor
+	Parallel  int
+	Buffer    int
+	blocks    map[int64]*pipelineBlock
+	saving    map[int64]*pipelineBlock
+	savingTxs map[chainhash.Hash]*pipelineBlock
+	next      int64
+	commit    int64
+	progress  *item.SyncProgress
+	started   bool
+	stopped   bool
+	failed    bool
+	done      chan struct{}
+	mutex     sync.Mutex
+	cond      *sync.Cond
+}
+
+// Add queues a block for saving, returns false if the block can't be pipelined and should be processed directly,
+// e.g. a block without a header, a fork or a block before the init block.
+func (p *Pipeline) Add(block *dbi.Block) bool {
+	if !dbi.BlockHeaderSet(block.Header) {
+		return false
+	}
+	headerChain, err := saver.GetHeaderChain()
+	if err != nil {
+		return false
+	}
+	node, err := headerChain.Check(block.Header)
+	if err != nil || node.Height == 0 {
+		return false
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.stopped {
+		return false
+	}
+	if !p.started {
+		if err := p.setNext(); err != nil {
+			log.Printf("error setting next pipeline height; %v", err)
+			return false
+		}
+	}
+	if node.Height < p.next {
+		saving, ok := p.saving[node.Height]
+		return ok && saving.Node.Hash == node.Hash
+	}
+	if existing, ok := p.blocks[node.Height]; ok {
+		return existing.Node.Hash == node.Hash
+	}
+	for !p.stopped && node.Height != p.next && len(p.blocks)+len(p.saving) >= p.Buffer && p.canProgress() {
+		p.cond.Wait()
+	}
+	if p.stopped {
+		return false
+	}
+	p.blocks[node.Height] = &pipelineBlock{
+		Block:  block,
+		Node:   node,
+		Seen:   getBlockSeen(block),
+		Shards: GetShardBlocks(block),
+	}
+	p.cond.Broadcast()
+	return true
+}
+
+// setNext starts the pipeline after the most recent saved height.
+func (p *Pipeline) setNext() error {
+	recentBlock, err := chain.GetRecentHeightBlock()
+	if err != nil {
+		return fmt.Errorf("error getting recent height block for pipeline; %w", err)
+	}
+	if recentBlock != nil {
+		p.next = recentBlock.Height + 1
+	} else {
+		p.next = int64(config.GetInitBlockHeight())
+	}
+	p.commit = p.next
+	p.started = true
+	if p.progress.Started.IsZero() {
+		p.progress.StartHeight = p.next - 1
+		p.progress.Height = p.next - 1
+		p.progress.Started = time.Now()
+	}
+	return nil
+}
+
+// canProgress is false when the next block is missing and nothing is saving, e.g. after a peer disconnect. Waiting
+// blocks are accepted over the buffer limit in that case so the block loop can receive the missing block.
+func (p *Pipeline) canProgress() bool {
+	_, ok := p.blocks[p.next]
+	return ok || len(p.saving) > 0
+}
+
+// Flush waits for all contiguous blocks to be saved and committed.
+func (p *Pipeline) Flush() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for !p.stopped && p.canProgress() {
+		p.cond.Wait()
+	}
+}
+
+// Reset restarts the pipeline after the most recent saved height on the next Add, used after a block is processed
+// outside the pipeline. Reset is only valid after Flush.
+func (p *Pipeline) Reset() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if len(p.saving) == 0 {
+		p.blocks = make(map[int64]*pipelineBlock)
+		p.started = false
+	}
+}
+
+// Failed returns whether the pipeline stopped because a block failed to save, blocks after it must not be processed
+// outside the pipeline either.
+func (p *Pipeline) Failed() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.failed
+}
+
+func (p *Pipeline) Stop() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.stop()
+}
+
+func (p *Pipeline) stop() {
+	if p.stopped {
+		return
+	}
+	p.stopped = true
+	close(p.done)
+	p.cond.Broadcast()
+}
+
+func (p *Pipeline) run() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for !p.stopped {
+		if block, ok := p.saving[p.commit]; ok && block.Saved {
+			p.mutex.Unlock()
+			err := p.commitBlock(block)
+			p.mutex.Lock()
+			if err != nil {
+				p.failed = true
+				p.stop()
+				go func() {
+					p.Processor.ErrorChan <- fmt.Errorf("error committing pipeline block; %w", err)
+				}()
+				return
+			}
+			delete(p.saving, p.commit)
+			for _, tx := range block.Block.Transactions {
+				delete(p.savingTxs, chainhash.Hash(tx.Hash))
+			}
+			p.progress.Height = p.commit
+			p.commit++
+			p.cond.Broadcast()
+			continue
+		}
+		if block, ok := p.blocks[p.next]; ok && len(p.saving) < p.Parallel && !p.hasSavingDependency(block) {
+			delete(p.blocks, p.next)
+			p.saving[p.next] = block
+			for _, tx := range block.Block.Transactions {
+				p.savingTxs[chainhash.Hash(tx.Hash)] = block
+			}
+			p.next++
+			go p.saveShards(block)
+			p.cond.Broadcast()
+			continue
+		}
+		p.cond.Wait()
+	}
+}
+
+// hasSavingDependency checks if any input spends, or any OP_RETURN push references, a tx in a block still saving.
+func (p *Pipeline) hasSavingDependency(block *pipelineBlock) bool {
+	isSaving := func(hash []byte) bool {
+		txHash, err := chainhash.NewHash(hash)
+		if err != nil {
+			return false
+		}
+		saving, ok := p.savingTxs[*txHash]
+		return ok && !saving.Saved
+	}
+	for _, tx := range block.Block.Transactions {
+		for _, txIn := range tx.MsgTx.TxIn {
+			if isSaving(txIn.PreviousOutPoint.Hash[:]) {
+				return true
+			}
+		}
+		for _, txOut := range tx.MsgTx.TxOut {
+			if len(txOut.PkScript) == 0 || txOut.PkScript[0] != txscript.OP_RETURN {
+				continue
+			}
+			pushData, err := txscript.PushedData(txOut.PkScript)
+			if err != nil {
+				continue
+			}
+			for _, data := range pushData {
+				if len(data) == chainhash.HashSize && (isSaving(data) || isSaving(jutil.ByteReverse(data))) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// saveShards saves the shard txs of a block, stopping the pipeline on failure so the block is never committed. The
+// failure is reported on the processor error chan by SaveBlockShards.
+func (p *Pipeline) saveShards(block *pipelineBlock) {
+	saved := p.Processor.SaveBlockShards(block.Node.Height, block.Seen, block.Shards)
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if !saved {
+		log.Printf("error saving pipeline block shards, stopping pipeline: %s (height: %d)\n",
+			block.Node.Hash, block.Node.Height)
+		p.failed = true
+		p.stop()
+		return
+	}
+	block.Saved = true
+	p.cond.Broadcast()
+}
+
+func (p *Pipeline) commitBlock(block *pipelineBlock) error {
+	blockInfo := dbi.BlockInfo{
+		Header:  block.Block.Header,
+		Size:    block.Block.Size(),
+		TxCount: len(block.Block.Transactions),
+	}
+	blockSaver := saver.NewBlock(p.Processor.Verbose)
+	if err := blockSaver.SaveBlock(blockInfo); err != nil {
+		return fmt.Errorf("error saving pipeline block: %s; %w", block.Node.Hash, err)
+	}
+	logSavedBlock("pipeline", blockInfo)
+	return nil
+}
+
+func (p *Pipeline) runProgress() {
+	ticker := time.NewTicker(pipelineProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := p.SaveProgress(); err != nil {
+				log.Printf("error saving pipeline sync progress; %v", err)
+			}
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// SaveProgress saves the sync progress item and metric, the target is the tip announced by the block node.
+func (p *Pipeline) SaveProgress() error {
+	p.mutex.Lock()
+	if p.progress.Started.IsZero() {
+		p.mutex.Unlock()
+		return nil
+	}
+	p.progress.InFlight = len(p.blocks) + len(p.saving)
+	p.progress.Updated = time.Now()
+	progress := *p.progress
+	p.mutex.Unlock()
+	if source := p.Processor.GetSource(); source != nil {
+		progress.Target = int64(source.GetBlockHeight())
+	}
+	if progress.Target < progress.Height {
+		progress.Target = progress.Height
+	}
+	if err := db.Save([]db.Object{&progress}); err != nil {
+		return fmt.Errorf("error saving sync progress; %w", err)
+	}
+	metric.AddSync(metric.Sync{
+		Height:   progress.Height,
+		Target:   progress.Target,
+		InFlight: progress.InFlight,
+		Rate:     progress.GetRate(),
+	})
+	log.Printf("Sync progress: %d / %d, in flight: %d, rate: %.2f blocks/s, eta: %s\n", progress.Height,
+		progress.Target, progress.InFlight, progress.GetRate(), progress.GetEta().Round(time.Second))
+	return nil
+}
+
+func NewPipeline(processor *Processor) *Pipeline {
+	syncConfig := config.GetSyncConfig()
+	var pipeline = &Pipeline{
+		Processor: processor,
+		Parallel:  syncConfig.Parallel,
+		Buffer:    syncConfig.Buffer,
+		blocks:    make(map[int64]*pipelineBlock),
+		saving:    make(map[int64]*pipelineBlock),
+		savingTxs: make(map[chainhash.Hash]*pipelineBlock),
+		progress:  &item.SyncProgress{Name: item.SyncProgressBlock},
+		done:      make(chan struct{}),
+	}
+	if pipeline.Parallel < 1 {
+		pipeline.Parallel = 1
+	}
+	if pipeline.Buffer < pipeline.Parallel {
+		pipeline.Buffer = pipeline.Parallel
+	}
+	pipeline.cond = sync.NewCond(&pipeline.mutex)
+	go pipeline.run()
+	go pipeline.runProgress()
+	return pipeline
+}
@@ -3,6 +3,7 @@ package lead
 import (
 	"context"
 	"fmt"
+	"github.com/jchavannes/btcd/wire"
 	"github.com/jchavannes/jgo/jfmt"
 	"github.com/memocash/index/db/client"
 	"github.com/memocash/index/db/item"
@@ -21,15 +22,32 @@ import (
 )
 
 type Processor struct {
-	Clients     map[int]*Client
-	ErrorChan   chan error
-	BlockNode   *Node
-	MemPoolNode *Node
-	Verbose     bool
-	Synced      bool
+	Clients    map[int]*Client
+	ErrorChan  chan error
+	SourceType string
+	Rpc        config.RpcConfig
+	Verbose    bool
+	Synced     bool
+	Pipeline   *Pipeline
+	source     BlockSource
+	mutex      sync.Mutex
 }
 
-func (p *Processor) Run() error {
+// GetSource returns the current block source, nil before the processor is started. The source is replaced when the
+// processor restarts after initial sync.
+func (p *Processor) GetSource() BlockSource {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.source
+}
+
+func (p *Processor) setSource(source BlockSource) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.source = source
+}
+
+func (p *Processor) ConnectClients() error {
 	p.Clients = make(map[int]*Client)
 	clusterShards := config.GetClusterShards()
 	for _, clusterShard := range clusterShards {
@@ -41,6 +59,13 @@ func (p *Processor) Run() error {
 			Config: clusterShard,
 			Client: cluster_pb.NewClusterClient(conn)}
 	}
+	return nil
+}
+
+func (p *Processor) Run() error {
+	if err := p.ConnectClients(); err != nil {
+		return fmt.Errorf("error connecting cluster clients for lead processor; %w", err)
+	}
 	var syncStatusComplete *item.SyncStatus
 	if err := ExecWithRetry(func() error {
 		var err error
@@ -52,30 +77,75 @@ func (p *Processor) Run() error {
 	}); err != nil {
 		return fmt.Errorf("error getting sync status complete exec with retry; %w", err)
 	}
+	if oldSource := p.GetSource(); oldSource != nil {
+		oldSource.Stop()
+	}
+	source, err := NewBlockSource(p.SourceType, p.Rpc, p.Verbose)
+	if err != nil {
+		return fmt.Errorf("error getting block source for lead processor; %w", err)
+	}
+	if err := source.Load(); err != nil {
+		return fmt.Errorf("error loading block source for lead processor; %w", err)
+	}
+	p.setSource(source)
 	if syncStatusComplete != nil {
 		p.Synced = true
 		go func() {
-			p.MemPoolNode = NewNode()
-			p.MemPoolNode.Start(true, p.Synced)
-			log.Printf("Started mempool node...\n")
-			for p.ProcessBlock(<-p.MemPoolNode.NewBlock, "mempool") {
+			source.StartMemPool()
+			log.Printf("Started mempool source...\n")
+			for {
+				select {
+				case block := <-source.GetMemPool():
+					if p.ProcessBlock(block, "mempool") {
+						continue
+					}
+				case <-source.GetDone():
+				}
+				break
 			}
-			log.Println("Stopping mempool node")
+			log.Println("Stopping mempool source")
 		}()
 	}
-	p.BlockNode = NewNode()
-	p.BlockNode.Start(false, p.Synced)
+	if p.Pipeline != nil {
+		p.Pipeline.Stop()
+		p.Pipeline = nil
+	}
+	if !p.Synced {
+		p.Pipeline = NewPipeline(p)
+	}
+	pipeline := p.Pipeline
+	source.StartBlock(p.Synced)
 	go func() {
 		log.Printf("Started block node...\n")
 		for {
 			select {
-			case block := <-p.BlockNode.NewBlock:
+			case block := <-source.GetNewBlock():
+				if pipeline != nil {
+					if pipeline.Add(block) {
+						continue
+					}
+					pipeline.Flush()
+					if pipeline.Failed() {
+						break
+					}
+				}
 				if p.ProcessBlock(block, "block node") {
+					if pipeline != nil {
+						pipeline.Reset()
+					}
 					continue
 				}
 				p.ErrorChan <- fmt.Errorf("error processing block")
-			case <-p.BlockNode.SyncDone:
+			case <-source.GetSyncDone():
 				log.Printf("Node sync done\n")
+				if pipeline != nil {
+					pipeline.Flush()
+					if err := pipeline.SaveProgress(); err != nil {
+						p.ErrorChan <- fmt.Errorf("error saving sync progress after block sync complete; %w", err)
+						break
+					}
+					pipeline.Stop()
+				}
 				p.Synced = true
 				recentBlock, err := chain.GetRecentHeightBlock()
 				if err != nil {
@@ -101,25 +171,40 @@ func (p *Processor) Run() error {
 	return fmt.Errorf("error lead processing run; %w", <-p.ErrorChan)
 }
 
-func (p *Processor) ProcessBlock(block *dbi.Block, loc string) bool {
-	seen := time.Now()
-	if block.HasHeader() && block.Header.Timestamp.Before(seen) {
-		seen = block.Header.Timestamp
+// BroadcastTx sends a tx to the upstream block source.
+func (p *Processor) BroadcastTx(ctx context.Context, msgTx *wire.MsgTx) error {
+	source := p.GetSource()
+	if source == nil {
+		return fmt.Errorf("error block source not started")
 	}
-	var shardBlocks = make(map[uint32]*cluster_pb.Block)
-	for i, tx := range block.Transactions {
-		shard := db.GetShardIdFromByte32(tx.Hash[:])
-		if _, ok := shardBlocks[shard]; !ok {
-			shardBlocks[shard] = &cluster_pb.Block{
-				Header: memo.GetRawBlockHeader(block.Header),
-			}
+	if err := source.BroadcastTx(ctx, msgTx); err != nil {
+		return fmt.Errorf("error broadcasting tx to block source; %w", err)
+	}
+	return nil
+}
+
+// Backfill fetches blocks in the height range from the block source and processes them again, e.g. to repair txs
+// missing from a shard. The processor must be running.
+func (p *Processor) Backfill(ctx context.Context, startHeight, endHeight int64) error {
+	source := p.GetSource()
+	if source == nil {
+		return fmt.Errorf("error block source not started for backfill")
+	}
+	for height := startHeight; height <= endHeight; height++ {
+		block, err := source.GetBlock(ctx, height)
+		if err != nil {
+			return fmt.Errorf("error getting block for backfill: %d; %w", height, err)
+		}
+		if !p.ProcessBlock(block, "backfill") {
+			return fmt.Errorf("error processing block for backfill: %d", height)
 		}
-		shardBlocks[shard].Txs = append(shardBlocks[shard].Txs, &cluster_pb.Tx{
-			Index: uint32(i),
-			Raw:   memo.GetRaw(tx.MsgTx),
-		})
 	}
-	blockHash := block.Header.BlockHash()
+	return nil
+}
+
+func (p *Processor) ProcessBlock(block *dbi.Block, loc string) bool {
+	seen := getBlockSeen(block)
+	shardBlocks := GetShardBlocks(block)
 	blockInfo := dbi.BlockInfo{
 		Header:  block.Header,
 		Size:    block.Size(),
@@ -142,15 +227,46 @@ func (p *Processor) ProcessBlock(block *dbi.Block, loc string) bool {
 		return false
 	}
 	if dbi.BlockHeaderSet(block.Header) {
-		log.Printf("Saved block (%s): %s %s, %7s txs, size: %14s\n", loc,
-			blockHash, block.Header.Timestamp.Format("2006-01-02 15:04:05"), jfmt.AddCommasInt(blockInfo.TxCount),
-			jfmt.AddCommasInt(int(blockInfo.Size)))
+		logSavedBlock(loc, blockInfo)
 	}
 	return true
 }
 
+func getBlockSeen(block *dbi.Block) time.Time {
+	seen := time.Now()
+	if block.HasHeader() && block.Header.Timestamp.Before(seen) {
+		seen = block.Header.Timestamp
+	}
+	return seen
+}
+
+// GetShardBlocks splits the txs of a block by shard, keeping each tx's index in the block.
+func GetShardBlocks(block *dbi.Block) map[uint32]*cluster_pb.Block {
+	var shardBlocks = make(map[uint32]*cluster_pb.Block)
+	for i, tx := range block.Transactions {
+		shard := db.GetShardIdFromByte32(tx.Hash[:])
+		if _, ok := shardBlocks[shard]; !ok {
+			shardBlocks[shard] = &cluster_pb.Block{
+				Header: memo.GetRawBlockHeader(block.Header),
+			}
+		}
+		shardBlocks[shard].Txs = append(shardBlocks[shard].Txs, &cluster_pb.Tx{
+			Index: uint32(i),
+			Raw:   memo.GetRaw(tx.MsgTx),
+		})
+	}
+	return shardBlocks
+}
+
+func logSavedBlock(loc string, blockInfo dbi.BlockInfo) {
+	log.Printf("Saved block (%s): %s %s, %7s txs, size: %14s\n", loc,
+		blockInfo.Header.BlockHash(), blockInfo.Header.Timestamp.Format("2006-01-02 15:04:05"),
+		jfmt.AddCommasInt(blockInfo.TxCount), jfmt.AddCommasInt(int(blockInfo.Size)))
+}
+
 func (p *Processor) SaveBlockShards(height int64, seen time.Time, shardBlocks map[uint32]*cluster_pb.Block) bool {
 	var wg sync.WaitGroup
+	var mutex sync.Mutex
 	var hadError bool
 	for _, c := range p.Clients {
 		wg.Add(1)
@@ -170,6 +286,9 @@ func (p *Processor) SaveBlockShards(height int64, seen time.Time, shardBlocks ma
 				}
 				return nil
 			}); err != nil {
+				mutex.Lock()
+				hadError = true
+				mutex.Unlock()
 				p.ErrorChan <- fmt.Errorf("error client exec with retry save txs: %d; %w", c.Config.Shard, err)
 			}
 		}(c)
@@ -180,7 +299,9 @@ func (p *Processor) SaveBlockShards(height int64, seen time.Time, shardBlocks ma
 
 func NewProcessor(verbose bool) *Processor {
 	return &Processor{
-		ErrorChan: make(chan error),
-		Verbose:   verbose,
+		ErrorChan:  make(chan error),
+		SourceType: config.GetSyncConfig().Source,
+		Rpc:        config.GetNodeRpc(),
+		Verbose:    verbose,
 	}
 }
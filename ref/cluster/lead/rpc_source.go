@@ -0,0 +1,331 @@
+package lead
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/db/item"
+	"github.com/memocash/index/db/item/chain"
+	"github.com/memocash/index/db/item/db"
+	"github.com/memocash/index/node/peer"
+	"github.com/memocash/index/node/rpc"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/config"
+	"github.com/memocash/index/ref/dbi"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	RpcDefaultPollInterval = 2 * time.Second
+
+	rpcRetry = 5 * time.Second
+)
+
+type rpcTip struct {
+	Hash   chainhash.Hash
+	Height int64
+}
+
+// RpcSource gets blocks and mempool txs by polling a node's JSON-RPC interface. Blocks are fetched by height after
+// the most recent saved height. When the node's block at the last sent height changes, heights are walked back to
+// the last matching saved block and blocks are sent again from there.
+type RpcSource struct {
+	Client       *rpc.Client
+	Verbose      bool
+	PollInterval time.Duration
+	NewBlock     chan *dbi.Block
+	MemPool      chan *dbi.Block
+	SyncDone     chan struct{}
+	Done         chan struct{}
+	height       atomic.Int32
+	stopped      bool
+	mutex        sync.Mutex
+}
+
+func (s *RpcSource) Load() error {
+	if !s.Client.Config.IsSet() {
+		return fmt.Errorf("error node rpc config for rpc block source; %w", config.NotSetError)
+	}
+	count, err := s.Client.GetBlockCount(context.Background())
+	if err != nil {
+		return fmt.Errorf("error connecting to node rpc: %s; %w", s.Client.Config, err)
+	}
+	s.height.Store(int32(count))
+	return nil
+}
+
+func (s *RpcSource) StartBlock(synced bool) {
+	go func() {
+		var tip *rpcTip
+		for !s.isStopped() {
+			var caughtUp bool
+			var err error
+			if tip, caughtUp, err = s.syncBlocks(tip); err != nil {
+				log.Printf("error syncing blocks from node rpc, retrying after %s; %v", rpcRetry, err)
+				if !s.wait(rpcRetry) {
+					return
+				}
+				continue
+			}
+			if caughtUp && !synced {
+				select {
+				case s.SyncDone <- struct{}{}:
+				case <-s.Done:
+				}
+				return
+			}
+			if !s.wait(s.PollInterval) {
+				return
+			}
+		}
+	}()
+}
+
+// syncBlocks sends blocks after the tip up to the node's tip, returning the new tip and whether it was reached.
+func (s *RpcSource) syncBlocks(tip *rpcTip) (*rpcTip, bool, error) {
+	ctx := context.Background()
+	var err error
+	if tip == nil {
+		if tip, err = getRpcStartTip(); err != nil {
+			return nil, false, fmt.Errorf("error getting start tip for rpc sync; %w", err)
+		}
+	} else if tip, err = s.checkReorg(ctx, tip); err != nil {
+		return nil, false, fmt.Errorf("error checking rpc tip for reorg; %w", err)
+	}
+	count, err := s.Client.GetBlockCount(ctx)
+	if err != nil {
+		return tip, false, fmt.Errorf("error getting block count for rpc sync; %w", err)
+	}
+	s.height.Store(int32(count))
+	for height := tip.Height + 1; height <= count; height++ {
+		block, err := s.GetBlock(ctx, height)
+		if err != nil {
+			return tip, false, fmt.Errorf("error getting block for rpc sync; %w", err)
+		}
+		if !tip.Hash.IsEqual(&chainhash.Hash{}) && block.Header.PrevBlock != tip.Hash {
+			// Node reorged since the block count, checked on the next sync.
+			return tip, false, nil
+		}
+		if !s.send(s.NewBlock, block) {
+			return tip, false, nil
+		}
+		tip = &rpcTip{Hash: block.Header.BlockHash(), Height: height}
+	}
+	return tip, tip.Height >= count, nil
+}
+
+// getRpcStartTip is the most recent saved block, or the height before the init block if none are saved yet.
+func getRpcStartTip() (*rpcTip, error) {
+	recentBlock, err := chain.GetRecentHeightBlock()
+	if err != nil {
+		return nil, fmt.Errorf("error getting recent height block; %w", err)
+	}
+	if recentBlock != nil {
+		return &rpcTip{Hash: recentBlock.BlockHash, Height: recentBlock.Height}, nil
+	}
+	return &rpcTip{Height: int64(config.GetInitBlockHeight()) - 1}, nil
+}
+
+// checkReorg walks the tip back until the node has the same block at its height, up to peer.MaxHeightBack blocks. If
+// the init block changed the tip is reset to before the init block.
+func (s *RpcSource) checkReorg(ctx context.Context, tip *rpcTip) (*rpcTip, error) {
+	for back := 0; !tip.Hash.IsEqual(&chainhash.Hash{}); back++ {
+		if back > peer.MaxHeightBack {
+			return nil, fmt.Errorf("error rpc reorg deeper than max height back: %d", peer.MaxHeightBack)
+		}
+		blockHash, err := s.Client.GetBlockHash(ctx, tip.Height)
+		if err != nil && !rpc.IsErrorCode(err, rpc.ErrorCodeInvalidParameter) {
+			return nil, fmt.Errorf("error getting block hash for rpc reorg check; %w", err)
+		}
+		if blockHash != nil && *blockHash == tip.Hash {
+			return tip, nil
+		}
+		log.Printf("Node rpc block at height %d changed, walking back from: %s\n", tip.Height, tip.Hash)
+		if initHeight := int64(config.GetInitBlockHeight()); tip.Height <= initHeight {
+			// The init block has no saved parent, sync again from the init height.
+			return &rpcTip{Height: initHeight - 1}, nil
+		}
+		heightBlock, err := chain.GetHeightBlockSingle(tip.Height - 1)
+		if err != nil {
+			return nil, fmt.Errorf("error getting parent height block for rpc reorg; %w", err)
+		}
+		tip = &rpcTip{Hash: heightBlock.BlockHash, Height: heightBlock.Height}
+	}
+	return tip, nil
+}
+
+func (s *RpcSource) StartMemPool() {
+	go func() {
+		var seen = make(map[chainhash.Hash]bool)
+		for {
+			var err error
+			if seen, err = s.syncMemPool(seen); err != nil {
+				log.Printf("error syncing mempool from node rpc; %v", err)
+			}
+			if !s.wait(s.PollInterval) {
+				return
+			}
+		}
+	}()
+}
+
+// syncMemPool sends mempool txs not seen in the previous poll, returning the txs seen in this poll.
+func (s *RpcSource) syncMemPool(seen map[chainhash.Hash]bool) (map[chainhash.Hash]bool, error) {
+	ctx := context.Background()
+	txHashes, err := s.Client.GetRawMempool(ctx)
+	if err != nil {
+		return seen, fmt.Errorf("error getting raw mempool for rpc sync; %w", err)
+	}
+	var current = make(map[chainhash.Hash]bool)
+	for _, txHash := range txHashes {
+		if seen[txHash] {
+			current[txHash] = true
+			continue
+		}
+		msgTx, err := s.Client.GetRawTransaction(ctx, txHash)
+		if err != nil {
+			// Tx can be mined or evicted after the mempool is listed, retried on the next poll if still there.
+			if s.Verbose {
+				log.Printf("error getting mempool tx from node rpc: %s; %v", txHash, err)
+			}
+			continue
+		}
+		if !s.send(s.MemPool, dbi.WireBlockToBlock(memo.GetBlockFromTxs([]*wire.MsgTx{msgTx}, nil))) {
+			return current, nil
+		}
+		current[txHash] = true
+	}
+	return current, nil
+}
+
+func (s *RpcSource) send(ch chan *dbi.Block, block *dbi.Block) bool {
+	select {
+	case ch <- block:
+		return true
+	case <-s.Done:
+		return false
+	}
+}
+
+func (s *RpcSource) wait(duration time.Duration) bool {
+	select {
+	case <-time.After(duration):
+		return true
+	case <-s.Done:
+		return false
+	}
+}
+
+func (s *RpcSource) GetNewBlock() chan *dbi.Block {
+	return s.NewBlock
+}
+
+func (s *RpcSource) GetMemPool() chan *dbi.Block {
+	return s.MemPool
+}
+
+func (s *RpcSource) GetSyncDone() chan struct{} {
+	return s.SyncDone
+}
+
+func (s *RpcSource) GetDone() chan struct{} {
+	return s.Done
+}
+
+func (s *RpcSource) GetBlockHeight() int32 {
+	return s.height.Load()
+}
+
+func (s *RpcSource) GetBlock(ctx context.Context, height int64) (*dbi.Block, error) {
+	blockHash, err := s.Client.GetBlockHash(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("error getting block hash from node rpc; %w", err)
+	}
+	msgBlock, err := s.Client.GetBlock(ctx, *blockHash)
+	if err != nil {
+		return nil, fmt.Errorf("error getting block from node rpc; %w", err)
+	}
+	return dbi.WireBlockToBlock(msgBlock), nil
+}
+
+// BroadcastTx sends a tx with sendrawtransaction and saves the broadcast result. A tx already in the chain counts as
+// accepted, a verify error is saved as rejected and returned as a RejectError.
+func (s *RpcSource) BroadcastTx(ctx context.Context, msgTx *wire.MsgTx) error {
+	var broadcastResult = &item.BroadcastResult{
+		TxHash: msgTx.TxHash(),
+		Status: item.BroadcastResultStatusAccepted,
+		Host:   s.Client.Config.String(),
+		Time:   time.Now(),
+	}
+	var returnErr error
+	err := s.Client.SendRawTransaction(ctx, msgTx)
+	var rpcError *rpc.Error
+	if err != nil && !rpc.IsErrorCode(err, rpc.ErrorCodeVerifyAlreadyInChain) {
+		if !errors.As(err, &rpcError) || (rpcError.Code != rpc.ErrorCodeVerifyRejected &&
+			rpcError.Code != rpc.ErrorCodeVerify) {
+			return fmt.Errorf("error broadcasting tx to node rpc; %w", err)
+		}
+		code, reason := parseRpcReject(rpcError.Message)
+		broadcastResult.Status = item.BroadcastResultStatusRejected
+		broadcastResult.Code = uint8(code)
+		broadcastResult.Reason = reason
+		returnErr = &peer.RejectError{
+			Host:   broadcastResult.Host,
+			TxHash: broadcastResult.TxHash,
+			Code:   code,
+			Reason: reason,
+		}
+	}
+	if err := db.Save([]db.Object{broadcastResult}); err != nil {
+		return fmt.Errorf("error saving rpc broadcast result; %w", err)
+	}
+	return returnErr
+}
+
+// parseRpcReject splits a verify error message ("16: bad-txns-...") into the reject code and reason.
+func parseRpcReject(message string) (wire.RejectCode, string) {
+	codeString, reason, found := strings.Cut(message, ": ")
+	if !found {
+		return wire.RejectInvalid, message
+	}
+	code, err := strconv.ParseUint(codeString, 10, 8)
+	if err != nil {
+		return wire.RejectInvalid, message
+	}
+	return wire.RejectCode(code), reason
+}
+
+func (s *RpcSource) isStopped() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.stopped
+}
+
+func (s *RpcSource) Stop() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.stopped {
+		return
+	}
+	s.stopped = true
+	close(s.Done)
+}
+
+func NewRpcSource(rpcConfig config.RpcConfig, verbose bool) *RpcSource {
+	return &RpcSource{
+		Client:       rpc.NewClient(rpcConfig),
+		Verbose:      verbose,
+		PollInterval: RpcDefaultPollInterval,
+		NewBlock:     make(chan *dbi.Block),
+		MemPool:      make(chan *dbi.Block),
+		SyncDone:     make(chan struct{}),
+		Done:         make(chan struct{}),
+	}
+}
@@ -0,0 +1,39 @@
+package lead
+
+import (
+	"context"
+	"fmt"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/ref/config"
+	"github.com/memocash/index/ref/dbi"
+)
+
+// BlockSource is where the lead processor gets blocks and mempool txs from and sends broadcast txs to. Blocks are
+// sent on NewBlock in order starting after the most recent saved height, SyncDone is sent once the source tip is
+// reached during initial sync. Mempool txs are sent on MemPool as blocks without a header.
+type BlockSource interface {
+	Load() error
+	StartBlock(synced bool)
+	StartMemPool()
+	GetNewBlock() chan *dbi.Block
+	GetMemPool() chan *dbi.Block
+	GetSyncDone() chan struct{}
+	GetDone() chan struct{}
+	// GetBlockHeight is the tip reported by the source, 0 if unknown.
+	GetBlockHeight() int32
+	// GetBlock fetches a block by height, used to backfill historical blocks.
+	GetBlock(ctx context.Context, height int64) (*dbi.Block, error)
+	BroadcastTx(ctx context.Context, msgTx *wire.MsgTx) error
+	Stop()
+}
+
+// NewBlockSource returns the block source for the sync source type, peer or rpc.
+func NewBlockSource(sourceType string, rpcConfig config.RpcConfig, verbose bool) (BlockSource, error) {
+	switch sourceType {
+	case "", config.SyncSourcePeer:
+		return NewPeers(verbose), nil
+	case config.SyncSourceRpc:
+		return NewRpcSource(rpcConfig, verbose), nil
+	}
+	return nil, fmt.Errorf("error unknown block source: %s", sourceType)
+}
@@ -0,0 +1,16 @@
+package config
+
+import "time"
+
+type CacheConfig struct {
+	Size        int `mapstructure:"SIZE"`         // In MB, 0 disables the cache
+	NegativeTtl int `mapstructure:"NEGATIVE_TTL"` // In seconds, 0 disables negative caching
+}
+
+func (c CacheConfig) GetSizeBytes() int {
+	return c.Size * 1024 * 1024
+}
+
+func (c CacheConfig) GetNegativeTtl() time.Duration {
+	return time.Duration(c.NegativeTtl) * time.Second
+}
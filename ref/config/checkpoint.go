@@ -0,0 +1,6 @@
+package config
+
+type Checkpoint struct {
+	Height int64  `mapstructure:"HEIGHT"`
+	Hash   string `mapstructure:"HASH"`
+}
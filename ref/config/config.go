@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"strings"
+	"time"
 )
 
 const (
@@ -28,18 +29,35 @@ const (
 	DefaultInitBlockHeight = 625306
 	DefaultBlocksToConfirm = 5
 
+	DefaultBroadcastWait = 2000
+
+	DefaultCacheSize        = 256
+	DefaultCacheNegativeTtl = 30
+
+	DefaultPeersMax          = 4
+	DefaultPeersStallTimeout = 120
+
+	DefaultSyncWindow   = 500
+	DefaultSyncParallel = 4
+	DefaultSyncBuffer   = 1000
+
 	DefaultDataDir = "db/data"
 )
 
 type Config struct {
+	Network  string `mapstructure:"NETWORK"`
 	NodeHost string `mapstructure:"NODE_HOST"`
 
+	NodeRpc RpcConfig `mapstructure:"NODE_RPC"`
+
 	InitBlock       string `mapstructure:"INIT_BLOCK"`
 	InitBlockHeight uint   `mapstructure:"INIT_BLOCK_HEIGHT"`
 	InitBlockParent string `mapstructure:"INIT_BLOCK_PARENT"`
 
 	BlocksToConfirm uint `mapstructure:"BLOCKS_TO_CONFIRM"`
 
+	Checkpoints []Checkpoint `mapstructure:"CHECKPOINTS"`
+
 	ServerHost string `mapstructure:"SERVER_HOST"`
 	ServerPort int    `mapstructure:"SERVER_PORT"`
 
@@ -50,6 +68,9 @@ type Config struct {
 	GraphQLPort   uint `mapstructure:"GRAPHQL_PORT"`
 	AdminPort     uint `mapstructure:"ADMIN_PORT"`
 	BroadcastPort int  `mapstructure:"BROADCAST_PORT"`
+	BroadcastWait int  `mapstructure:"BROADCAST_WAIT"` // In milliseconds, time to wait for a node reject after broadcast
+
+	GraphQLAllowlist bool `mapstructure:"GRAPHQL_ALLOWLIST"`
 
 	DataDir string `mapstructure:"DATA_DIR"`
 
@@ -64,9 +85,16 @@ type Config struct {
 	} `mapstructure:"PROCESS_LIMIT"`
 
 	Influx InfluxConfig `mapstructure:"INFLUX"`
+
+	Cache CacheConfig `mapstructure:"CACHE"`
+
+	Peers PeersConfig `mapstructure:"PEERS"`
+
+	Sync SyncConfig `mapstructure:"SYNC"`
 }
 
 var _config = Config{
+	Network:         NetworkMainnet,
 	NodeHost:        DefaultNodeHost,
 	InitBlock:       DefaultInitBlock,
 	InitBlockHeight: DefaultInitBlockHeight,
@@ -77,7 +105,22 @@ var _config = Config{
 	AdminPort:       DefaultAdminPort,
 	GraphQLPort:     DefaultGraphQLPort,
 	BroadcastPort:   DefaultBroadcastPort,
+	BroadcastWait:   DefaultBroadcastWait,
 	DataDir:         DefaultDataDir,
+	Cache: CacheConfig{
+		Size:        DefaultCacheSize,
+		NegativeTtl: DefaultCacheNegativeTtl,
+	},
+	Peers: PeersConfig{
+		Max:          DefaultPeersMax,
+		StallTimeout: DefaultPeersStallTimeout,
+	},
+	Sync: SyncConfig{
+		Source:   SyncSourcePeer,
+		Window:   DefaultSyncWindow,
+		Parallel: DefaultSyncParallel,
+		Buffer:   DefaultSyncBuffer,
+	},
 	QueueShards: []Shard{{
 		Shard: 0,
 		Total: 2,
@@ -127,6 +170,9 @@ func Init(cmd *cobra.Command) error {
 	if err := viper.Unmarshal(&_config); err != nil {
 		return fmt.Errorf("error unmarshalling config; %w", err)
 	}
+	if err := setNetworkDefaults(); err != nil {
+		return fmt.Errorf("error setting network defaults; %w", err)
+	}
 	if len(_config.ClusterShards) != len(_config.QueueShards) {
 		return fmt.Errorf("error config cluster shards and queue shards must be the same length")
 	}
@@ -137,6 +183,10 @@ func GetNodeHost() string {
 	return _config.NodeHost
 }
 
+func GetNodeRpc() RpcConfig {
+	return _config.NodeRpc
+}
+
 func GetInitBlock() string {
 	return _config.InitBlock
 }
@@ -153,6 +203,10 @@ func GetBlocksToConfirm() uint {
 	return _config.BlocksToConfirm
 }
 
+func GetCheckpoints() []Checkpoint {
+	return _config.Checkpoints
+}
+
 func GetQueueShards() []Shard {
 	return _config.QueueShards
 }
@@ -195,6 +249,10 @@ func GetGraphQLPort() uint {
 	return _config.GraphQLPort
 }
 
+func GetGraphQLAllowlist() bool {
+	return _config.GraphQLAllowlist
+}
+
 func GetBroadcastRpc() RpcConfig {
 	return RpcConfig{
 		Host: Localhost,
@@ -202,6 +260,10 @@ func GetBroadcastRpc() RpcConfig {
 	}
 }
 
+func GetBroadcastWait() time.Duration {
+	return time.Duration(_config.BroadcastWait) * time.Millisecond
+}
+
 func GetDataPrefix() string {
 	return _config.DataPrefix
 }
@@ -225,3 +287,15 @@ func GetDataDir() string {
 func GetInfluxConfig() InfluxConfig {
 	return _config.Influx
 }
+
+func GetCacheConfig() CacheConfig {
+	return _config.Cache
+}
+
+func GetPeersConfig() PeersConfig {
+	return _config.Peers
+}
+
+func GetSyncConfig() SyncConfig {
+	return _config.Sync
+}
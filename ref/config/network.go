@@ -0,0 +1,65 @@
+package config
+
+import (
+	"fmt"
+	"github.com/spf13/viper"
+	"path/filepath"
+)
+
+const (
+	NetworkMainnet = "mainnet"
+	NetworkTestnet = "testnet"
+	NetworkChipnet = "chipnet"
+	NetworkRegtest = "regtest"
+)
+
+type networkDefault struct {
+	NodePort  int
+	InitBlock string
+}
+
+// networkDefaults for networks other than mainnet start from genesis, init block parent is left empty.
+var networkDefaults = map[string]networkDefault{
+	NetworkTestnet: {
+		NodePort:  18333,
+		InitBlock: "000000000933ea01ad0ee984209779baaec3ced90fa3f408719526f8d77f4943",
+	},
+	NetworkChipnet: {
+		NodePort:  48333,
+		InitBlock: "000000001dd410c49a788668ce26751718cc797474d3152a5fc073dd44fd9f7b",
+	},
+	NetworkRegtest: {
+		NodePort:  18444,
+		InitBlock: "0f9188f13cb7b2c71f2a335e3a4fc328bf5beb436012afca590b1a11466e2206",
+	},
+}
+
+// setNetworkDefaults replaces mainnet defaults for values not set in the config file or environment.
+func setNetworkDefaults() error {
+	if _config.Network == "" {
+		_config.Network = NetworkMainnet
+	}
+	if _config.Network == NetworkMainnet {
+		return nil
+	}
+	defaults, ok := networkDefaults[_config.Network]
+	if !ok {
+		return fmt.Errorf("error unknown network: %s", _config.Network)
+	}
+	if !viper.IsSet("NODE_HOST") {
+		_config.NodeHost = fmt.Sprintf("localhost:%d", defaults.NodePort)
+	}
+	if !viper.IsSet("INIT_BLOCK") && !viper.IsSet("INIT_BLOCK_PARENT") && !viper.IsSet("INIT_BLOCK_HEIGHT") {
+		_config.InitBlock = defaults.InitBlock
+		_config.InitBlockParent = ""
+		_config.InitBlockHeight = 0
+	}
+	if !viper.IsSet("DATA_DIR") {
+		_config.DataDir = filepath.Join(DefaultDataDir, _config.Network)
+	}
+	return nil
+}
+
+func GetNetwork() string {
+	return _config.Network
+}
@@ -0,0 +1,28 @@
+package config
+
+import "time"
+
+type PeersConfig struct {
+	Hosts        []string `mapstructure:"HOSTS"`         // Upstream peers in addition to NODE_HOST
+	Max          int      `mapstructure:"MAX"`           // Max upstream peers connected at once
+	UseFound     bool     `mapstructure:"USE_FOUND"`     // Include peers found by the node group
+	StallTimeout int      `mapstructure:"STALL_TIMEOUT"` // In seconds, block peer replaced if no block received
+}
+
+func (p PeersConfig) GetStallTimeout() time.Duration {
+	return time.Duration(p.StallTimeout) * time.Second
+}
+
+// GetHosts returns the configured upstream peers, NODE_HOST first, without duplicates.
+func (p PeersConfig) GetHosts() []string {
+	var hosts []string
+	var seen = make(map[string]bool)
+	for _, host := range append([]string{GetNodeHost()}, p.Hosts...) {
+		if host == "" || seen[host] {
+			continue
+		}
+		seen[host] = true
+		hosts = append(hosts, host)
+	}
+	return hosts
+}
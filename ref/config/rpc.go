@@ -5,8 +5,10 @@ import (
 )
 
 type RpcConfig struct {
-	Host string
-	Port int
+	Host     string `mapstructure:"HOST"`
+	Port     int    `mapstructure:"PORT"`
+	User     string `mapstructure:"USER"`
+	Password string `mapstructure:"PASSWORD"`
 }
 
 func (r RpcConfig) String() string {
@@ -0,0 +1,13 @@
+package config
+
+const (
+	SyncSourcePeer = "peer"
+	SyncSourceRpc  = "rpc"
+)
+
+type SyncConfig struct {
+	Source   string `mapstructure:"SOURCE"`   // Block source, peer (P2P, default) or rpc (NODE_RPC JSON-RPC)
+	Window   int    `mapstructure:"WINDOW"`   // Max blocks requested from the block peer but not yet received
+	Parallel int    `mapstructure:"PARALLEL"` // Max blocks saving to shards concurrently during initial sync
+	Buffer   int    `mapstructure:"BUFFER"`   // Max blocks received and waiting to be saved during initial sync
+}
@@ -18,6 +18,10 @@ type BlockSave interface {
 	GetBlock(int64) (*chainhash.Hash, error)
 }
 
+type HeaderCheck interface {
+	CheckHeader(wire.BlockHeader) error
+}
+
 type BlockHeightSave interface {
 	SaveHeights([]*BlockHeight) error
 }
@@ -367,6 +367,7 @@ func (s *Server) Start() error {
 	s.grpc = grpc.NewServer()
 	network_pb.RegisterNetworkServer(s.grpc, s)
 	reflection.Register(s.grpc)
+	db.InitCache()
 	return nil
 }
 
@@ -0,0 +1,146 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	graph "github.com/memocash/index/graph/server"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/broadcast/broadcast_server"
+	"github.com/memocash/index/ref/cluster/lead"
+	"github.com/memocash/index/ref/cluster/shard"
+	"github.com/memocash/index/ref/config"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Cluster runs the cluster shards (including their queue servers), the GraphQL server and the lead processor in
+// process. The servers can't be stopped, a cluster suite should be the only one run by a process.
+type Cluster struct {
+	Shards    []*shard.Shard
+	Graph     *graph.Server
+	Processor *lead.Processor
+	Broadcast *broadcast_server.Server
+	Verbose   bool
+	err       error
+	errMutex  sync.Mutex
+}
+
+func (c *Cluster) Start() error {
+	for _, shardConfig := range config.GetClusterShards() {
+		clusterShard := shard.NewShard(int(shardConfig.Shard), c.Verbose)
+		if err := clusterShard.Start(); err != nil {
+			return fmt.Errorf("error starting test cluster shard %d; %w", shardConfig.Shard, err)
+		}
+		go func() {
+			c.setError(fmt.Errorf("error running test cluster shard %d; %w", clusterShard.Id, clusterShard.Serve()))
+		}()
+		c.Shards = append(c.Shards, clusterShard)
+	}
+	c.Graph = graph.NewServer()
+	if err := c.Graph.Start(); err != nil {
+		return fmt.Errorf("error starting test graph server; %w", err)
+	}
+	log.Printf("Test GraphQL server started at: %s...\n", c.Graph.GetHost())
+	go func() {
+		c.setError(fmt.Errorf("error running test graph server; %w", c.Graph.Serve()))
+	}()
+	return nil
+}
+
+// StartProcessor starts the lead processor syncing from the configured upstream peers and the broadcast server
+// sending txs to them.
+func (c *Cluster) StartProcessor() {
+	c.startProcessor(lead.NewProcessor(c.Verbose))
+}
+
+// StartRpcProcessor starts the lead processor syncing from a node JSON-RPC server instead of peers.
+func (c *Cluster) StartRpcProcessor(rpcConfig config.RpcConfig) {
+	processor := lead.NewProcessor(c.Verbose)
+	processor.SourceType = config.SyncSourceRpc
+	processor.Rpc = rpcConfig
+	c.startProcessor(processor)
+}
+
+func (c *Cluster) startProcessor(processor *lead.Processor) {
+	c.Processor = processor
+	go func() {
+		c.setError(fmt.Errorf("error running test lead processor; %w", c.Processor.Run()))
+	}()
+	c.Broadcast = broadcast_server.NewServer(config.GetBroadcastRpc().Port, func(ctx context.Context, raw []byte) error {
+		txMsg, err := memo.GetMsgFromRaw(raw)
+		if err != nil {
+			return fmt.Errorf("error parsing raw tx; %w", err)
+		}
+		if err := c.Processor.BroadcastTx(ctx, txMsg); err != nil {
+			return fmt.Errorf("error broadcasting tx upstream; %w", err)
+		}
+		return nil
+	})
+	go func() {
+		c.setError(fmt.Errorf("error running test broadcast server; %w", c.Broadcast.Run()))
+	}()
+}
+
+func (c *Cluster) setError(err error) {
+	c.errMutex.Lock()
+	defer c.errMutex.Unlock()
+	log.Printf("test cluster error; %v", err)
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+func (c *Cluster) GetError() error {
+	c.errMutex.Lock()
+	defer c.errMutex.Unlock()
+	return c.err
+}
+
+// Query posts a GraphQL query to the test graph server and unmarshals the response data into data.
+func (c *Cluster) Query(query string, variables map[string]interface{}, data interface{}) error {
+	jsonValue, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return fmt.Errorf("error marshaling test graph query; %w", err)
+	}
+	url := fmt.Sprintf("http://%s:%d/graphql", config.Localhost, c.Graph.Port)
+	client := &http.Client{Timeout: 10 * time.Second}
+	response, err := client.Post(url, "application/json", bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return fmt.Errorf("error test graph query request failed; %w", err)
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("error reading test graph query response; %w", err)
+	}
+	var result = struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}{}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("error unmarshalling test graph query response: %s; %w", body, err)
+	}
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("error test graph query response: %s", result.Errors[0].Message)
+	}
+	if err := json.Unmarshal(result.Data, data); err != nil {
+		return fmt.Errorf("error unmarshalling test graph query data; %w", err)
+	}
+	return nil
+}
+
+func NewCluster(verbose bool) *Cluster {
+	return &Cluster{
+		Verbose: verbose,
+	}
+}
@@ -0,0 +1,286 @@
+package node
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/jchavannes/btcd/blockchain"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/btcd/txscript"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/jchavannes/btcutil"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/bitcoin/pow"
+	"github.com/memocash/index/ref/bitcoin/tx/gen"
+	"github.com/memocash/index/ref/bitcoin/tx/script"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"sync"
+	"time"
+)
+
+const (
+	CoinbaseValue = 50 * 1e8
+	OrphanHeight  = -1
+
+	blockVersion = 4
+)
+
+type Block struct {
+	Msg    *wire.MsgBlock
+	Hash   chainhash.Hash
+	Height int64
+}
+
+// Chain is a scripted regtest block chain served by a simulated Node. Blocks are mined on top of any known block so
+// tests can create forks, the tip is the highest block.
+type Chain struct {
+	Key      wallet.PrivateKey
+	Params   *pow.Params
+	Genesis  *Block
+	Tip      *Block
+	Blocks   map[chainhash.Hash]*Block
+	Mempool  []*wire.MsgTx
+	utxos    []memo.UTXO
+	coinbase int64
+	mutex    sync.Mutex
+}
+
+// Generate mines count blocks on the tip, the first block includes the mempool txs.
+func (c *Chain) Generate(count int) ([]*Block, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var blocks []*Block
+	for i := 0; i < count; i++ {
+		txs := c.Mempool
+		c.Mempool = nil
+		block, err := c.mine(c.Tip, txs)
+		if err != nil {
+			return nil, fmt.Errorf("error mining generated block; %w", err)
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// Fork mines count blocks starting from a parent that does not need to be the tip.
+func (c *Chain) Fork(parentHash chainhash.Hash, count int, txs ...*wire.MsgTx) ([]*Block, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	parent, ok := c.Blocks[parentHash]
+	if !ok {
+		return nil, fmt.Errorf("error fork parent block not found: %s", parentHash)
+	}
+	var blocks []*Block
+	for i := 0; i < count; i++ {
+		block, err := c.mine(parent, txs)
+		if err != nil {
+			return nil, fmt.Errorf("error mining fork block; %w", err)
+		}
+		blocks = append(blocks, block)
+		parent = block
+		txs = nil
+	}
+	return blocks, nil
+}
+
+// Orphan mines a block with a parent the chain does not know about. The block can be requested from the node but is
+// never part of the chain.
+func (c *Chain) Orphan(txs ...*wire.MsgTx) (*Block, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var parent = &Block{
+		Hash:   chainhash.DoubleHashH([]byte(fmt.Sprintf("orphan-parent-%d", time.Now().UnixNano()))),
+		Height: OrphanHeight - 1,
+	}
+	parent.Msg = &wire.MsgBlock{Header: c.Tip.Msg.Header}
+	block, err := c.mine(parent, txs)
+	if err != nil {
+		return nil, fmt.Errorf("error mining orphan block; %w", err)
+	}
+	return block, nil
+}
+
+func (c *Chain) mine(parent *Block, txs []*wire.MsgTx) (*Block, error) {
+	height := parent.Height + 1
+	coinbase, err := c.getCoinbase(height)
+	if err != nil {
+		return nil, fmt.Errorf("error getting coinbase for block; %w", err)
+	}
+	var msgBlock = &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:   blockVersion,
+			PrevBlock: parent.Hash,
+			Timestamp: time.Unix(time.Now().Unix(), 0),
+			Bits:      c.Params.PowLimitBits,
+		},
+		Transactions: append([]*wire.MsgTx{coinbase}, txs...),
+	}
+	if !msgBlock.Header.Timestamp.After(parent.Msg.Header.Timestamp) {
+		msgBlock.Header.Timestamp = parent.Msg.Header.Timestamp.Add(time.Second)
+	}
+	var utilTxs = make([]*btcutil.Tx, len(msgBlock.Transactions))
+	for i, msgTx := range msgBlock.Transactions {
+		utilTxs[i] = btcutil.NewTx(msgTx)
+	}
+	merkles := blockchain.BuildMerkleTreeStore(utilTxs)
+	msgBlock.Header.MerkleRoot = *merkles[len(merkles)-1]
+	for pow.CheckProofOfWork(msgBlock.Header, c.Params) != nil {
+		msgBlock.Header.Nonce++
+	}
+	var block = &Block{
+		Msg:    msgBlock,
+		Hash:   msgBlock.BlockHash(),
+		Height: height,
+	}
+	c.Blocks[block.Hash] = block
+	if height > c.Tip.Height {
+		c.Tip = block
+	}
+	c.removeMempool(txs)
+	if height == OrphanHeight {
+		return block, nil
+	}
+	coinbaseHash := coinbase.TxHash()
+	c.utxos = append(c.utxos, memo.UTXO{Input: memo.TxInput{
+		PkScript:    coinbase.TxOut[0].PkScript,
+		PkHash:      c.Key.GetPkHash(),
+		Value:       coinbase.TxOut[0].Value,
+		PrevOutHash: coinbaseHash[:],
+	}})
+	return block, nil
+}
+
+// getCoinbase pays the block reward to the chain key, a counter in the unlock script keeps coinbase hashes unique
+// across forks at the same height.
+func (c *Chain) getCoinbase(height int64) (*wire.MsgTx, error) {
+	c.coinbase++
+	unlockScript, err := txscript.NewScriptBuilder().AddInt64(height).AddInt64(c.coinbase).Script()
+	if err != nil {
+		return nil, fmt.Errorf("error building coinbase unlock script; %w", err)
+	}
+	lockScript, err := script.P2pkh{PkHash: c.Key.GetPkHash()}.Get()
+	if err != nil {
+		return nil, fmt.Errorf("error building coinbase lock script; %w", err)
+	}
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{}, wire.MaxPrevOutIndex),
+		SignatureScript:  unlockScript,
+		Sequence:         wire.MaxTxInSequenceNum,
+	})
+	msgTx.AddTxOut(wire.NewTxOut(CoinbaseValue, lockScript))
+	return msgTx, nil
+}
+
+func (c *Chain) removeMempool(txs []*wire.MsgTx) {
+	for i := 0; i < len(c.Mempool); i++ {
+		for _, tx := range txs {
+			if c.Mempool[i].TxHash() == tx.TxHash() {
+				c.Mempool = append(c.Mempool[:i], c.Mempool[i+1:]...)
+				i--
+				break
+			}
+		}
+	}
+}
+
+// NewTx builds a tx with the outputs funded by the chain key, change is kept for future txs. The tx is not added to
+// the mempool.
+func (c *Chain) NewTx(outputs []*memo.Output) (*wire.MsgTx, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if len(c.utxos) == 0 {
+		return nil, fmt.Errorf("error no utxos available for new tx, generate a block first")
+	}
+	utxo := c.utxos[0]
+	memoTx, err := gen.Tx(gen.TxRequest{
+		InputsToUse: []memo.UTXO{utxo},
+		Outputs:     outputs,
+		Change:      wallet.GetChange(c.Key.GetAddress()),
+		KeyRing:     wallet.GetSingleKeyRing(c.Key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error generating new tx for chain; %w", err)
+	}
+	c.utxos = c.utxos[1:]
+	for _, output := range script.GetOutputUTXOs(memoTx) {
+		if bytes.Equal(output.Input.PkHash, c.Key.GetPkHash()) {
+			c.utxos = append(c.utxos, output)
+		}
+	}
+	return memoTx.MsgTx, nil
+}
+
+func (c *Chain) NewPost(message string) (*wire.MsgTx, error) {
+	msgTx, err := c.NewTx([]*memo.Output{{Script: &script.Post{Message: message}}})
+	if err != nil {
+		return nil, fmt.Errorf("error generating post tx; %w", err)
+	}
+	return msgTx, nil
+}
+
+func (c *Chain) AddMempool(txs ...*wire.MsgTx) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.Mempool = append(c.Mempool, txs...)
+}
+
+func (c *Chain) GetBlock(blockHash chainhash.Hash) *Block {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.Blocks[blockHash]
+}
+
+func (c *Chain) GetTip() *Block {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.Tip
+}
+
+func (c *Chain) GetMempoolTx(txHash chainhash.Hash) *wire.MsgTx {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, tx := range c.Mempool {
+		if tx.TxHash() == txHash {
+			return tx
+		}
+	}
+	return nil
+}
+
+func (c *Chain) GetMempool() []*wire.MsgTx {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]*wire.MsgTx{}, c.Mempool...)
+}
+
+// GetMainChain returns the blocks from genesis to the tip.
+func (c *Chain) GetMainChain() []*Block {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var blocks = make([]*Block, c.Tip.Height+1)
+	for block := c.Tip; block != nil; block = c.Blocks[block.Msg.Header.PrevBlock] {
+		blocks[block.Height] = block
+	}
+	return blocks
+}
+
+// NewChain returns a chain containing only the genesis block, blocks can only be mined on regtest.
+func NewChain() (*Chain, error) {
+	if wallet.GetNetwork() != wallet.NetworkRegtest {
+		return nil, fmt.Errorf("error simulated chain requires network %s, current network: %s",
+			wallet.NetworkRegtest, wallet.GetNetwork())
+	}
+	params := pow.GetNetworkParams(wallet.NetworkRegtest)
+	genesisBlock := wallet.GetNetParams().GenesisBlock
+	var genesis = &Block{
+		Msg:  genesisBlock,
+		Hash: genesisBlock.BlockHash(),
+	}
+	return &Chain{
+		Key:     wallet.GeneratePrivateKey(),
+		Params:  params,
+		Genesis: genesis,
+		Tip:     genesis,
+		Blocks:  map[chainhash.Hash]*Block{genesis.Hash: genesis},
+	}, nil
+}
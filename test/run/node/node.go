@@ -0,0 +1,268 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/ref/bitcoin/wallet"
+	"github.com/memocash/index/ref/config"
+	"io"
+	"log"
+	"math/rand"
+	"net"
+	"sync"
+)
+
+const (
+	UserAgent     = "/memo-test-node:0.1.0/"
+	maxHeadersMsg = 2000
+)
+
+// Node is a simulated full node speaking the bitcoin wire protocol on a local TCP listener. It serves headers, blocks
+// and mempool txs from a scripted Chain and announces new blocks and txs to connected peers when told to.
+type Node struct {
+	Chain   *Chain
+	Host    string
+	Verbose bool
+	// Reject returns a reject message for a tx sent to the node, accepted txs are added to the mempool.
+	Reject   func(tx *wire.MsgTx) *wire.MsgReject
+	listener net.Listener
+	conns    map[*conn]struct{}
+	mutex    sync.Mutex
+}
+
+type conn struct {
+	net.Conn
+	node    *Node
+	pver    uint32
+	verAck  bool
+	writeMu sync.Mutex
+}
+
+func (n *Node) Start() error {
+	var err error
+	if n.listener, err = net.Listen("tcp", n.Host); err != nil {
+		return fmt.Errorf("error listening simulated node; %w", err)
+	}
+	log.Printf("Started simulated node on: %s\n", n.Host)
+	go func() {
+		for {
+			netConn, err := n.listener.Accept()
+			if err != nil {
+				if !errors.Is(err, net.ErrClosed) {
+					log.Printf("error accepting simulated node connection; %v", err)
+				}
+				return
+			}
+			c := &conn{Conn: netConn, node: n, pver: wire.ProtocolVersion}
+			n.mutex.Lock()
+			n.conns[c] = struct{}{}
+			n.mutex.Unlock()
+			go c.run()
+		}
+	}()
+	return nil
+}
+
+func (n *Node) Stop() {
+	if n.listener != nil {
+		n.listener.Close()
+	}
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	for c := range n.conns {
+		c.Close()
+	}
+}
+
+func (n *Node) GetConnCount() int {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	var count int
+	for c := range n.conns {
+		if c.verAck {
+			count++
+		}
+	}
+	return count
+}
+
+// AnnounceBlocks sends an inv for each block to all connected peers, in order.
+func (n *Node) AnnounceBlocks(blocks ...*Block) {
+	msgInv := wire.NewMsgInv()
+	for _, block := range blocks {
+		msgInv.AddInvVect(wire.NewInvVect(wire.InvTypeBlock, &block.Hash))
+	}
+	n.broadcast(msgInv)
+}
+
+// AnnounceTxs sends an inv for the txs to all connected peers.
+func (n *Node) AnnounceTxs(txs ...*wire.MsgTx) {
+	msgInv := wire.NewMsgInv()
+	for _, tx := range txs {
+		txHash := tx.TxHash()
+		msgInv.AddInvVect(wire.NewInvVect(wire.InvTypeTx, &txHash))
+	}
+	n.broadcast(msgInv)
+}
+
+func (n *Node) broadcast(msg wire.Message) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	for c := range n.conns {
+		if c.verAck {
+			c.write(msg)
+		}
+	}
+}
+
+func (c *conn) run() {
+	defer func() {
+		c.Close()
+		c.node.mutex.Lock()
+		delete(c.node.conns, c)
+		c.node.mutex.Unlock()
+	}()
+	for {
+		msg, _, err := wire.ReadMessage(c, c.pver, wallet.GetNetParams().Net)
+		if err != nil {
+			var messageError *wire.MessageError
+			if errors.As(err, &messageError) {
+				continue
+			}
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				log.Printf("error reading simulated node message; %v", err)
+			}
+			return
+		}
+		if c.node.Verbose {
+			log.Printf("Simulated node received: %s\n", msg.Command())
+		}
+		switch msg := msg.(type) {
+		case *wire.MsgVersion:
+			c.onVersion(msg)
+		case *wire.MsgVerAck:
+			c.node.mutex.Lock()
+			c.verAck = true
+			c.node.mutex.Unlock()
+		case *wire.MsgPing:
+			c.write(wire.NewMsgPong(msg.Nonce))
+		case *wire.MsgGetHeaders:
+			c.onGetHeaders(msg)
+		case *wire.MsgGetData:
+			c.onGetData(msg)
+		case *wire.MsgMemPool:
+			c.onMemPool()
+		case *wire.MsgTx:
+			c.onTx(msg)
+		}
+	}
+}
+
+func (c *conn) write(msg wire.Message) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := wire.WriteMessage(c, msg, c.pver, wallet.GetNetParams().Net); err != nil {
+		log.Printf("error writing simulated node message: %s; %v", msg.Command(), err)
+	}
+}
+
+func (c *conn) onVersion(msg *wire.MsgVersion) {
+	if msg.ProtocolVersion < int32(c.pver) {
+		c.pver = uint32(msg.ProtocolVersion)
+	}
+	me := wire.NewNetAddress(c.LocalAddr().(*net.TCPAddr), wire.SFNodeNetwork)
+	you := wire.NewNetAddress(c.RemoteAddr().(*net.TCPAddr), msg.Services)
+	msgVersion := wire.NewMsgVersion(me, you, rand.Uint64(), int32(c.node.Chain.GetTip().Height))
+	msgVersion.UserAgent = UserAgent
+	msgVersion.Services = wire.SFNodeNetwork
+	msgVersion.ProtocolVersion = int32(c.pver)
+	c.write(msgVersion)
+	c.write(wire.NewMsgVerAck())
+}
+
+// onGetHeaders sends main chain headers after the first known locator hash, starting after genesis if none are known.
+func (c *conn) onGetHeaders(msg *wire.MsgGetHeaders) {
+	mainChain := c.node.Chain.GetMainChain()
+	var heights = make(map[chainhash.Hash]int)
+	for height, block := range mainChain {
+		heights[block.Hash] = height
+	}
+	var start = 1
+	for _, locator := range msg.BlockLocatorHashes {
+		if height, ok := heights[*locator]; ok {
+			start = height + 1
+			break
+		}
+	}
+	if start > len(mainChain) {
+		start = len(mainChain)
+	}
+	msgHeaders := wire.NewMsgHeaders()
+	for _, block := range mainChain[start:] {
+		header := block.Msg.Header
+		msgHeaders.AddBlockHeader(&header)
+		if block.Hash == msg.HashStop || len(msgHeaders.Headers) == maxHeadersMsg {
+			break
+		}
+	}
+	c.write(msgHeaders)
+}
+
+func (c *conn) onGetData(msg *wire.MsgGetData) {
+	msgNotFound := wire.NewMsgNotFound()
+	for _, invVect := range msg.InvList {
+		switch invVect.Type {
+		case wire.InvTypeBlock:
+			if block := c.node.Chain.GetBlock(invVect.Hash); block != nil {
+				c.write(block.Msg)
+				continue
+			}
+		case wire.InvTypeTx:
+			if tx := c.node.Chain.GetMempoolTx(invVect.Hash); tx != nil {
+				c.write(tx)
+				continue
+			}
+		}
+		msgNotFound.AddInvVect(invVect)
+	}
+	if len(msgNotFound.InvList) > 0 {
+		c.write(msgNotFound)
+	}
+}
+
+func (c *conn) onTx(msg *wire.MsgTx) {
+	if c.node.Reject != nil {
+		if msgReject := c.node.Reject(msg); msgReject != nil {
+			c.write(msgReject)
+			return
+		}
+	}
+	if c.node.Chain.GetMempoolTx(msg.TxHash()) == nil {
+		c.node.Chain.AddMempool(msg)
+	}
+}
+
+func (c *conn) onMemPool() {
+	mempool := c.node.Chain.GetMempool()
+	if len(mempool) == 0 {
+		return
+	}
+	msgInv := wire.NewMsgInv()
+	for _, tx := range mempool {
+		txHash := tx.TxHash()
+		msgInv.AddInvVect(wire.NewInvVect(wire.InvTypeTx, &txHash))
+	}
+	c.write(msgInv)
+}
+
+// NewNode returns a simulated node for the chain listening on the configured NODE_HOST.
+func NewNode(chain *Chain, verbose bool) *Node {
+	return &Node{
+		Chain:   chain,
+		Host:    config.GetNodeHost(),
+		Verbose: verbose,
+		conns:   make(map[*conn]struct{}),
+	}
+}
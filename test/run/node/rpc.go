@@ -0,0 +1,203 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/node/rpc"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/ref/config"
+	"log"
+	"net"
+	"net/http"
+)
+
+const (
+	RpcUser     = "test"
+	RpcPassword = "test"
+
+	rpcErrorCodeNotFound = -5
+)
+
+type rpcRequest struct {
+	Id     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// RpcServer is a stub node JSON-RPC server serving the same scripted Chain as a simulated Node. Only the methods
+// used by the lead processor RPC block source are implemented.
+type RpcServer struct {
+	Chain   *Chain
+	Verbose bool
+	// Reject returns a reject message for a tx sent with sendrawtransaction, accepted txs are added to the mempool.
+	Reject   func(tx *wire.MsgTx) *wire.MsgReject
+	listener net.Listener
+	server   *http.Server
+}
+
+func (s *RpcServer) Start() error {
+	var err error
+	if s.listener, err = net.Listen("tcp", net.JoinHostPort(config.Localhost, "0")); err != nil {
+		return fmt.Errorf("error listening stub rpc server; %w", err)
+	}
+	s.server = &http.Server{Handler: http.HandlerFunc(s.handle)}
+	log.Printf("Started stub rpc server on: %s\n", s.listener.Addr())
+	go func() {
+		if err := s.server.Serve(s.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("error serving stub rpc server; %v", err)
+		}
+	}()
+	return nil
+}
+
+func (s *RpcServer) Stop() {
+	if s.server != nil {
+		s.server.Close()
+	}
+}
+
+// GetConfig returns the rpc config for connecting to the server once started.
+func (s *RpcServer) GetConfig() config.RpcConfig {
+	return config.RpcConfig{
+		Host:     config.Localhost,
+		Port:     s.listener.Addr().(*net.TCPAddr).Port,
+		User:     RpcUser,
+		Password: RpcPassword,
+	}
+}
+
+func (s *RpcServer) handle(w http.ResponseWriter, r *http.Request) {
+	if user, password, ok := r.BasicAuth(); !ok || user != RpcUser || password != RpcPassword {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	var req rpcRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("error decoding stub rpc request; %v", err), http.StatusBadRequest)
+		return
+	}
+	if s.Verbose {
+		log.Printf("Stub rpc server received: %s\n", req.Method)
+	}
+	result, rpcError := s.call(req)
+	var resp = struct {
+		Result interface{}     `json:"result"`
+		Error  *rpc.Error      `json:"error"`
+		Id     json.RawMessage `json:"id"`
+	}{Result: result, Error: rpcError, Id: req.Id}
+	if rpcError != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Printf("error encoding stub rpc response; %v", err)
+	}
+}
+
+func (s *RpcServer) call(req rpcRequest) (interface{}, *rpc.Error) {
+	switch req.Method {
+	case "getblockcount":
+		return s.Chain.GetTip().Height, nil
+	case "getbestblockhash":
+		return s.Chain.GetTip().Hash.String(), nil
+	case "getblockhash":
+		var height int64
+		if err := getRpcParam(req, 0, &height); err != nil {
+			return nil, err
+		}
+		mainChain := s.Chain.GetMainChain()
+		if height < 0 || height >= int64(len(mainChain)) {
+			return nil, &rpc.Error{Code: rpc.ErrorCodeInvalidParameter, Message: "Block height out of range"}
+		}
+		return mainChain[height].Hash.String(), nil
+	case "getblock":
+		blockHash, err := getRpcHashParam(req)
+		if err != nil {
+			return nil, err
+		}
+		block := s.Chain.GetBlock(*blockHash)
+		if block == nil {
+			return nil, &rpc.Error{Code: rpcErrorCodeNotFound, Message: "Block not found"}
+		}
+		var buf bytes.Buffer
+		if err := block.Msg.Serialize(&buf); err != nil {
+			return nil, &rpc.Error{Code: rpcErrorCodeNotFound, Message: err.Error()}
+		}
+		return hex.EncodeToString(buf.Bytes()), nil
+	case "getrawmempool":
+		var txHashes = []string{}
+		for _, tx := range s.Chain.GetMempool() {
+			txHashes = append(txHashes, tx.TxHash().String())
+		}
+		return txHashes, nil
+	case "getrawtransaction":
+		txHash, err := getRpcHashParam(req)
+		if err != nil {
+			return nil, err
+		}
+		msgTx := s.Chain.GetMempoolTx(*txHash)
+		if msgTx == nil {
+			return nil, &rpc.Error{Code: rpcErrorCodeNotFound, Message: "No such mempool transaction"}
+		}
+		return hex.EncodeToString(memo.GetRaw(msgTx)), nil
+	case "sendrawtransaction":
+		var rawHex string
+		if err := getRpcParam(req, 0, &rawHex); err != nil {
+			return nil, err
+		}
+		raw, err := hex.DecodeString(rawHex)
+		if err != nil {
+			return nil, &rpc.Error{Code: rpc.ErrorCodeInvalidParameter, Message: "TX decode failed"}
+		}
+		msgTx, err := memo.GetMsgFromRaw(raw)
+		if err != nil {
+			return nil, &rpc.Error{Code: rpc.ErrorCodeInvalidParameter, Message: "TX decode failed"}
+		}
+		if s.Reject != nil {
+			if msgReject := s.Reject(msgTx); msgReject != nil {
+				return nil, &rpc.Error{
+					Code:    rpc.ErrorCodeVerifyRejected,
+					Message: fmt.Sprintf("%d: %s", msgReject.Code, msgReject.Reason),
+				}
+			}
+		}
+		if s.Chain.GetMempoolTx(msgTx.TxHash()) == nil {
+			s.Chain.AddMempool(msgTx)
+		}
+		return msgTx.TxHash().String(), nil
+	}
+	return nil, &rpc.Error{Code: -32601, Message: "Method not found"}
+}
+
+func getRpcParam(req rpcRequest, index int, v interface{}) *rpc.Error {
+	if index >= len(req.Params) {
+		return &rpc.Error{Code: rpc.ErrorCodeInvalidParameter, Message: "Missing parameter"}
+	}
+	if err := json.Unmarshal(req.Params[index], v); err != nil {
+		return &rpc.Error{Code: rpc.ErrorCodeInvalidParameter, Message: err.Error()}
+	}
+	return nil
+}
+
+func getRpcHashParam(req rpcRequest) (*chainhash.Hash, *rpc.Error) {
+	var hashString string
+	if err := getRpcParam(req, 0, &hashString); err != nil {
+		return nil, err
+	}
+	hash, err := chainhash.NewHashFromStr(hashString)
+	if err != nil {
+		return nil, &rpc.Error{Code: rpc.ErrorCodeInvalidParameter, Message: err.Error()}
+	}
+	return hash, nil
+}
+
+func NewRpcServer(chain *Chain, verbose bool) *RpcServer {
+	return &RpcServer{
+		Chain:   chain,
+		Verbose: verbose,
+	}
+}
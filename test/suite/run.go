@@ -2,11 +2,15 @@ package suite
 
 import (
 	"fmt"
+	"github.com/memocash/index/test/run"
 	"log"
 )
 
 func Run(test *Test, args []string) error {
 	s := GetNewSuite()
+	if test.Cluster {
+		s.Cluster = run.NewCluster(false)
+	}
 	err := s.Start()
 	defer s.EndPrint()
 	if err != nil {
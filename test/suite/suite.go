@@ -10,8 +10,9 @@ import (
 )
 
 type Suite struct {
-	Queue0 *run.Queue
-	Queue1 *run.Queue
+	Queue0  *run.Queue
+	Queue1  *run.Queue
+	Cluster *run.Cluster
 }
 
 func (s *Suite) ClearData() error {
@@ -25,6 +26,12 @@ func (s *Suite) Start() error {
 	if err := s.ClearData(); err != nil {
 		return fmt.Errorf("error clearing data when starting suite; %w", err)
 	}
+	if s.Cluster != nil {
+		if err := s.Cluster.Start(); err != nil {
+			return fmt.Errorf("error starting cluster when starting suite; %w", err)
+		}
+		return nil
+	}
 	shards := config.GetQueueShards()
 	if len(shards) != 2 {
 		return fmt.Errorf("expected 2 shards, got %d", len(shards))
@@ -60,6 +67,11 @@ func (s *Suite) EndPrint() {
 }
 
 func (s *Suite) End() error {
+	if s.Cluster != nil {
+		if err := s.Cluster.GetError(); err != nil {
+			return fmt.Errorf("server test suite cluster error; %w", err)
+		}
+	}
 	if s.Queue0 != nil {
 		if s.Queue0.End(); s.Queue0.Error != nil {
 			return fmt.Errorf("server test suite queue0 error; %w", s.Queue0.Error)
@@ -8,6 +8,8 @@ type TestRequest struct {
 type Test struct {
 	Name string
 	Test func(*TestRequest) error
+	// Cluster runs the test against in process cluster shards and a GraphQL server instead of queue servers.
+	Cluster bool
 }
 
 func Tests(routeSets ...[]Test) []Test {
@@ -0,0 +1,124 @@
+package tasks
+
+import (
+	"encoding/hex"
+	"fmt"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/ref/bitcoin/memo"
+	"github.com/memocash/index/test/run/node"
+	"github.com/memocash/index/test/suite"
+	"strings"
+)
+
+const broadcastRejectReason = "bad-txns-test-reject"
+
+type broadcastGraphResult struct {
+	TxHash string `json:"tx_hash"`
+	Status string `json:"status"`
+	Code   int    `json:"code"`
+	Reason string `json:"reason"`
+}
+
+func (s *syncTest) broadcast(msgTx *wire.MsgTx) error {
+	const mutation = `mutation ($raw: String!) {
+		broadcast(raw: $raw)
+	}`
+	var data struct {
+		Broadcast bool `json:"broadcast"`
+	}
+	if err := s.Request.Suite.Cluster.Query(mutation, map[string]interface{}{
+		"raw": hex.EncodeToString(memo.GetRaw(msgTx)),
+	}, &data); err != nil {
+		return fmt.Errorf("error graph broadcast mutation; %w", err)
+	}
+	if !data.Broadcast {
+		return fmt.Errorf("error graph broadcast mutation returned false")
+	}
+	return nil
+}
+
+func (s *syncTest) getBroadcastResult(txHash chainhash.Hash) (*broadcastGraphResult, error) {
+	const query = `query ($hash: Hash!) {
+		broadcast_result(hash: $hash) {
+			tx_hash
+			status
+			code
+			reason
+		}
+	}`
+	var data struct {
+		BroadcastResult *broadcastGraphResult `json:"broadcast_result"`
+	}
+	if err := s.Request.Suite.Cluster.Query(query, map[string]interface{}{
+		"hash": txHash.String(),
+	}, &data); err != nil {
+		return nil, fmt.Errorf("error querying graph broadcast result; %w", err)
+	}
+	if data.BroadcastResult == nil {
+		return nil, fmt.Errorf("error graph broadcast result not found: %s", txHash)
+	}
+	return data.BroadcastResult, nil
+}
+
+var syncBroadcastTest = suite.Test{
+	Name:    TestSyncBroadcast,
+	Cluster: true,
+	Test: func(r *suite.TestRequest) error {
+		s := &syncTest{Request: r}
+		defer s.end()
+		var err error
+		if s.Chain, err = node.NewChain(); err != nil {
+			return fmt.Errorf("error getting new simulated chain; %w", err)
+		}
+		if _, err := s.Chain.Generate(2); err != nil {
+			return fmt.Errorf("error generating blocks; %w", err)
+		}
+		if err := s.start(); err != nil {
+			return fmt.Errorf("error starting sync test; %w", err)
+		}
+		rejectTx, err := s.Chain.NewPost(syncPostMessage)
+		if err != nil {
+			return fmt.Errorf("error generating reject post tx; %w", err)
+		}
+		acceptTx, err := s.Chain.NewPost(syncPostMessage)
+		if err != nil {
+			return fmt.Errorf("error generating accept post tx; %w", err)
+		}
+		rejectHash := rejectTx.TxHash()
+		s.Node.Reject = func(tx *wire.MsgTx) *wire.MsgReject {
+			if tx.TxHash() != rejectHash {
+				return nil
+			}
+			msgReject := wire.NewMsgReject(wire.CmdTx, wire.RejectInvalid, broadcastRejectReason)
+			msgReject.Hash = rejectHash
+			return msgReject
+		}
+		if err := s.broadcast(rejectTx); err == nil || !strings.Contains(err.Error(), broadcastRejectReason) {
+			return fmt.Errorf("error expected broadcast of rejected tx to fail with reject reason; %w", err)
+		}
+		rejectResult, err := s.getBroadcastResult(rejectHash)
+		if err != nil {
+			return fmt.Errorf("error getting rejected tx broadcast result; %w", err)
+		}
+		if rejectResult.Status != "rejected" || rejectResult.Code != int(wire.RejectInvalid) ||
+			rejectResult.Reason != broadcastRejectReason {
+			return fmt.Errorf("error rejected tx broadcast result does not match: %s %d %s",
+				rejectResult.Status, rejectResult.Code, rejectResult.Reason)
+		}
+		if err := s.broadcast(acceptTx); err != nil {
+			return fmt.Errorf("error broadcasting accepted tx; %w", err)
+		}
+		acceptResult, err := s.getBroadcastResult(acceptTx.TxHash())
+		if err != nil {
+			return fmt.Errorf("error getting accepted tx broadcast result; %w", err)
+		}
+		if acceptResult.Status != "accepted" {
+			return fmt.Errorf("error accepted tx broadcast result status: %s", acceptResult.Status)
+		}
+		if s.Chain.GetMempoolTx(acceptTx.TxHash()) == nil {
+			return fmt.Errorf("error accepted tx not in simulated node mempool")
+		}
+		return nil
+	},
+}
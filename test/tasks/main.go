@@ -3,9 +3,15 @@ package tasks
 import "github.com/memocash/index/test/suite"
 
 const (
-	TestSaveMessage = "save_message"
-	TestQueue       = "queue"
-	TestQueueWait   = "queue_wait"
+	TestSaveMessage   = "save_message"
+	TestQueue         = "queue"
+	TestQueueWait     = "queue_wait"
+	TestSyncBlocks    = "sync_blocks"
+	TestSyncFork      = "sync_fork"
+	TestSyncOrphan    = "sync_orphan"
+	TestSyncMempool   = "sync_mempool"
+	TestSyncBroadcast = "sync_broadcast"
+	TestSyncRpc       = "sync_rpc"
 )
 
 func GetTests() []suite.Test {
@@ -13,5 +19,11 @@ func GetTests() []suite.Test {
 		SaveMessage,
 		queueTest,
 		waitTest,
+		syncBlocksTest,
+		syncForkTest,
+		syncOrphanTest,
+		syncMempoolTest,
+		syncBroadcastTest,
+		syncRpcTest,
 	}
 }
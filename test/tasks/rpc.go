@@ -0,0 +1,111 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"github.com/jchavannes/btcd/wire"
+	"github.com/memocash/index/test/run/node"
+	"github.com/memocash/index/test/suite"
+	"strings"
+)
+
+// startRpc serves the chain from a stub rpc server and starts the lead processor with the rpc block source, waiting
+// for the initial sync.
+func (s *syncTest) startRpc() error {
+	s.Rpc = node.NewRpcServer(s.Chain, false)
+	if err := s.Rpc.Start(); err != nil {
+		return fmt.Errorf("error starting stub rpc server; %w", err)
+	}
+	s.Request.Suite.Cluster.StartRpcProcessor(s.Rpc.GetConfig())
+	if err := s.waitForTip(); err != nil {
+		return fmt.Errorf("error waiting for initial rpc sync; %w", err)
+	}
+	return nil
+}
+
+var syncRpcTest = suite.Test{
+	Name:    TestSyncRpc,
+	Cluster: true,
+	Test: func(r *suite.TestRequest) error {
+		s := &syncTest{Request: r}
+		defer s.end()
+		var err error
+		if s.Chain, err = node.NewChain(); err != nil {
+			return fmt.Errorf("error getting new simulated chain; %w", err)
+		}
+		if _, err := s.Chain.Generate(1); err != nil {
+			return fmt.Errorf("error generating funding block; %w", err)
+		}
+		postTx, err := s.Chain.NewPost(syncPostMessage)
+		if err != nil {
+			return fmt.Errorf("error generating post tx; %w", err)
+		}
+		s.Chain.AddMempool(postTx)
+		blocks, err := s.Chain.Generate(4)
+		if err != nil {
+			return fmt.Errorf("error generating blocks; %w", err)
+		}
+		if err := s.startRpc(); err != nil {
+			return fmt.Errorf("error starting rpc sync test; %w", err)
+		}
+		if err := s.checkMainChain(); err != nil {
+			return fmt.Errorf("error checking main chain after rpc sync; %w", err)
+		}
+		if _, err := s.waitForPost(postTx.TxHash(), &blocks[0].Hash); err != nil {
+			return fmt.Errorf("error getting rpc synced post; %w", err)
+		}
+		mempoolTx, err := s.Chain.NewPost(syncPostMessage)
+		if err != nil {
+			return fmt.Errorf("error generating mempool post tx; %w", err)
+		}
+		s.Chain.AddMempool(mempoolTx)
+		if _, err := s.waitForPost(mempoolTx.TxHash(), nil); err != nil {
+			return fmt.Errorf("error getting rpc mempool post; %w", err)
+		}
+		// Fork from height 3 with one more block than the current chain, the first fork block mines the mempool post.
+		forkBlocks, err := s.Chain.Fork(blocks[1].Hash, 4, mempoolTx)
+		if err != nil {
+			return fmt.Errorf("error generating rpc fork blocks; %w", err)
+		}
+		if err := s.waitForTip(); err != nil {
+			return fmt.Errorf("error waiting for rpc fork tip; %w", err)
+		}
+		if err := s.checkMainChain(); err != nil {
+			return fmt.Errorf("error checking main chain after rpc fork; %w", err)
+		}
+		if _, err := s.waitForPost(mempoolTx.TxHash(), &forkBlocks[0].Hash); err != nil {
+			return fmt.Errorf("error getting rpc mempool post in fork block; %w", err)
+		}
+		if err := s.Request.Suite.Cluster.Processor.Backfill(context.Background(), 1,
+			s.Chain.GetTip().Height); err != nil {
+			return fmt.Errorf("error backfilling blocks from rpc; %w", err)
+		}
+		if err := s.checkMainChain(); err != nil {
+			return fmt.Errorf("error checking main chain after rpc backfill; %w", err)
+		}
+		rejectTx, err := s.Chain.NewPost(syncPostMessage)
+		if err != nil {
+			return fmt.Errorf("error generating reject post tx; %w", err)
+		}
+		rejectHash := rejectTx.TxHash()
+		s.Rpc.Reject = func(tx *wire.MsgTx) *wire.MsgReject {
+			if tx.TxHash() != rejectHash {
+				return nil
+			}
+			return wire.NewMsgReject(wire.CmdTx, wire.RejectInvalid, broadcastRejectReason)
+		}
+		if err := s.broadcast(rejectTx); err == nil || !strings.Contains(err.Error(), broadcastRejectReason) {
+			return fmt.Errorf("error expected rpc broadcast of rejected tx to fail with reject reason; %w", err)
+		}
+		rejectResult, err := s.getBroadcastResult(rejectHash)
+		if err != nil {
+			return fmt.Errorf("error getting rpc rejected tx broadcast result; %w", err)
+		}
+		if rejectResult.Status != "rejected" || rejectResult.Code != int(wire.RejectInvalid) ||
+			rejectResult.Reason != broadcastRejectReason {
+			return fmt.Errorf("error rpc rejected tx broadcast result does not match: %s %d %s",
+				rejectResult.Status, rejectResult.Code, rejectResult.Reason)
+		}
+		return nil
+	},
+}
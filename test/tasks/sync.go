@@ -0,0 +1,369 @@
+package tasks
+
+import (
+	"fmt"
+	"github.com/jchavannes/btcd/chaincfg/chainhash"
+	"github.com/memocash/index/db/client"
+	"github.com/memocash/index/db/item/chain"
+	"github.com/memocash/index/test/run/node"
+	"github.com/memocash/index/test/suite"
+	"time"
+)
+
+const (
+	syncTimeout      = time.Minute
+	syncPollInterval = 100 * time.Millisecond
+	syncPostMessage  = "sync test post"
+)
+
+type syncTest struct {
+	Request *suite.TestRequest
+	Chain   *node.Chain
+	Node    *node.Node
+	Rpc     *node.RpcServer
+}
+
+// start serves the chain from a simulated node and starts the lead processor, waiting for the initial sync.
+func (s *syncTest) start() error {
+	s.Node = node.NewNode(s.Chain, false)
+	if err := s.Node.Start(); err != nil {
+		return fmt.Errorf("error starting simulated node; %w", err)
+	}
+	s.Request.Suite.Cluster.StartProcessor()
+	if err := s.waitForTip(); err != nil {
+		return fmt.Errorf("error waiting for initial sync; %w", err)
+	}
+	return nil
+}
+
+func (s *syncTest) end() {
+	if s.Node != nil {
+		s.Node.Stop()
+	}
+	if s.Rpc != nil {
+		s.Rpc.Stop()
+	}
+}
+
+func (s *syncTest) waitFor(name string, check func() (bool, error)) error {
+	timeout := time.After(syncTimeout)
+	for {
+		if err := s.Request.Suite.Cluster.GetError(); err != nil {
+			return fmt.Errorf("error cluster failed waiting for %s; %w", name, err)
+		}
+		if ok, err := check(); err != nil {
+			return fmt.Errorf("error checking %s; %w", name, err)
+		} else if ok {
+			return nil
+		}
+		select {
+		case <-timeout:
+			return fmt.Errorf("error timeout waiting for %s", name)
+		case <-time.After(syncPollInterval):
+		}
+	}
+}
+
+// waitForTip waits until the recent height block matches the simulated chain tip.
+func (s *syncTest) waitForTip() error {
+	tip := s.Chain.GetTip()
+	return s.waitFor(fmt.Sprintf("tip %s (height %d)", tip.Hash, tip.Height), func() (bool, error) {
+		heightBlock, err := chain.GetRecentHeightBlock()
+		if err != nil {
+			return false, fmt.Errorf("error getting recent height block; %w", err)
+		}
+		return heightBlock != nil && heightBlock.BlockHash == tip.Hash, nil
+	})
+}
+
+// checkMainChain verifies each height of the simulated main chain has a single height block for the expected block.
+func (s *syncTest) checkMainChain() error {
+	for _, block := range s.Chain.GetMainChain() {
+		heightBlocks, err := chain.GetHeightBlock(block.Height)
+		if err != nil {
+			return fmt.Errorf("error getting height blocks for main chain check; %w", err)
+		}
+		if len(heightBlocks) != 1 || heightBlocks[0].BlockHash != block.Hash {
+			return fmt.Errorf("error height blocks for height %d (count %d) do not match expected: %s",
+				block.Height, len(heightBlocks), block.Hash)
+		}
+	}
+	return nil
+}
+
+type syncGraphPost struct {
+	TxHash string `json:"tx_hash"`
+	Text   string `json:"text"`
+	Tx     struct {
+		Blocks []struct {
+			BlockHash string `json:"block_hash"`
+		} `json:"blocks"`
+	} `json:"tx"`
+}
+
+func (s *syncTest) getPost(txHash chainhash.Hash) (*syncGraphPost, error) {
+	const query = `query ($txHashes: [Hash!]) {
+		posts(txHashes: $txHashes) {
+			tx_hash
+			text
+			tx {
+				blocks {
+					block_hash
+				}
+			}
+		}
+	}`
+	var data struct {
+		Posts []*syncGraphPost `json:"posts"`
+	}
+	if err := s.Request.Suite.Cluster.Query(query, map[string]interface{}{
+		"txHashes": []string{txHash.String()},
+	}, &data); err != nil {
+		return nil, fmt.Errorf("error querying graph posts; %w", err)
+	}
+	if len(data.Posts) != 1 || data.Posts[0] == nil || data.Posts[0].TxHash != txHash.String() {
+		return nil, nil
+	}
+	return data.Posts[0], nil
+}
+
+func (s *syncTest) checkNewestBlock(expected *node.Block) error {
+	const query = `query {
+		block_newest {
+			hash
+			height
+		}
+	}`
+	var data struct {
+		BlockNewest *struct {
+			Hash   string `json:"hash"`
+			Height int64  `json:"height"`
+		} `json:"block_newest"`
+	}
+	if err := s.Request.Suite.Cluster.Query(query, nil, &data); err != nil {
+		return fmt.Errorf("error querying graph newest block; %w", err)
+	}
+	if data.BlockNewest == nil {
+		return fmt.Errorf("error graph newest block not found")
+	}
+	if data.BlockNewest.Hash != expected.Hash.String() || data.BlockNewest.Height != expected.Height {
+		return fmt.Errorf("error graph newest block %s (height %d) does not match expected %s (height %d)",
+			data.BlockNewest.Hash, data.BlockNewest.Height, expected.Hash, expected.Height)
+	}
+	return nil
+}
+
+// waitForPost waits until the post is returned by the graph server, included in the block if one is set. Block
+// heights are saved before their txs so a synced tip doesn't mean the post is available yet.
+func (s *syncTest) waitForPost(txHash chainhash.Hash, blockHash *chainhash.Hash) (*syncGraphPost, error) {
+	var post *syncGraphPost
+	if err := s.waitFor(fmt.Sprintf("post %s", txHash), func() (bool, error) {
+		var err error
+		if post, err = s.getPost(txHash); err != nil || post == nil {
+			return false, err
+		}
+		if blockHash == nil {
+			return true, nil
+		}
+		for _, block := range post.Tx.Blocks {
+			if block.BlockHash == blockHash.String() {
+				return true, nil
+			}
+		}
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("error waiting for post; %w", err)
+	}
+	return post, nil
+}
+
+var syncBlocksTest = suite.Test{
+	Name:    TestSyncBlocks,
+	Cluster: true,
+	Test: func(r *suite.TestRequest) error {
+		s := &syncTest{Request: r}
+		defer s.end()
+		var err error
+		if s.Chain, err = node.NewChain(); err != nil {
+			return fmt.Errorf("error getting new simulated chain; %w", err)
+		}
+		if _, err := s.Chain.Generate(1); err != nil {
+			return fmt.Errorf("error generating funding block; %w", err)
+		}
+		postTx, err := s.Chain.NewPost(syncPostMessage)
+		if err != nil {
+			return fmt.Errorf("error generating post tx; %w", err)
+		}
+		s.Chain.AddMempool(postTx)
+		blocks, err := s.Chain.Generate(5)
+		if err != nil {
+			return fmt.Errorf("error generating blocks; %w", err)
+		}
+		if err := s.start(); err != nil {
+			return fmt.Errorf("error starting sync test; %w", err)
+		}
+		if err := s.checkMainChain(); err != nil {
+			return fmt.Errorf("error checking main chain after sync; %w", err)
+		}
+		if err := s.checkNewestBlock(s.Chain.GetTip()); err != nil {
+			return fmt.Errorf("error checking newest block after sync; %w", err)
+		}
+		post, err := s.waitForPost(postTx.TxHash(), &blocks[0].Hash)
+		if err != nil {
+			return fmt.Errorf("error getting synced post; %w", err)
+		}
+		if post.Text != syncPostMessage {
+			return fmt.Errorf("error synced post text does not match: %s", post.Text)
+		}
+		return nil
+	},
+}
+
+var syncForkTest = suite.Test{
+	Name:    TestSyncFork,
+	Cluster: true,
+	Test: func(r *suite.TestRequest) error {
+		s := &syncTest{Request: r}
+		defer s.end()
+		var err error
+		if s.Chain, err = node.NewChain(); err != nil {
+			return fmt.Errorf("error getting new simulated chain; %w", err)
+		}
+		blocks, err := s.Chain.Generate(5)
+		if err != nil {
+			return fmt.Errorf("error generating blocks; %w", err)
+		}
+		if err := s.start(); err != nil {
+			return fmt.Errorf("error starting sync test; %w", err)
+		}
+		postTx, err := s.Chain.NewPost(syncPostMessage)
+		if err != nil {
+			return fmt.Errorf("error generating fork post tx; %w", err)
+		}
+		// Fork from height 3 with one more block than the current chain, replacing heights 4 and 5.
+		forkBlocks, err := s.Chain.Fork(blocks[2].Hash, 3, postTx)
+		if err != nil {
+			return fmt.Errorf("error generating fork blocks; %w", err)
+		}
+		if s.Chain.GetTip() != forkBlocks[len(forkBlocks)-1] {
+			return fmt.Errorf("error simulated chain tip is not fork tip")
+		}
+		s.Node.AnnounceBlocks(forkBlocks...)
+		if err := s.waitForTip(); err != nil {
+			return fmt.Errorf("error waiting for fork tip; %w", err)
+		}
+		if err := s.checkMainChain(); err != nil {
+			return fmt.Errorf("error checking main chain after fork; %w", err)
+		}
+		for _, block := range blocks[3:] {
+			blockHeight, err := chain.GetBlockHeight(block.Hash)
+			if err != nil {
+				return fmt.Errorf("error getting block height for replaced block; %w", err)
+			}
+			if blockHeight.Height != block.Height {
+				return fmt.Errorf("error replaced block height %d does not match expected %d",
+					blockHeight.Height, block.Height)
+			}
+		}
+		if err := s.checkNewestBlock(s.Chain.GetTip()); err != nil {
+			return fmt.Errorf("error checking newest block after fork; %w", err)
+		}
+		if _, err := s.waitForPost(postTx.TxHash(), &forkBlocks[0].Hash); err != nil {
+			return fmt.Errorf("error getting fork post; %w", err)
+		}
+		return nil
+	},
+}
+
+var syncOrphanTest = suite.Test{
+	Name:    TestSyncOrphan,
+	Cluster: true,
+	Test: func(r *suite.TestRequest) error {
+		s := &syncTest{Request: r}
+		defer s.end()
+		var err error
+		if s.Chain, err = node.NewChain(); err != nil {
+			return fmt.Errorf("error getting new simulated chain; %w", err)
+		}
+		if _, err := s.Chain.Generate(3); err != nil {
+			return fmt.Errorf("error generating blocks; %w", err)
+		}
+		if err := s.start(); err != nil {
+			return fmt.Errorf("error starting sync test; %w", err)
+		}
+		orphan, err := s.Chain.Orphan()
+		if err != nil {
+			return fmt.Errorf("error generating orphan block; %w", err)
+		}
+		s.Node.AnnounceBlocks(orphan)
+		if err := s.waitFor("orphan block", func() (bool, error) {
+			if _, err := chain.GetBlock(orphan.Hash); client.IsEntryNotFoundError(err) {
+				return false, nil
+			} else if err != nil {
+				return false, fmt.Errorf("error getting orphan block; %w", err)
+			}
+			return true, nil
+		}); err != nil {
+			return fmt.Errorf("error waiting for orphan block; %w", err)
+		}
+		if _, err := chain.GetBlockHeight(orphan.Hash); !client.IsEntryNotFoundError(err) {
+			return fmt.Errorf("error expected orphan block to not have a height; %w", err)
+		}
+		blocks, err := s.Chain.Generate(1)
+		if err != nil {
+			return fmt.Errorf("error generating block after orphan; %w", err)
+		}
+		s.Node.AnnounceBlocks(blocks...)
+		if err := s.waitForTip(); err != nil {
+			return fmt.Errorf("error waiting for tip after orphan; %w", err)
+		}
+		if err := s.checkMainChain(); err != nil {
+			return fmt.Errorf("error checking main chain after orphan; %w", err)
+		}
+		return nil
+	},
+}
+
+var syncMempoolTest = suite.Test{
+	Name:    TestSyncMempool,
+	Cluster: true,
+	Test: func(r *suite.TestRequest) error {
+		s := &syncTest{Request: r}
+		defer s.end()
+		var err error
+		if s.Chain, err = node.NewChain(); err != nil {
+			return fmt.Errorf("error getting new simulated chain; %w", err)
+		}
+		if _, err := s.Chain.Generate(2); err != nil {
+			return fmt.Errorf("error generating blocks; %w", err)
+		}
+		if err := s.start(); err != nil {
+			return fmt.Errorf("error starting sync test; %w", err)
+		}
+		postTx, err := s.Chain.NewPost(syncPostMessage)
+		if err != nil {
+			return fmt.Errorf("error generating mempool post tx; %w", err)
+		}
+		s.Chain.AddMempool(postTx)
+		s.Node.AnnounceTxs(postTx)
+		post, err := s.waitForPost(postTx.TxHash(), nil)
+		if err != nil {
+			return fmt.Errorf("error getting mempool post; %w", err)
+		}
+		if len(post.Tx.Blocks) != 0 {
+			return fmt.Errorf("error mempool post has blocks: %d", len(post.Tx.Blocks))
+		}
+		blocks, err := s.Chain.Generate(1)
+		if err != nil {
+			return fmt.Errorf("error generating block for mempool post; %w", err)
+		}
+		s.Node.AnnounceBlocks(blocks...)
+		if err := s.waitForTip(); err != nil {
+			return fmt.Errorf("error waiting for mempool post block; %w", err)
+		}
+		if _, err := s.waitForPost(postTx.TxHash(), &blocks[0].Hash); err != nil {
+			return fmt.Errorf("error getting mempool post in block; %w", err)
+		}
+		return nil
+	},
+}